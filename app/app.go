@@ -670,6 +670,7 @@ func New(
 		app.EscrowKeeper,
 		app.AccountKeeper,
 		app.StakingKeeper,
+		app.DistrKeeper,
 		nil,
 		app.EpochsKeeper,
 		SagaAddress,
@@ -694,7 +695,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ConnectionKeeper,
 		app.ProviderKeeper,
-		app.BillingKeeper,
+		&app.BillingKeeper,
 		app.EscrowKeeper,
 		app.DacKeeper,
 	)
@@ -708,17 +709,25 @@ func New(
 		app.ChainletKeeper,
 	)
 	peersModule := peers.NewAppModule(appCodec, app.PeersKeeper, app.GetSubspace(peerstypes.ModuleName))
+	app.ChainletKeeper.UpdateKeeper(app.PeersKeeper)
 
 	app.BillingKeeper.UpdateKeeper(app.ChainletKeeper)
-	billingModule := billingmodule.NewAppModule(appCodec, app.BillingKeeper, app.AccountKeeper, app.BankKeeper)
+	app.BillingKeeper.UpdateKeeper(app.PeersKeeper)
+	app.BillingKeeper.UpdateKeeper(app.ProviderKeeper)
 
 	app.EscrowKeeper.UpdateKeeper(app.BillingKeeper)
 	app.EscrowKeeper.UpdateKeeper(app.ChainletKeeper)
 	app.EscrowKeeper.UpdateKeeper(app.DacKeeper)
 	escrowModule := escrowmodule.NewAppModule(appCodec, app.EscrowKeeper, app.AccountKeeper, app.BankKeeper, app.ChainletKeeper)
 
+	// billing simulations run the escrow epoch hook, which needs the wired escrow keeper
+	app.BillingKeeper.UpdateKeeper(app.EscrowKeeper)
+	billingModule := billingmodule.NewAppModule(appCodec, app.BillingKeeper, app.AccountKeeper, app.BankKeeper)
+
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(
+			// top-ups have to run before billing
+			app.EscrowKeeper.Hooks(),
 			app.BillingKeeper.Hooks(),
 		),
 	)
@@ -760,6 +769,7 @@ func New(
 
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = escrowmodule.NewIBCModule(transferStack, app.EscrowKeeper)
 	transferStack = packetforward.NewIBCMiddleware(
 		transferStack,
 		app.PacketForwardKeeper,
@@ -1254,6 +1264,14 @@ func (app *App) ModuleManager() *module.Manager {
 	return app.mm
 }
 
+// RegisterInvariants registers the invariants of the app modules. The module manager no longer
+// registers invariants and the app runs without x/crisis, so the simulations assert them instead.
+//
+//nolint:staticcheck // sdk.InvariantRegistry is deprecated
+func (app *App) RegisterInvariants(ir sdk.InvariantRegistry) {
+	escrowmodulekeeper.RegisterInvariants(ir, app.EscrowKeeper)
+}
+
 func (app *App) RegisterUpgradeHandlers() {
 	baseAppLegacySS := app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramstypes.ConsensusParamsKeyTable())
 	app.UpgradeKeeper.SetUpgradeHandler(upgrade02.Name, upgrade02.UpgradeHandler(app.mm, app.configurator, app.ParamsKeeper, &app.ConsensusParamsKeeper, baseAppLegacySS))
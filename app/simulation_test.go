@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -49,6 +50,31 @@ func init() {
 	simcli.GetSimulatorFlags()
 }
 
+// invariantRegistry collects the invariants registered by the app.
+type invariantRegistry struct {
+	routes []string
+	invs   []sdk.Invariant //nolint:staticcheck
+}
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) { //nolint:staticcheck
+	r.routes = append(r.routes, moduleName+"/"+route)
+	r.invs = append(r.invs, invar)
+}
+
+// assertInvariants checks the app invariants against the last committed state.
+func assertInvariants(tb testing.TB, bApp *app.App) {
+	tb.Helper()
+
+	ir := &invariantRegistry{}
+	bApp.RegisterInvariants(ir)
+
+	ctx := bApp.NewContextLegacy(true, tmproto.Header{Height: bApp.LastBlockHeight()})
+	for i, invar := range ir.invs {
+		res, broken := invar(ctx)
+		require.False(tb, broken, "invariant %s broken: %s", ir.routes[i], res)
+	}
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `starport chain simulate -v --numBlocks 200 --blockSize 50`
@@ -111,6 +137,7 @@ func BenchmarkSimulation(b *testing.B) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(b, err)
 	require.NoError(b, simErr)
+	assertInvariants(b, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -182,6 +209,7 @@ func TestAppStateDeterminism(t *testing.T) {
 				bApp.AppCodec(),
 			)
 			require.NoError(t, err)
+			assertInvariants(t, bApp)
 
 			if config.Commit {
 				simtestutil.PrintStats(db)
@@ -251,6 +279,7 @@ func TestAppImportExport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	// export state and simParams before the simulation error is checked
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
@@ -397,6 +426,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	// export state and simParams before the simulation error is checked
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
@@ -464,4 +494,5 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	assertInvariants(t, newApp)
 }
@@ -235,7 +235,7 @@ run_test "sscd tx escrow withdraw ${cid_a0} \
 show_escrow_state "${cid_a0}"
 
 sleep_billing 60
-ensure_status "${cid_a0}" "STATUS_OFFLINE"
+ensure_status "${cid_a0}" "STATUS_SUSPENDED_BILLING"
 
 run_test "sscd tx escrow deposit 5000${denom} ${cid_a0} \
   --from ${key} --keyring-backend ${keyring_backend} --fees ${fees} -o json -y" \
@@ -284,7 +284,7 @@ run_test "sscd tx escrow withdraw ${cid_b0} \
 show_escrow_state "${cid_b0}"
 
 sleep_billing 60
-ensure_status "${cid_b0}" "STATUS_OFFLINE"
+ensure_status "${cid_b0}" "STATUS_SUSPENDED_BILLING"
 
 run_test "sscd tx escrow deposit 5000${denom_b} ${cid_b0} \
   --from ${key} --keyring-backend ${keyring_backend} --fees ${fees} -o json -y" \
@@ -325,7 +325,7 @@ run_test "sscd tx escrow withdraw ${cid_a_1} \
 show_escrow_state "${cid_a_1}"
 
 sleep_billing 60
-ensure_status "${cid_a_1}" "STATUS_OFFLINE"
+ensure_status "${cid_a_1}" "STATUS_SUSPENDED_BILLING"
 
 run_test "sscd tx escrow deposit 20000${denom_b} ${cid_a_1} \
   --from alice --keyring-backend ${keyring_backend} --fees ${fees} -o json -y" \
@@ -357,7 +357,7 @@ run_test "sscd tx escrow withdraw ${cid_b_1} \
 show_escrow_state "${cid_b_1}"
 
 sleep_billing 60
-ensure_status "${cid_b_1}" "STATUS_OFFLINE"
+ensure_status "${cid_b_1}" "STATUS_SUSPENDED_BILLING"
 
 run_test "sscd tx escrow deposit 20000${denom} ${cid_b_1} \
   --from alice --keyring-backend ${keyring_backend} --fees ${fees} -o json -y" \
@@ -398,7 +398,7 @@ run_test "sscd tx escrow withdraw ${cid_b_2} \
 show_escrow_state "${cid_b_2}"
 
 sleep_billing 60
-ensure_status "${cid_b_2}" "STATUS_OFFLINE"
+ensure_status "${cid_b_2}" "STATUS_SUSPENDED_BILLING"
 
 run_test "sscd tx escrow deposit 5000${denom} ${cid_b_2} \
   --from alice --keyring-backend ${keyring_backend} --fees ${fees} -o json -y" \
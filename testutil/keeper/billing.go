@@ -48,6 +48,7 @@ func BillingKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		nil,
 		nil,
+		nil,
 		"",
 	)
 
@@ -28,8 +28,47 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdGetBillingHistory())
 
 	cmd.AddCommand(CmdGetValidatorPayoutHistory())
+	cmd.AddCommand(CmdGetChainletDebt())
+	cmd.AddCommand(CmdRunway())
+	cmd.AddCommand(CmdStackRevenue())
+	cmd.AddCommand(CmdPendingRewards())
+	cmd.AddCommand(CmdBillingCredits())
+	cmd.AddCommand(CmdSimulateNextBilling())
 
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+const (
+	flagEpochIdentifier = "epoch-identifier"
+	flagFromEpoch       = "from-epoch"
+	flagToEpoch         = "to-epoch"
+	flagResult          = "result"
+)
+
+// addHistoryFilterFlags adds the flags shared by the history queries.
+func addHistoryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagEpochIdentifier, "", "only list records of this epoch identifier")
+	cmd.Flags().Int64(flagFromEpoch, 0, "only list records from this epoch number on")
+	cmd.Flags().Int64(flagToEpoch, 0, "only list records up to this epoch number")
+	cmd.Flags().String(flagResult, "", "only list successful or failed records (success|failure)")
+}
+
+func readHistoryFilterFlags(cmd *cobra.Command) (epochIdentifier string, fromEpoch, toEpoch int64, result types.ResultFilter, err error) {
+	epochIdentifier, _ = cmd.Flags().GetString(flagEpochIdentifier)
+	fromEpoch, _ = cmd.Flags().GetInt64(flagFromEpoch)
+	toEpoch, _ = cmd.Flags().GetInt64(flagToEpoch)
+	resultFlag, _ := cmd.Flags().GetString(flagResult)
+	switch resultFlag {
+	case "":
+		result = types.ResultFilter_RESULT_FILTER_ANY
+	case "success":
+		result = types.ResultFilter_RESULT_FILTER_SUCCESS
+	case "failure":
+		result = types.ResultFilter_RESULT_FILTER_FAILURE
+	default:
+		err = fmt.Errorf("invalid result filter %s", resultFlag)
+	}
+	return
+}
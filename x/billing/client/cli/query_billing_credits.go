@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdBillingCredits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "billing-credits [chain-id|launcher-address]",
+		Short: "Query the outstanding billing credits, optionally of a chainlet or a launcher only",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var reqChainId, reqLauncher string
+			if len(args) > 0 {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err == nil {
+					reqLauncher = args[0]
+				} else {
+					reqChainId = args[0]
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBillingCreditsRequest{
+				ChainId:    reqChainId,
+				Launcher:   reqLauncher,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BillingCredits(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "billing-credits")
+
+	return cmd
+}
@@ -24,11 +24,20 @@ func CmdGetBillingHistory() *cobra.Command {
 				return err
 			}
 
+			epochIdentifier, fromEpoch, toEpoch, result, err := readHistoryFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryGetBillingHistoryRequest{
-				ChainId:    reqChainId,
-				Pagination: pageReq,
+				ChainId:         reqChainId,
+				Pagination:      pageReq,
+				EpochIdentifier: epochIdentifier,
+				FromEpoch:       fromEpoch,
+				ToEpoch:         toEpoch,
+				Result:          result,
 			}
 
 			res, err := queryClient.GetBillingHistory(cmd.Context(), params)
@@ -42,6 +51,7 @@ func CmdGetBillingHistory() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "get-billing-history")
+	addHistoryFilterFlags(cmd)
 
 	return cmd
 }
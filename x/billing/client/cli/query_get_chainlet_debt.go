@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetChainletDebt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-chainlet-debt [chain-id]",
+		Short: "Query the debt and grace period state of a chainlet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqChainId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetChainletDebtRequest{
+				ChainId: reqChainId,
+			}
+
+			res, err := queryClient.GetChainletDebt(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -24,11 +24,20 @@ func CmdGetValidatorPayoutHistory() *cobra.Command {
 				return err
 			}
 
+			epochIdentifier, fromEpoch, toEpoch, result, err := readHistoryFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryGetValidatorPayoutHistoryRequest{
 				ValidatorAddress: reqValidatorAddress,
 				Pagination:       pageReq,
+				EpochIdentifier:  epochIdentifier,
+				FromEpoch:        fromEpoch,
+				ToEpoch:          toEpoch,
+				Result:           result,
 			}
 
 			res, err := queryClient.GetValidatorPayoutHistory(cmd.Context(), params)
@@ -42,6 +51,7 @@ func CmdGetValidatorPayoutHistory() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "get-validator-payout-history")
+	addHistoryFilterFlags(cmd)
 
 	return cmd
 }
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdRunway() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runway [chain-id]",
+		Short: "Query how many epochs the escrow of a chainlet still pays for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqChainId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRunwayRequest{
+				ChainId: reqChainId,
+			}
+
+			res, err := queryClient.Runway(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
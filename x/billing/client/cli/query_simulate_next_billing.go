@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSimulateNextBilling() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-next-billing [chain-id|launcher-address]",
+		Short: "Query what billing the chainlets at the start of the next billing epoch would do, optionally for a chainlet or a launcher only",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var reqChainId, reqLauncher string
+			if len(args) > 0 {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err == nil {
+					reqLauncher = args[0]
+				} else {
+					reqChainId = args[0]
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulateNextBillingRequest{
+				ChainId:  reqChainId,
+				Launcher: reqLauncher,
+			}
+
+			res, err := queryClient.SimulateNextBilling(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,6 +25,12 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdSetLowBalanceThreshold())
+	cmd.AddCommand(CmdSetStackRevenueShare())
+	cmd.AddCommand(CmdClaimBillingRewards())
+	cmd.AddCommand(CmdSetRewardWithdrawAddress())
+	cmd.AddCommand(CmdIssueBillingCredit())
+	cmd.AddCommand(CmdRevokeBillingCredit())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
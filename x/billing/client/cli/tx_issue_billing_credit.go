@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdIssueBillingCredit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-billing-credit [chain-id|launcher-address] [amount] [expiry] [memo]",
+		Short: "Issue a credit paying the epoch fees of a chainlet, or of all chainlets of a launcher, until the expiry (RFC3339)",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var argChainId, argLauncher string
+			if _, err := sdk.AccAddressFromBech32(args[0]); err == nil {
+				argLauncher = args[0]
+			} else {
+				argChainId = args[0]
+			}
+			argAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argExpiry, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+			var argMemo string
+			if len(args) > 3 {
+				argMemo = args[3]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgIssueBillingCredit(clientCtx.GetFromAddress().String(), argChainId, argLauncher, argAmount, argExpiry, argMemo)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
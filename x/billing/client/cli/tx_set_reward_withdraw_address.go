@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetRewardWithdrawAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reward-withdraw-address [withdraw-address]",
+		Short: "Set the address the billing rewards of the signing validator are claimed to, empty resets it",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var argWithdrawAddress string
+			if len(args) > 0 {
+				argWithdrawAddress = args[0]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetRewardWithdrawAddress{
+				Creator:         clientCtx.GetFromAddress().String(),
+				WithdrawAddress: argWithdrawAddress,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetStackRevenueShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-stack-revenue-share [stack-name] [share] [payout-address]",
+		Short: "Set the share of the epoch fees of a chainlet stack paid to its creator (stack creator only), 0 removes it",
+		Long:  "Set the share of the epoch fees of a chainlet stack paid to its creator, as a decimal fraction capped by the max-stack-revenue-share param. The share is paid to the stack creator unless a payout address is given.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStackName := args[0]
+			argShare := args[1]
+			var argPayoutAddress string
+			if len(args) > 2 {
+				argPayoutAddress = args[2]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetStackRevenueShare{
+				Creator:       clientCtx.GetFromAddress().String(),
+				StackName:     argStackName,
+				Share:         argShare,
+				PayoutAddress: argPayoutAddress,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -21,6 +21,47 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.ImportValidatorPayoutHistory(ctx, vph)
 	}
 
+	// Import chainlet debts
+	for _, debt := range genState.ChainletDebts {
+		k.ImportChainletDebt(ctx, debt)
+	}
+
+	// Import low balance alerts
+	for _, alert := range genState.LowBalanceAlerts {
+		k.ImportLowBalanceAlert(ctx, alert)
+	}
+
+	// Import stack revenue shares and revenues
+	for _, share := range genState.StackRevenueShares {
+		k.ImportStackRevenueShare(ctx, share)
+	}
+	for _, revenue := range genState.StackRevenues {
+		k.ImportStackRevenue(ctx, revenue)
+	}
+
+	// Import pending validator rewards and withdraw addresses
+	for _, rewards := range genState.PendingRewards {
+		k.ImportPendingRewards(ctx, rewards)
+	}
+	for _, addr := range genState.RewardWithdrawAddresses {
+		k.ImportRewardWithdrawAddress(ctx, addr)
+	}
+
+	// Import revenue of CCV consumer chainlets
+	for _, revenue := range genState.ConsumerRevenues {
+		k.ImportConsumerRevenue(ctx, revenue)
+	}
+
+	// Import epoch charges
+	for _, charge := range genState.EpochCharges {
+		k.ImportEpochCharge(ctx, charge)
+	}
+
+	// Import billing credits
+	for _, credit := range genState.BillingCredits {
+		k.ImportBillingCredit(ctx, credit)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 }
 
@@ -35,6 +76,29 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export validator payout history
 	genesis.ValidatorPayoutHistory = k.ExportValidatorPayoutHistory(ctx)
 
+	// Export chainlet debts
+	genesis.ChainletDebts = k.ExportChainletDebts(ctx)
+
+	// Export low balance alerts
+	genesis.LowBalanceAlerts = k.ExportLowBalanceAlerts(ctx)
+
+	// Export stack revenue shares and revenues
+	genesis.StackRevenueShares = k.ExportStackRevenueShares(ctx)
+	genesis.StackRevenues = k.ExportStackRevenues(ctx)
+
+	// Export pending validator rewards and withdraw addresses
+	genesis.PendingRewards = k.ExportPendingRewards(ctx)
+	genesis.RewardWithdrawAddresses = k.ExportRewardWithdrawAddresses(ctx)
+
+	// Export revenue of CCV consumer chainlets
+	genesis.ConsumerRevenues = k.ExportConsumerRevenues(ctx)
+
+	// Export epoch charges
+	genesis.EpochCharges = k.ExportEpochCharges(ctx)
+
+	// Export billing credits
+	genesis.BillingCredits = k.ExportBillingCredits(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
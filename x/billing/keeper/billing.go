@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
 	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sagaxyz/ssc/x/billing/types"
@@ -12,34 +14,73 @@ import (
 )
 
 func (k Keeper) BillAccount(ctx sdk.Context, amount sdk.Coin, chainlet chainlettypes.Chainlet, memo string) error {
-	err := k.escrowkeeper.BillAccount(ctx, amount, chainlet.ChainId, "billing")
-	if err != nil {
-		ctx.Logger().Info(fmt.Sprintf("failed to bill account %s for %s at epoch %s", chainlet.ChainId, amount.String(), memo))
-		//nolint:errcheck // Event emission errors are non-critical
-		ctx.EventManager().EmitTypedEvent(&types.BillingEvent{
-			ChainId: chainlet.ChainId,
-			Amount:  amount.String(),
-			Memo:    memo,
-			Success: false,
-			Debit:   true,
-		})
-		return err
+	_, err := k.billAccount(ctx, amount, sdk.NewCoin(amount.Denom, math.ZeroInt()), chainlet, memo)
+	return err
+}
+
+// billAccount bills the escrow of a chainlet for the part of the amount not paid with credits
+// and returns the revenue paid to the stack creator. Stack creators and consumer chainlet
+// validators only get a share of what the escrow paid.
+func (k Keeper) billAccount(ctx sdk.Context, amount, credited sdk.Coin, chainlet chainlettypes.Chainlet, memo string) (sdk.Coin, error) {
+	charged := amount.Sub(credited)
+	if charged.IsPositive() {
+		err := k.escrowkeeper.BillAccount(ctx, charged, chainlet.ChainId, "billing")
+		if err != nil {
+			ctx.Logger().Info(fmt.Sprintf("failed to bill account %s for %s at epoch %s", chainlet.ChainId, charged.String(), memo))
+			//nolint:errcheck // Event emission errors are non-critical
+			ctx.EventManager().EmitTypedEvent(&types.BillingEvent{
+				ChainId: chainlet.ChainId,
+				Amount:  charged.String(),
+				Memo:    memo,
+				Success: false,
+				Debit:   true,
+			})
+			k.saveBillingAttempt(ctx, chainlet, charged, sdk.Coin{}, memo, true, sdk.Coin{}, "")
+			return sdk.Coin{}, err
+		}
 	}
-	ctx.Logger().Info(fmt.Sprintf("successfully billed account %s for %s at epoch %s", chainlet.ChainId, amount.String(), memo))
+	ctx.Logger().Info(fmt.Sprintf("successfully billed account %s for %s at epoch %s", chainlet.ChainId, charged.String(), memo))
 	//nolint:errcheck // Event emission errors are non-critical
 	ctx.EventManager().EmitTypedEvent(&types.BillingEvent{
 		ChainId: chainlet.ChainId,
-		Amount:  amount.String(),
+		Amount:  charged.String(),
 		Memo:    memo,
 		Success: true,
 		Debit:   true,
 	})
-	// Save billing history
+	revenue, recipient := k.payStackRevenue(ctx, chainlet, charged)
+	k.attributeConsumerRevenue(ctx, chainlet, sdk.NewCoins(charged.Sub(revenue)))
+	k.saveBillingAttempt(ctx, chainlet, charged, credited, memo, false, revenue, recipient)
+
+	return revenue, nil
+}
+
+// saveBillingAttempt records a billing attempt with a snapshot of the chainlet as it was billed,
+// including the part of the amount paid to the stack creator and the part paid with credits.
+func (k Keeper) saveBillingAttempt(ctx sdk.Context, chainlet chainlettypes.Chainlet, amount, credited sdk.Coin, memo string, failed bool, creatorRevenue sdk.Coin, creatorRevenueRecipient string) {
+	record := k.newBillingRecord(ctx, chainlet, memo)
+	record.BilledAmount = amount.String()
+	record.Failed = failed
+	if creatorRevenueRecipient != "" {
+		record.CreatorRevenue = creatorRevenue.String()
+		record.CreatorRevenueRecipient = creatorRevenueRecipient
+	}
+	if credited.IsValid() && credited.IsPositive() {
+		record.CreditUsed = credited.String()
+	}
+	err := k.SaveBillingHistory(ctx, record)
+	if err != nil {
+		ctx.Logger().Error("could not save billing history for chainlet " + chainlet.ChainletName + ". Error: " + err.Error())
+	}
+}
+
+// newBillingRecord returns a billing history record of the current billing epoch for the chainlet.
+func (k Keeper) newBillingRecord(ctx sdk.Context, chainlet chainlettypes.Chainlet, memo string) types.BillingHistory {
 	epochIdentifier := k.GetParams(ctx).BillingEpoch
 	epochInfo := k.epochskeeper.GetEpochInfo(ctx, epochIdentifier)
 	epochEventStartTime := epochInfo.CurrentEpochStartTime.Format(time.RFC3339)
 
-	err = k.SaveBillingHistory(ctx, types.BillingHistory{
+	return types.BillingHistory{
 		ChainletOwner:     chainlet.Launcher,
 		ChainletId:        chainlet.ChainId,
 		ChainletName:      chainlet.ChainletName,
@@ -47,13 +88,8 @@ func (k Keeper) BillAccount(ctx sdk.Context, amount sdk.Coin, chainlet chainlett
 		EpochIdentifier:   epochIdentifier,
 		EpochNumber:       epochInfo.CurrentEpoch,
 		EpochStartTime:    epochEventStartTime,
-		BilledAmount:      amount.String(),
-	})
-	if err != nil {
-		ctx.Logger().Error("could not save billing history for chainlet " + chainlet.ChainletName + ". Error: " + err.Error())
+		Memo:              memo,
 	}
-
-	return nil
 }
 
 func (k Keeper) PayEpochFeeToValidator(ctx sdk.Context, epochFee sdk.Coins, fromModuleName string, valAddr sdk.AccAddress, memo string) (err error) {
@@ -74,67 +110,45 @@ func (k Keeper) PayEpochFeeToValidator(ctx sdk.Context, epochFee sdk.Coins, from
 	return nil
 }
 
+// SaveBillingHistory stores a billing history record under a new ID.
 func (k Keeper) SaveBillingHistory(ctx sdk.Context, billinghistory types.BillingHistory) error {
-	// Get the store
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.BillingHistoryKey, billinghistory.ChainletId)))
+	billinghistory.Id = k.nextBillingHistoryID(ctx)
+	k.setBillingHistory(ctx, billinghistory)
+	return nil
+}
 
-	uniqueBillingRecord := fmt.Sprintf("%s-%d", billinghistory.EpochIdentifier, billinghistory.EpochNumber)
-	byteKey := []byte(uniqueBillingRecord)
-	saveBillingHistory := types.SaveBillingHistory{
-		ChainletId:      billinghistory.ChainletId,
-		EpochIdentifier: billinghistory.EpochIdentifier,
-		EpochNumber:     billinghistory.EpochNumber,
-		BilledAmount:    billinghistory.BilledAmount,
-	}
-	value := k.cdc.MustMarshal(&saveBillingHistory)
-	if len(value) == 0 {
-		return cosmossdkerrors.Wrap(types.ErrInternalFailure, "could not marshal billing history input for appending to the kv store")
-	}
-	if store.Has(byteKey) {
-		// cannot add a duplicate billing record so return an error
-		return cosmossdkerrors.Wrap(types.ErrInternalFailure, fmt.Sprintf("cannot add billing record %v as it already exists", uniqueBillingRecord))
-	} else {
-		store.Set(byteKey, value)
-	}
+func (k Keeper) setBillingHistory(ctx sdk.Context, billinghistory types.BillingHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingHistoryKey)
+	value := k.cdc.MustMarshal(&billinghistory)
+	store.Set(types.BillingHistoryRecordKey(billinghistory), value)
+}
 
-	return nil
+func (k Keeper) nextBillingHistoryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get(types.BillingHistorySeqKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	id++
+	store.Set(types.BillingHistorySeqKey, binary.BigEndian.AppendUint64(nil, id))
+	return id
 }
 
 func (k Keeper) GetChainletBillingHistory(ctx sdk.Context, chainId string) ([]*types.BillingHistory, error) {
 	var bh []*types.BillingHistory
 
 	// Get the store
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.BillingHistoryKey, chainId)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingHistoryKey)
+	store = prefix.NewStore(store, types.BillingHistoryPrefix(chainId, ""))
 	it := store.Iterator(nil, nil)
+	defer it.Close()
 	if !it.Valid() {
 		return nil, cosmossdkerrors.Wrapf(types.ErrNoRecords, "no billing history found for chain %s", chainId)
 	}
 
-	// get chainlet info to fill in the details for returning to the user
-	chainletReq := &chainlettypes.QueryGetChainletRequest{ChainId: chainId}
-
-	chainletRes, err := k.chainletkeeper.GetChainlet(ctx, chainletReq)
-	if err != nil {
-		return nil, cosmossdkerrors.Wrapf(types.ErrInternalFailure, "could not retrieve chainlet info for chain %s. Error: %v", chainId, err)
-	}
-
-	for val := it.Value(); it.Valid(); it.Next() {
-		var sbhr types.SaveBillingHistory
-		k.cdc.MustUnmarshal(val, &sbhr)
-		// get epoch info
-		epochInfo := k.epochskeeper.GetEpochInfo(ctx, sbhr.EpochIdentifier)
-		epochSince := (epochInfo.CurrentEpoch - sbhr.EpochNumber)
-		epochEventStartTime := epochInfo.CurrentEpochStartTime.Add(-time.Duration(epochSince * int64(epochInfo.Duration)))
-		bhr := types.BillingHistory{
-			ChainletId:        sbhr.ChainletId,
-			ChainletName:      chainletRes.Chainlet.ChainletName,
-			ChainletOwner:     chainletRes.Chainlet.Launcher,
-			ChainletStackName: chainletRes.Chainlet.ChainletStackName,
-			EpochIdentifier:   sbhr.EpochIdentifier,
-			EpochNumber:       sbhr.EpochNumber,
-			EpochStartTime:    epochEventStartTime.Format(time.RFC3339),
-			BilledAmount:      sbhr.BilledAmount,
-		}
+	for ; it.Valid(); it.Next() {
+		var bhr types.BillingHistory
+		k.cdc.MustUnmarshal(it.Value(), &bhr)
 		bh = append(bh, &bhr)
 	}
 	return bh, nil
@@ -142,17 +156,16 @@ func (k Keeper) GetChainletBillingHistory(ctx sdk.Context, chainId string) ([]*t
 
 func (k Keeper) SaveValidatorPayoutHistory(ctx sdk.Context, payouthistory types.ValidatorPayoutHistory) error {
 	// Get the store
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.ValidatorPayoutHistoryKey, payouthistory.ValidatorAddress)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorPayoutHistoryKey)
 
-	uniqueBillingRecord := fmt.Sprintf("%s-%d", payouthistory.EpochIdentifier, payouthistory.EpochNumber)
-	byteKey := []byte(uniqueBillingRecord)
+	byteKey := types.ValidatorPayoutHistoryRecordKey(payouthistory)
 	value := k.cdc.MustMarshal(&payouthistory)
 	if len(value) == 0 {
 		return cosmossdkerrors.Wrapf(types.ErrJSONMarhsal, "could not marshal validator payout history input for appending to the kv store")
 	}
 	if store.Has(byteKey) {
 		// cannot add a duplicate billing record so return an error
-		return cosmossdkerrors.Wrapf(types.ErrDuplicateRecord, "cannot add validator payout record %v as it already exists", uniqueBillingRecord)
+		return cosmossdkerrors.Wrapf(types.ErrDuplicateRecord, "cannot add validator payout record %s-%d as it already exists", payouthistory.EpochIdentifier, payouthistory.EpochNumber)
 	} else {
 		store.Set(byteKey, value)
 	}
@@ -164,27 +177,40 @@ func (k Keeper) GetKprValidatorPayoutHistory(ctx sdk.Context, validatorAddress s
 	var vph []*types.ValidatorPayoutHistory
 
 	// Get the store
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.ValidatorPayoutHistoryKey, validatorAddress)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorPayoutHistoryKey)
+	store = prefix.NewStore(store, types.ValidatorPayoutHistoryPrefix(validatorAddress, ""))
 	it := store.Iterator(nil, nil)
+	defer it.Close()
 	if !it.Valid() {
 		return nil, cosmossdkerrors.Wrapf(types.ErrNoRecords, "no validator payout history found for validator %s", validatorAddress)
 	}
 
-	for val := it.Value(); it.Valid(); it.Next() {
+	for ; it.Valid(); it.Next() {
 		var vphr types.ValidatorPayoutHistory
-		k.cdc.MustUnmarshal(val, &vphr)
+		k.cdc.MustUnmarshal(it.Value(), &vphr)
 		vph = append(vph, &vphr)
-
 	}
 	return vph, nil
 }
 
 func (k Keeper) BillAndRestartChainlet(ctx sdk.Context, chainId string) error {
-	started, err := k.chainletkeeper.IsChainletStarted(ctx, chainId)
+	chainlet, err := k.chainletkeeper.GetChainletInfo(ctx, chainId)
 	if err != nil {
 		return err
 	}
-	if started {
+	// Only chainlets stopped for non-payment are restarted, admin suspensions stay in place
+	if chainlet.Status != chainlettypes.Status_STATUS_SUSPENDED_BILLING {
+		// Settle the debt of running chainlets if the escrow covers it by now
+		if _, found := k.getChainletDebt(ctx, chainId); !found || !chainlet.Status.IsActive() {
+			return nil
+		}
+		stack, err := k.chainletkeeper.GetChainletStackInfo(ctx, chainId)
+		if err != nil {
+			return err
+		}
+		if err := k.settleDebt(ctx, *chainlet, stack); err != nil {
+			ctx.Logger().Info("could not settle debt of chainlet " + chainId + ": " + err.Error())
+		}
 		return nil
 	}
 
@@ -193,6 +219,12 @@ func (k Keeper) BillAndRestartChainlet(ctx sdk.Context, chainId string) error {
 		return err
 	}
 
+	// The debt accrued before the chainlet was stopped has to be settled first
+	err = k.settleDebt(ctx, *chainlet, stack)
+	if err != nil {
+		return err
+	}
+
 	billed := false
 	for _, feeOption := range stack.Fees {
 		epochfee, err := sdk.ParseCoinNormalized(feeOption.EpochFee)
@@ -200,13 +232,8 @@ func (k Keeper) BillAndRestartChainlet(ctx sdk.Context, chainId string) error {
 			return err
 		}
 
-		chainlet, err := k.chainletkeeper.GetChainletInfo(ctx, chainId)
-		if err != nil {
-			return err
-		}
-
 		// Check if there is enough funds to restart the chainlet
-		err = k.BillAccount(ctx, epochfee, *chainlet, "restarting chainlet")
+		err = k.BillEpochFee(ctx, epochfee, sdk.NewCoin(epochfee.Denom, math.ZeroInt()), *chainlet, "restarting chainlet")
 		if err == nil {
 			billed = true
 			break
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k Keeper) GetBillingCredit(ctx sdk.Context, id uint64) (types.BillingCredit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingCreditKey)
+	bz := store.Get(types.BillingCreditIDKey(id))
+	if bz == nil {
+		return types.BillingCredit{}, false
+	}
+	var credit types.BillingCredit
+	k.cdc.MustUnmarshal(bz, &credit)
+	return credit, true
+}
+
+// creditIndex returns the index of the credits of chainlets or of launchers, and the owner of
+// the credit in it.
+func (k Keeper) creditIndex(ctx sdk.Context, credit types.BillingCredit) (prefix.Store, string) {
+	if credit.ChainId != "" {
+		return prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletCreditKey), credit.ChainId
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.LauncherCreditKey), credit.Launcher
+}
+
+func (k Keeper) setBillingCredit(ctx sdk.Context, credit types.BillingCredit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingCreditKey)
+	store.Set(types.BillingCreditIDKey(credit.Id), k.cdc.MustMarshal(&credit))
+
+	index, owner := k.creditIndex(ctx, credit)
+	index.Set(types.CreditIndexKey(owner, credit.Id), []byte{})
+}
+
+func (k Keeper) deleteBillingCredit(ctx sdk.Context, credit types.BillingCredit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingCreditKey)
+	store.Delete(types.BillingCreditIDKey(credit.Id))
+
+	index, owner := k.creditIndex(ctx, credit)
+	index.Delete(types.CreditIndexKey(owner, credit.Id))
+}
+
+func (k Keeper) nextBillingCreditID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get(types.BillingCreditSeqKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	id++
+	store.Set(types.BillingCreditSeqKey, binary.BigEndian.AppendUint64(nil, id))
+	return id
+}
+
+// IssueBillingCredit issues a credit paying the epoch fees of a chainlet, or of all chainlets of
+// a launcher, until it is used up or expires.
+func (k Keeper) IssueBillingCredit(ctx sdk.Context, chainId, launcher string, amount sdk.Coin, expiry time.Time, memo string) (uint64, error) {
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidCredit.Wrapf("expiry %s is not after the block time", expiry.Format(time.RFC3339))
+	}
+
+	credit := types.BillingCredit{
+		Id:       k.nextBillingCreditID(ctx),
+		ChainId:  chainId,
+		Launcher: launcher,
+		Amount:   amount,
+		Issued:   amount,
+		Expiry:   expiry,
+		Memo:     memo,
+	}
+	k.setBillingCredit(ctx, credit)
+
+	//nolint:errcheck // Event emission errors are non-critical
+	ctx.EventManager().EmitTypedEvent(&types.EventBillingCreditIssued{
+		Id:       credit.Id,
+		ChainId:  chainId,
+		Launcher: launcher,
+		Amount:   amount.String(),
+		Expiry:   expiry.Format(time.RFC3339),
+		Memo:     memo,
+	})
+	return credit.Id, nil
+}
+
+// RevokeBillingCredit removes the unused part of a credit.
+func (k Keeper) RevokeBillingCredit(ctx sdk.Context, id uint64) error {
+	credit, found := k.GetBillingCredit(ctx, id)
+	if !found {
+		return types.ErrNoRecords.Wrapf("billing credit %d not found", id)
+	}
+	k.deleteBillingCredit(ctx, credit)
+	return nil
+}
+
+// creditsOf returns the unexpired credits issued to a chainlet or launcher from the given index.
+func (k Keeper) creditsOf(ctx sdk.Context, indexKey []byte, owner string) []types.BillingCredit {
+	index := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	it := storetypes.KVStorePrefixIterator(index, types.CreditIndexPrefix(owner))
+	defer it.Close()
+
+	var credits []types.BillingCredit
+	for ; it.Valid(); it.Next() {
+		id := binary.BigEndian.Uint64(it.Key()[len(types.CreditIndexPrefix(owner)):])
+		credit, found := k.GetBillingCredit(ctx, id)
+		if !found || !ctx.BlockTime().Before(credit.Expiry) {
+			continue
+		}
+		credits = append(credits, credit)
+	}
+	return credits
+}
+
+// usableCredits returns the credits that can pay fees of a chainlet in the given denom, the ones
+// expiring first before the others.
+func (k Keeper) usableCredits(ctx sdk.Context, chainlet chainlettypes.Chainlet, denom string) []types.BillingCredit {
+	var credits []types.BillingCredit
+	candidates := append(k.creditsOf(ctx, types.ChainletCreditKey, chainlet.ChainId), k.creditsOf(ctx, types.LauncherCreditKey, chainlet.Launcher)...)
+	for _, credit := range candidates {
+		if credit.Amount.Denom == denom && credit.Amount.IsPositive() {
+			credits = append(credits, credit)
+		}
+	}
+	sort.Slice(credits, func(i, j int) bool {
+		if !credits[i].Expiry.Equal(credits[j].Expiry) {
+			return credits[i].Expiry.Before(credits[j].Expiry)
+		}
+		return credits[i].Id < credits[j].Id
+	})
+	return credits
+}
+
+// billWithCredits bills a chainlet, paying as much of the amount as possible with its credits and
+// the rest from its escrow. Credits are only used if the escrow covers the rest. It returns the
+// amount paid with credits and the revenue paid to the stack creator.
+func (k Keeper) billWithCredits(ctx sdk.Context, amount sdk.Coin, chainlet chainlettypes.Chainlet, memo string) (sdk.Coin, sdk.Coin, error) {
+	credits := k.usableCredits(ctx, chainlet, amount.Denom)
+	credited := sdk.NewCoin(amount.Denom, math.ZeroInt())
+	for _, credit := range credits {
+		credited = credited.AddAmount(math.MinInt(credit.Amount.Amount, amount.Amount.Sub(credited.Amount)))
+	}
+
+	revenue, err := k.billAccount(ctx, amount, credited, chainlet, memo)
+	if err != nil {
+		return sdk.NewCoin(amount.Denom, math.ZeroInt()), sdk.NewCoin(amount.Denom, math.ZeroInt()), err
+	}
+
+	remaining := credited.Amount
+	for _, credit := range credits {
+		if !remaining.IsPositive() {
+			break
+		}
+		used := sdk.NewCoin(amount.Denom, math.MinInt(credit.Amount.Amount, remaining))
+		remaining = remaining.Sub(used.Amount)
+		credit.Amount = credit.Amount.Sub(used)
+		if credit.Amount.IsZero() {
+			k.deleteBillingCredit(ctx, credit)
+		} else {
+			k.setBillingCredit(ctx, credit)
+		}
+
+		//nolint:errcheck // Event emission errors are non-critical
+		ctx.EventManager().EmitTypedEvent(&types.EventBillingCreditUsed{
+			Id:        credit.Id,
+			ChainId:   chainlet.ChainId,
+			Amount:    used.String(),
+			Remaining: credit.Amount.String(),
+		})
+	}
+	return credited, revenue, nil
+}
+
+// pruneExpiredCredits removes the credits that cannot be used anymore.
+func (k Keeper) pruneExpiredCredits(ctx sdk.Context) {
+	for _, credit := range k.ExportBillingCredits(ctx) {
+		if !ctx.BlockTime().Before(credit.Expiry) {
+			k.deleteBillingCredit(ctx, credit)
+		}
+	}
+}
+
+// deleteChainletCredits removes the credits issued to a chainlet, launcher credits stay usable by
+// the other chainlets of the launcher.
+func (k Keeper) deleteChainletCredits(ctx sdk.Context, chainId string) {
+	index := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletCreditKey)
+	it := storetypes.KVStorePrefixIterator(index, types.CreditIndexPrefix(chainId))
+	var ids []uint64
+	for ; it.Valid(); it.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(it.Key()[len(types.CreditIndexPrefix(chainId)):]))
+	}
+	it.Close()
+
+	for _, id := range ids {
+		credit, found := k.GetBillingCredit(ctx, id)
+		if !found {
+			index.Delete(types.CreditIndexKey(chainId, id))
+			continue
+		}
+		k.deleteBillingCredit(ctx, credit)
+	}
+}
+
+// ExportBillingCredits exports all billing credits from the store
+func (k Keeper) ExportBillingCredits(ctx sdk.Context) []types.BillingCredit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingCreditKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var credits []types.BillingCredit
+	for ; it.Valid(); it.Next() {
+		var credit types.BillingCredit
+		k.cdc.MustUnmarshal(it.Value(), &credit)
+		credits = append(credits, credit)
+	}
+	return credits
+}
+
+// ImportBillingCredit imports a single billing credit into the store, keeping the ID sequence
+// ahead of every imported ID.
+func (k Keeper) ImportBillingCredit(ctx sdk.Context, credit types.BillingCredit) {
+	k.setBillingCredit(ctx, credit)
+
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	if bz := store.Get(types.BillingCreditSeqKey); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	if credit.Id > seq {
+		store.Set(types.BillingCreditSeqKey, binary.BigEndian.AppendUint64(nil, credit.Id))
+	}
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestBillingCredits(t *testing.T) {
+	f := setupBillingFixture(t, 1)
+	f.ctx = f.ctx.WithBlockTime(epochStartTime)
+
+	launcherCredit, err := f.keeper.IssueBillingCredit(f.ctx, "", f.chainlet.Launcher, sdk.NewInt64Coin("utsaga", 4), epochStartTime.Add(72*time.Hour), "promotion")
+	require.NoError(t, err)
+	chainletCredit, err := f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 3), epochStartTime.Add(48*time.Hour), "voucher")
+	require.NoError(t, err)
+	_, err = f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("uother", 100), epochStartTime.Add(48*time.Hour), "other denom")
+	require.NoError(t, err)
+	_, err = f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 1), epochStartTime, "expired")
+	require.ErrorIs(t, err, types.ErrInvalidCredit)
+
+	res, err := f.keeper.BillingCredits(f.ctx, &types.QueryBillingCreditsRequest{Launcher: f.chainlet.Launcher})
+	require.NoError(t, err)
+	require.Len(t, res.Credits, 1)
+	require.Equal(t, launcherCredit, res.Credits[0].Id)
+
+	// Credits are kept if the escrow cannot pay the rest
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 3), f.chainlet.ChainId, "billing").Return(fmt.Errorf("insufficient funds"))
+	require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 1))
+	res, err = f.keeper.BillingCredits(f.ctx, &types.QueryBillingCreditsRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Len(t, res.Credits, 2)
+
+	// The credit expiring first is used first, then the escrow pays the rest and the debt
+	gomock.InOrder(
+		f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 3), f.chainlet.ChainId, "billing").Return(nil),
+		f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(nil),
+	)
+	require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 2))
+	_, found := f.keeper.GetBillingCredit(f.ctx, chainletCredit)
+	require.False(t, found)
+	_, found = f.keeper.GetBillingCredit(f.ctx, launcherCredit)
+	require.False(t, found)
+
+	history, err := f.keeper.GetChainletBillingHistory(f.ctx, f.chainlet.ChainId)
+	require.NoError(t, err)
+	var credited []string
+	for _, record := range history {
+		if record.CreditUsed != "" {
+			require.Equal(t, "3utsaga", record.BilledAmount)
+			credited = append(credited, record.CreditUsed)
+		}
+	}
+	require.Equal(t, []string{"7utsaga"}, credited)
+
+	// A credit covering the whole fee leaves the escrow untouched
+	bigCredit, err := f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 15), epochStartTime.Add(48*time.Hour), "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 3))
+	credit, found := f.keeper.GetBillingCredit(f.ctx, bigCredit)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("utsaga", 5), credit.Amount)
+	require.Equal(t, sdk.NewInt64Coin("utsaga", 15), credit.Issued)
+
+	// Expired credits are not used and removed
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(48 * time.Hour))
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(nil)
+	require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 4))
+	require.Empty(t, f.keeper.ExportBillingCredits(f.ctx))
+}
+
+func TestRevokeBillingCredit(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.ctx = f.ctx.WithBlockTime(epochStartTime)
+
+	id, err := f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 10), epochStartTime.Add(time.Hour), "")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.RevokeBillingCredit(f.ctx, id))
+	require.ErrorIs(t, f.keeper.RevokeBillingCredit(f.ctx, id), types.ErrNoRecords)
+
+	res, err := f.keeper.BillingCredits(f.ctx, &types.QueryBillingCreditsRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Empty(t, res.Credits)
+
+	// IDs are not reused
+	next, err := f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 10), epochStartTime.Add(time.Hour), "")
+	require.NoError(t, err)
+	require.Equal(t, id+1, next)
+}
+
+func TestBillingCreditsOnDecommission(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.ctx = f.ctx.WithBlockTime(epochStartTime)
+
+	launcherCredit, err := f.keeper.IssueBillingCredit(f.ctx, "", f.chainlet.Launcher, sdk.NewInt64Coin("utsaga", 10), epochStartTime.Add(time.Hour), "")
+	require.NoError(t, err)
+	_, err = f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 10), epochStartTime.Add(time.Hour), "")
+	require.NoError(t, err)
+	_, err = f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("uother", 10), epochStartTime.Add(time.Hour), "")
+	require.NoError(t, err)
+
+	// Only the launcher credit outlives the chainlet
+	require.NoError(t, f.keeper.OnChainletDecommissioned(f.ctx, f.chainlet))
+	res, err := f.keeper.BillingCredits(f.ctx, &types.QueryBillingCreditsRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Empty(t, res.Credits)
+	credits := f.keeper.ExportBillingCredits(f.ctx)
+	require.Len(t, credits, 1)
+	require.Equal(t, launcherCredit, credits[0].Id)
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/sagaxyz/ssc/testutil/keeper"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestBillingHistory(t *testing.T) {
+	k, ctx := testkeeper.BillingKeeper(t)
+
+	records := []types.BillingHistory{
+		{ChainletId: "chain-1", ChainletName: "one", ChainletOwner: "owner1", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "10usaga"},
+		{ChainletId: "chain-1", ChainletName: "one", ChainletOwner: "owner1", EpochIdentifier: "day", EpochNumber: 2, BilledAmount: "10usaga", Failed: true},
+		{ChainletId: "chain-1", ChainletName: "one", ChainletOwner: "owner1", EpochIdentifier: "day", EpochNumber: 2, BilledAmount: "10usaga"},
+		{ChainletId: "chain-1", ChainletName: "one", ChainletOwner: "owner1", EpochIdentifier: "day", EpochNumber: 3, BilledAmount: "10usaga"},
+		{ChainletId: "chain-1", ChainletName: "one", ChainletOwner: "owner1", EpochIdentifier: "week", EpochNumber: 1, BilledAmount: "70usaga"},
+		// Chain ID sharing a prefix with chain-1
+		{ChainletId: "chain-10", ChainletName: "ten", ChainletOwner: "owner10", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "20usaga"},
+	}
+	for _, record := range records {
+		require.NoError(t, k.SaveBillingHistory(ctx, record))
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryGetBillingHistoryRequest
+		expected []uint64
+	}{
+		{
+			name:     "all records of a chainlet",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-1"},
+			expected: []uint64{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "no records of a chain ID sharing the prefix",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-10"},
+			expected: []uint64{6},
+		},
+		{
+			name:     "epoch identifier",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", EpochIdentifier: "week"},
+			expected: []uint64{5},
+		},
+		{
+			name:     "epoch range",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", EpochIdentifier: "day", FromEpoch: 2, ToEpoch: 2},
+			expected: []uint64{2, 3},
+		},
+		{
+			name:     "failures",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", Result: types.ResultFilter_RESULT_FILTER_FAILURE},
+			expected: []uint64{2},
+		},
+		{
+			name:     "successes from epoch 2",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", EpochIdentifier: "day", FromEpoch: 2, Result: types.ResultFilter_RESULT_FILTER_SUCCESS},
+			expected: []uint64{3, 4},
+		},
+		{
+			name:     "paginated",
+			req:      &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", Pagination: &query.PageRequest{Offset: 1, Limit: 2}},
+			expected: []uint64{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := k.GetBillingHistory(ctx, tt.req)
+			require.NoError(t, err)
+
+			var ids []uint64
+			for _, record := range res.Billhistory {
+				ids = append(ids, record.Id)
+			}
+			require.Equal(t, tt.expected, ids)
+		})
+	}
+
+	res, err := k.GetBillingHistory(ctx, &types.QueryGetBillingHistoryRequest{ChainId: "chain-10"})
+	require.NoError(t, err)
+	require.Equal(t, "ten", res.Billhistory[0].ChainletName)
+	require.Equal(t, "owner10", res.Billhistory[0].ChainletOwner)
+
+	_, err = k.GetBillingHistory(ctx, &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", FromEpoch: 3, ToEpoch: 2})
+	require.Error(t, err)
+	_, err = k.GetBillingHistory(ctx, &types.QueryGetBillingHistoryRequest{ChainId: "chain-1", Result: 3})
+	require.Error(t, err)
+}
+
+func TestValidatorPayoutHistory(t *testing.T) {
+	k, ctx := testkeeper.BillingKeeper(t)
+
+	records := []types.ValidatorPayoutHistory{
+		{ValidatorAddress: "val1", EpochIdentifier: "day", EpochNumber: 1, RewardAmount: "5usaga"},
+		{ValidatorAddress: "val1", EpochIdentifier: "day", EpochNumber: 2, RewardAmount: "5usaga", Failed: true},
+		{ValidatorAddress: "val1", EpochIdentifier: "day", EpochNumber: 3, RewardAmount: "5usaga"},
+		{ValidatorAddress: "val10", EpochIdentifier: "day", EpochNumber: 1, RewardAmount: "5usaga"},
+	}
+	for _, record := range records {
+		require.NoError(t, k.SaveValidatorPayoutHistory(ctx, record))
+	}
+	require.Error(t, k.SaveValidatorPayoutHistory(ctx, records[0]))
+
+	res, err := k.GetValidatorPayoutHistory(ctx, &types.QueryGetValidatorPayoutHistoryRequest{ValidatorAddress: "val1"})
+	require.NoError(t, err)
+	require.Len(t, res.Validatorpayouthistory, 3)
+
+	res, err = k.GetValidatorPayoutHistory(ctx, &types.QueryGetValidatorPayoutHistoryRequest{ValidatorAddress: "val1", Result: types.ResultFilter_RESULT_FILTER_SUCCESS, ToEpoch: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Validatorpayouthistory, 1)
+	require.Equal(t, int64(1), res.Validatorpayouthistory[0].EpochNumber)
+
+	res, err = k.GetValidatorPayoutHistory(ctx, &types.QueryGetValidatorPayoutHistoryRequest{ValidatorAddress: "val1", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Validatorpayouthistory, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+}
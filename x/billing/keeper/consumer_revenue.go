@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k Keeper) getConsumerRevenue(ctx sdk.Context, chainId string) types.ChainletRevenue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConsumerRevenueKey)
+	bz := store.Get([]byte(chainId))
+	if bz == nil {
+		return types.ChainletRevenue{ChainId: chainId}
+	}
+	var revenue types.ChainletRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue
+}
+
+func (k Keeper) setConsumerRevenue(ctx sdk.Context, revenue types.ChainletRevenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConsumerRevenueKey)
+	if revenue.Amount.IsZero() {
+		store.Delete([]byte(revenue.ChainId))
+		return
+	}
+	store.Set([]byte(revenue.ChainId), k.cdc.MustMarshal(&revenue))
+}
+
+// attributeConsumerRevenue records the epoch fees billed to a CCV consumer chainlet until they are
+// paid out to the validators that opted in to it. The fees of other chainlets are not tracked.
+func (k Keeper) attributeConsumerRevenue(ctx sdk.Context, chainlet chainlettypes.Chainlet, amount sdk.Coins) {
+	if !chainlet.IsCCVConsumer || amount.IsZero() {
+		return
+	}
+	revenue := k.getConsumerRevenue(ctx, chainlet.ChainId)
+	revenue.Amount = revenue.Amount.Add(amount...)
+	k.setConsumerRevenue(ctx, revenue)
+}
+
+// deductConsumerRevenue removes an amount returned to a CCV consumer chainlet from its revenue.
+func (k Keeper) deductConsumerRevenue(ctx sdk.Context, chainId string, amount sdk.Coins) {
+	revenue := k.getConsumerRevenue(ctx, chainId)
+	revenue.Amount = revenue.Amount.Sub(revenue.Amount.Min(amount)...)
+	k.setConsumerRevenue(ctx, revenue)
+}
+
+// takeConsumerRevenues returns the revenue of all CCV consumer chainlets since the last payout
+// and resets it.
+func (k Keeper) takeConsumerRevenues(ctx sdk.Context) []types.ChainletRevenue {
+	revenues := k.ExportConsumerRevenues(ctx)
+	for _, revenue := range revenues {
+		k.setConsumerRevenue(ctx, types.ChainletRevenue{ChainId: revenue.ChainId})
+	}
+	return revenues
+}
+
+// optedInValidators returns the account addresses of the validators that opted in to a CCV
+// consumer chainlet, nothing if the chainlet is not a consumer chain anymore.
+func (k Keeper) optedInValidators(ctx sdk.Context, chainId string) []string {
+	chainlet, err := k.chainletkeeper.GetChainletInfo(ctx, chainId)
+	if err != nil {
+		ctx.Logger().Error("could not get chainlet " + chainId + ". Error: " + err.Error())
+		return nil
+	}
+	if !chainlet.IsCCVConsumer || chainlet.ConsumerId == "" {
+		return nil
+	}
+
+	var validatorAddrs []string
+	for _, providerAddr := range k.providerkeeper.GetAllOptedIn(ctx, chainlet.ConsumerId) {
+		validator, err := k.stakingkeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+		if err != nil {
+			ctx.Logger().Error("could not get validator " + providerAddr.String() + " opted in to chainlet " + chainId + ". Error: " + err.Error())
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			ctx.Logger().Error("could not parse validator address: " + validator.OperatorAddress)
+			continue
+		}
+		validatorAddrs = append(validatorAddrs, sdk.AccAddress(valAddr).String())
+	}
+	return validatorAddrs
+}
+
+// ExportConsumerRevenues exports the revenue of CCV consumer chainlets not paid out yet from the store
+func (k Keeper) ExportConsumerRevenues(ctx sdk.Context) []types.ChainletRevenue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConsumerRevenueKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var revenues []types.ChainletRevenue
+	for ; it.Valid(); it.Next() {
+		var revenue types.ChainletRevenue
+		k.cdc.MustUnmarshal(it.Value(), &revenue)
+		revenues = append(revenues, revenue)
+	}
+	return revenues
+}
+
+// ImportConsumerRevenue imports the revenue of a single CCV consumer chainlet into the store
+func (k Keeper) ImportConsumerRevenue(ctx sdk.Context, revenue types.ChainletRevenue) {
+	k.setConsumerRevenue(ctx, revenue)
+}
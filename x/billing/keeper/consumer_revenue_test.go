@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	billingtestutil "github.com/sagaxyz/ssc/x/billing/testutil"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestConsumerRevenueToOptedInValidators(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.chainlet.IsCCVConsumer = true
+	f.chainlet.ConsumerId = "7"
+	val1 := sdk.AccAddress("val1")
+	val2 := sdk.AccAddress("val2")
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.PlatformValidators = []string{val1.String(), val2.String()}
+	f.keeper.SetParams(f.ctx, params)
+
+	providerKeeper := billingtestutil.NewMockProviderKeeper(gomock.NewController(t))
+	f.keeper.UpdateKeeper(providerKeeper)
+
+	// Only validator 3, which is not a platform validator, opted in to the consumer chain
+	optedIn := sdk.AccAddress("val3")
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(optedIn).String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	providerKeeper.EXPECT().GetAllOptedIn(gomock.Any(), "7").Return([]ccvprovidertypes.ProviderConsAddress{ccvprovidertypes.NewProviderConsAddress(consAddr)}).AnyTimes()
+	f.stakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), sdk.ConsAddress(consAddr)).Return(validator, nil).AnyTimes()
+
+	// The chainlet pays 10 out of the 30 held by the module account
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), gomock.Any(), f.chainlet.ChainId, "billing").Return(nil)
+	require.NoError(t, f.keeper.BillAccount(f.ctx, sdk.NewInt64Coin("utsaga", 10), f.chainlet, "epoch"))
+	require.Len(t, f.keeper.ExportConsumerRevenues(f.ctx), 1)
+
+	f.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAccount.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin("utsaga", 30))).AnyTimes()
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 1))
+	require.Empty(t, f.keeper.ExportConsumerRevenues(f.ctx))
+
+	for _, addr := range []sdk.AccAddress{val1, val2, optedIn} {
+		res, err := f.keeper.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{ValidatorAddress: addr.String()})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 10)), res.Amount)
+	}
+
+	history, err := f.keeper.GetKprValidatorPayoutHistory(f.ctx, optedIn.String())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, []types.ChainletRevenue{{ChainId: f.chainlet.ChainId, Amount: sdk.NewCoins(sdk.NewInt64Coin("utsaga", 10))}}, history[0].ChainletRevenues)
+
+	history, err = f.keeper.GetKprValidatorPayoutHistory(f.ctx, val1.String())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Empty(t, history[0].ChainletRevenues)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k Keeper) getChainletDebt(ctx sdk.Context, chainId string) (debt types.ChainletDebt, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletDebtKey)
+	bz := store.Get([]byte(chainId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &debt)
+	found = true
+	return
+}
+
+func (k Keeper) setChainletDebt(ctx sdk.Context, debt types.ChainletDebt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletDebtKey)
+	store.Set([]byte(debt.ChainId), k.cdc.MustMarshal(&debt))
+}
+
+func (k Keeper) deleteChainletDebt(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletDebtKey)
+	store.Delete([]byte(chainId))
+}
+
+// accrueDebt adds a missed epoch to the debt of a chainlet. It returns false, leaving the
+// debt as it was, once the grace period is exhausted and the chainlet has to be stopped.
+func (k Keeper) accrueDebt(ctx sdk.Context, chainId, epochIdentifier string, epochNumber int64) bool {
+	gracePeriod := k.GetParams(ctx).GracePeriodEpochs
+
+	debt, found := k.getChainletDebt(ctx, chainId)
+	if !found {
+		debt = types.ChainletDebt{
+			ChainId:          chainId,
+			EpochIdentifier:  epochIdentifier,
+			FirstUnpaidEpoch: epochNumber,
+		}
+	}
+	if debt.UnpaidEpochs+1 > gracePeriod {
+		return false
+	}
+	debt.UnpaidEpochs++
+	k.setChainletDebt(ctx, debt)
+
+	//nolint:errcheck // Event emission errors are non-critical
+	ctx.EventManager().EmitTypedEvent(&types.EventBillingGracePeriod{
+		ChainId:         chainId,
+		EpochIdentifier: epochIdentifier,
+		EpochNumber:     epochNumber,
+		UnpaidEpochs:    debt.UnpaidEpochs,
+		GraceEpochsLeft: gracePeriod - debt.UnpaidEpochs,
+	})
+	return true
+}
+
+// debtAmounts returns the amount settling the debt for each fee option of the stack.
+func debtAmounts(debt types.ChainletDebt, stack *chainlettypes.ChainletStack) ([]sdk.Coin, error) {
+	var amounts []sdk.Coin
+	for _, fee := range stack.Fees {
+		epochFee, err := sdk.ParseCoinNormalized(fee.EpochFee)
+		if err != nil {
+			return nil, err
+		}
+		amounts = append(amounts, sdk.NewCoin(epochFee.Denom, epochFee.Amount.Mul(math.NewIntFromUint64(debt.UnpaidEpochs))))
+	}
+	return amounts, nil
+}
+
+// settleDebt bills the outstanding debt of a chainlet using the first fee option the escrow
+// can cover.
+func (k Keeper) settleDebt(ctx sdk.Context, chainlet chainlettypes.Chainlet, stack *chainlettypes.ChainletStack) error {
+	debt, found := k.getChainletDebt(ctx, chainlet.ChainId)
+	if !found {
+		return nil
+	}
+
+	amounts, err := debtAmounts(debt, stack)
+	if err != nil {
+		return err
+	}
+	for _, amount := range amounts {
+		err = k.BillAccount(ctx, amount, chainlet, "debt-settlement")
+		if err != nil {
+			continue
+		}
+
+		k.deleteChainletDebt(ctx, chainlet.ChainId)
+		ctx.Logger().Info(fmt.Sprintf("settled debt of %d epochs for %s with %s", debt.UnpaidEpochs, chainlet.ChainId, amount.String()))
+		//nolint:errcheck // Event emission errors are non-critical
+		ctx.EventManager().EmitTypedEvent(&types.EventBillingDebtSettled{
+			ChainId:      chainlet.ChainId,
+			Amount:       amount.String(),
+			UnpaidEpochs: debt.UnpaidEpochs,
+		})
+		return nil
+	}
+
+	return cosmossdkerrors.Wrapf(types.ErrInternalBillingFailure, "could not settle debt of %d epochs for chainlet %s", debt.UnpaidEpochs, chainlet.ChainId)
+}
+
+// ExportChainletDebts exports all outstanding chainlet debts from the store
+func (k Keeper) ExportChainletDebts(ctx sdk.Context) []types.ChainletDebt {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletDebtKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var debts []types.ChainletDebt
+	for ; iterator.Valid(); iterator.Next() {
+		var debt types.ChainletDebt
+		k.cdc.MustUnmarshal(iterator.Value(), &debt)
+		debts = append(debts, debt)
+	}
+	return debts
+}
+
+// ImportChainletDebt imports a single chainlet debt into the store
+func (k Keeper) ImportChainletDebt(ctx sdk.Context, debt types.ChainletDebt) {
+	k.setChainletDebt(ctx, debt)
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func TestGracePeriod(t *testing.T) {
+	f := setupBillingFixture(t, 2)
+	insufficientFunds := fmt.Errorf("insufficient funds")
+
+	// Two failed epochs keep the chainlet running
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(insufficientFunds).Times(3)
+	for epoch := int64(1); epoch <= 2; epoch++ {
+		require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, epoch))
+	}
+	res := f.debt(t)
+	require.Equal(t, int64(1), res.Debt.FirstUnpaidEpoch)
+	require.Equal(t, uint64(2), res.Debt.UnpaidEpochs)
+	require.Equal(t, uint64(0), res.GraceEpochsLeft)
+	require.Equal(t, []string{"20utsaga"}, res.AmountsDue)
+
+	var warnings int
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type == "ssc.billing.EventBillingGracePeriod" {
+			warnings++
+		}
+	}
+	require.Equal(t, 2, warnings)
+
+	// The third failed epoch exhausts the grace period
+	f.chainletKeeper.EXPECT().StopChainlet(gomock.Any(), f.chainlet.ChainId).Return(nil)
+	require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 3))
+	f.chainlet.Status = chainlettypes.Status_STATUS_SUSPENDED_BILLING
+	require.Equal(t, uint64(2), f.debt(t).Debt.UnpaidEpochs)
+
+	// Restarting requires settling the debt first
+	gomock.InOrder(
+		f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 20), f.chainlet.ChainId, "billing").Return(nil),
+		f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(nil),
+	)
+	f.chainletKeeper.EXPECT().StartExistingChainlet(gomock.Any(), f.chainlet.ChainId).Return(nil)
+	require.NoError(t, f.keeper.BillAndRestartChainlet(f.ctx, f.chainlet.ChainId))
+	require.Equal(t, uint64(0), f.debt(t).Debt.UnpaidEpochs)
+	require.Equal(t, uint64(2), f.debt(t).GraceEpochsLeft)
+}
+
+func TestSettleDebtOnRefill(t *testing.T) {
+	f := setupBillingFixture(t, 3)
+
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(fmt.Errorf("insufficient funds"))
+	require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 1))
+	require.Equal(t, uint64(1), f.debt(t).Debt.UnpaidEpochs)
+
+	// A deposit that does not cover the debt keeps it in place without failing
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(fmt.Errorf("insufficient funds"))
+	require.NoError(t, f.keeper.BillAndRestartChainlet(f.ctx, f.chainlet.ChainId))
+	require.Equal(t, uint64(1), f.debt(t).Debt.UnpaidEpochs)
+
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(nil)
+	require.NoError(t, f.keeper.BillAndRestartChainlet(f.ctx, f.chainlet.ChainId))
+	require.Equal(t, uint64(0), f.debt(t).Debt.UnpaidEpochs)
+
+	res, err := f.keeper.GetBillingHistory(f.ctx, &types.QueryGetBillingHistoryRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Len(t, res.Billhistory, 3)
+	require.Equal(t, "debt-settlement", res.Billhistory[2].Memo)
+	require.False(t, res.Billhistory[2].Failed)
+}
+
+func TestDebtOnDecommission(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		billErr   error
+		writeOffs int
+	}{
+		{"settled from escrow", nil, 0},
+		{"written off", fmt.Errorf("insufficient funds"), 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setupBillingFixture(t, 3)
+
+			f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(fmt.Errorf("insufficient funds"))
+			require.NoError(t, f.keeper.BeforeEpochStart(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 1))
+			require.Len(t, f.keeper.ExportChainletDebts(f.ctx), 1)
+
+			// The debt is settled before the escrow is refunded, or written off if it cannot be
+			f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").Return(tc.billErr)
+			require.NoError(t, f.keeper.OnChainletDecommissioned(f.ctx, f.chainlet))
+			require.Empty(t, f.keeper.ExportChainletDebts(f.ctx))
+
+			var writeOffs int
+			for _, event := range f.ctx.EventManager().Events() {
+				if event.Type == "ssc.billing.EventBillingDebtWrittenOff" {
+					writeOffs++
+				}
+			}
+			require.Equal(t, tc.writeOffs, writeOffs)
+		})
+	}
+}
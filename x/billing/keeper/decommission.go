@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// OnChainletDecommissioned removes the billing state of a chainlet being decommissioned. It has
+// to be called before its escrow is refunded: the outstanding debt is settled from the escrow if
+// it covers it, and written off otherwise.
+func (k Keeper) OnChainletDecommissioned(ctx sdk.Context, chainlet chainlettypes.Chainlet) error {
+	debt, found := k.getChainletDebt(ctx, chainlet.ChainId)
+	if found {
+		stack, err := k.chainletkeeper.GetChainletStackInfo(ctx, chainlet.ChainId)
+		if err != nil {
+			return err
+		}
+		err = k.settleDebt(ctx, chainlet, stack)
+		if err != nil {
+			k.deleteChainletDebt(ctx, chainlet.ChainId)
+			ctx.Logger().Info(fmt.Sprintf("wrote off debt of %d epochs for decommissioned chainlet %s", debt.UnpaidEpochs, chainlet.ChainId))
+			//nolint:errcheck // Event emission errors are non-critical
+			ctx.EventManager().EmitTypedEvent(&types.EventBillingDebtWrittenOff{
+				ChainId:      chainlet.ChainId,
+				UnpaidEpochs: debt.UnpaidEpochs,
+			})
+		}
+	}
+
+	// The charge of the epoch is kept when its unused part is not refunded
+	k.deleteEpochCharge(ctx, chainlet.ChainId)
+	k.deleteLowBalanceAlert(ctx, chainlet.ChainId)
+	k.deleteChainletCredits(ctx, chainlet.ChainId)
+
+	return nil
+}
@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"fmt"
+	"encoding/binary"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,15 +10,15 @@ import (
 )
 
 // ExportBillingHistory exports all billing history records from the store
-func (k Keeper) ExportBillingHistory(ctx sdk.Context) []types.SaveBillingHistory {
+func (k Keeper) ExportBillingHistory(ctx sdk.Context) []types.BillingHistory {
 	// Use prefix store to efficiently iterate only over billing history keys
-	billingStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BillingHistoryKey+"-"))
+	billingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingHistoryKey)
 	iterator := billingStore.Iterator(nil, nil)
 	defer iterator.Close()
 
-	var records []types.SaveBillingHistory
+	var records []types.BillingHistory
 	for ; iterator.Valid(); iterator.Next() {
-		var record types.SaveBillingHistory
+		var record types.BillingHistory
 		k.cdc.MustUnmarshal(iterator.Value(), &record)
 		records = append(records, record)
 	}
@@ -28,7 +28,7 @@ func (k Keeper) ExportBillingHistory(ctx sdk.Context) []types.SaveBillingHistory
 // ExportValidatorPayoutHistory exports all validator payout history records from the store
 func (k Keeper) ExportValidatorPayoutHistory(ctx sdk.Context) []types.ValidatorPayoutHistory {
 	// Use prefix store to efficiently iterate only over validator payout history keys
-	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ValidatorPayoutHistoryKey+"-"))
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorPayoutHistoryKey)
 	iterator := payoutStore.Iterator(nil, nil)
 	defer iterator.Close()
 
@@ -41,18 +41,24 @@ func (k Keeper) ExportValidatorPayoutHistory(ctx sdk.Context) []types.ValidatorP
 	return records
 }
 
-// ImportBillingHistory imports a single billing history record into the store
-func (k Keeper) ImportBillingHistory(ctx sdk.Context, record types.SaveBillingHistory) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.BillingHistoryKey, record.ChainletId)))
-	uniqueKey := fmt.Sprintf("%s-%d", record.EpochIdentifier, record.EpochNumber)
-	value := k.cdc.MustMarshal(&record)
-	store.Set([]byte(uniqueKey), value)
+// ImportBillingHistory imports a single billing history record into the store, keeping the
+// ID sequence ahead of every imported ID.
+func (k Keeper) ImportBillingHistory(ctx sdk.Context, record types.BillingHistory) {
+	k.setBillingHistory(ctx, record)
+
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	if bz := store.Get(types.BillingHistorySeqKey); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	if record.Id > seq {
+		store.Set(types.BillingHistorySeqKey, binary.BigEndian.AppendUint64(nil, record.Id))
+	}
 }
 
 // ImportValidatorPayoutHistory imports a single validator payout history record into the store
 func (k Keeper) ImportValidatorPayoutHistory(ctx sdk.Context, record types.ValidatorPayoutHistory) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.ValidatorPayoutHistoryKey, record.ValidatorAddress)))
-	uniqueKey := fmt.Sprintf("%s-%d", record.EpochIdentifier, record.EpochNumber)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorPayoutHistoryKey)
 	value := k.cdc.MustMarshal(&record)
-	store.Set([]byte(uniqueKey), value)
+	store.Set(types.ValidatorPayoutHistoryRecordKey(record), value)
 }
@@ -1,7 +1,49 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/sagaxyz/ssc/x/billing/types"
 )
 
 var _ types.QueryServer = Keeper{}
+
+// historyFilter holds the record filters shared by the history queries.
+type historyFilter struct {
+	fromEpoch int64
+	toEpoch   int64
+	result    types.ResultFilter
+}
+
+func newHistoryFilter(fromEpoch, toEpoch int64, result types.ResultFilter) (historyFilter, error) {
+	if _, ok := types.ResultFilter_name[int32(result)]; !ok {
+		return historyFilter{}, fmt.Errorf("invalid result filter %d", result)
+	}
+	if fromEpoch < 0 || toEpoch < 0 {
+		return historyFilter{}, fmt.Errorf("epoch range cannot be negative")
+	}
+	if toEpoch != 0 && fromEpoch > toEpoch {
+		return historyFilter{}, fmt.Errorf("fromEpoch %d is after toEpoch %d", fromEpoch, toEpoch)
+	}
+	return historyFilter{
+		fromEpoch: fromEpoch,
+		toEpoch:   toEpoch,
+		result:    result,
+	}, nil
+}
+
+func (f historyFilter) matches(epochNumber int64, failed bool) bool {
+	if f.fromEpoch != 0 && epochNumber < f.fromEpoch {
+		return false
+	}
+	if f.toEpoch != 0 && epochNumber > f.toEpoch {
+		return false
+	}
+	switch f.result {
+	case types.ResultFilter_RESULT_FILTER_SUCCESS:
+		return !failed
+	case types.ResultFilter_RESULT_FILTER_FAILURE:
+		return failed
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BillingCredits(goCtx context.Context, req *types.QueryBillingCreditsRequest) (*types.QueryBillingCreditsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ChainId != "" && req.Launcher != "" {
+		return nil, status.Error(codes.InvalidArgument, "filter by either chain ID or launcher")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Credits are listed by ID, or through the index of the chainlet or launcher they were issued to
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingCreditKey)
+	indexed := req.ChainId != "" || req.Launcher != ""
+	switch {
+	case req.ChainId != "":
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletCreditKey)
+		store = prefix.NewStore(store, types.CreditIndexPrefix(req.ChainId))
+	case req.Launcher != "":
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.LauncherCreditKey)
+		store = prefix.NewStore(store, types.CreditIndexPrefix(req.Launcher))
+	}
+
+	var credits []types.BillingCredit
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var credit types.BillingCredit
+		if indexed {
+			var found bool
+			credit, found = k.GetBillingCredit(ctx, binary.BigEndian.Uint64(key))
+			if !found {
+				return false, nil
+			}
+		} else {
+			k.cdc.MustUnmarshal(value, &credit)
+		}
+		if !ctx.BlockTime().Before(credit.Expiry) {
+			return false, nil
+		}
+		if accumulate {
+			credits = append(credits, credit)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBillingCreditsResponse{Credits: credits, Pagination: pageRes}, nil
+}
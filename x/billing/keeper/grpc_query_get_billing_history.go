@@ -2,14 +2,11 @@ package keeper
 
 import (
 	"context"
-	"fmt"
-	"time"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/sagaxyz/ssc/x/billing/types"
-	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,40 +15,27 @@ func (k Keeper) GetBillingHistory(goCtx context.Context, req *types.QueryGetBill
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	filter, err := newHistoryFilter(req.FromEpoch, req.ToEpoch, req.Result)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.BillingHistoryKey, req.ChainId)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingHistoryKey)
+	store = prefix.NewStore(store, types.BillingHistoryPrefix(req.ChainId, req.EpochIdentifier))
 
 	var bh []*types.BillingHistory
-	// get chainlet info to fill in the details for returning to the user
-	chainletReq := &chainlettypes.QueryGetChainletRequest{ChainId: req.ChainId}
-
-	chainletRes, err := k.chainletkeeper.GetChainlet(ctx, chainletReq)
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve chainlet info for chain %s. Error: %v", req.ChainId, err)
-	}
-
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
-		var sbhr types.SaveBillingHistory
-		k.cdc.MustUnmarshal(value, &sbhr)
-		// get epoch info
-		epochInfo := k.epochskeeper.GetEpochInfo(ctx, sbhr.EpochIdentifier)
-		epochSince := (epochInfo.CurrentEpoch - sbhr.EpochNumber)
-		epochEventStartTime := epochInfo.CurrentEpochStartTime.Add(-time.Duration(epochSince * int64(epochInfo.Duration)))
-		bhr := types.BillingHistory{
-			ChainletId:        sbhr.ChainletId,
-			ChainletName:      chainletRes.Chainlet.ChainletName,
-			ChainletOwner:     chainletRes.Chainlet.Launcher,
-			ChainletStackName: chainletRes.Chainlet.ChainletStackName,
-			EpochIdentifier:   sbhr.EpochIdentifier,
-			EpochNumber:       sbhr.EpochNumber,
-			EpochStartTime:    epochEventStartTime.Format(time.RFC3339),
-			BilledAmount:      sbhr.BilledAmount,
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var bhr types.BillingHistory
+		k.cdc.MustUnmarshal(value, &bhr)
+		if !filter.matches(bhr.EpochNumber, bhr.Failed) {
+			return false, nil
 		}
-		bh = append(bh, &bhr)
-		return nil
+		if accumulate {
+			bh = append(bh, &bhr)
+		}
+		return true, nil
 	})
-
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
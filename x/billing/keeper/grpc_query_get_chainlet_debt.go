@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetChainletDebt(goCtx context.Context, req *types.QueryGetChainletDebtRequest) (*types.QueryGetChainletDebtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gracePeriod := k.GetParams(ctx).GracePeriodEpochs
+
+	debt, found := k.getChainletDebt(ctx, req.ChainId)
+	if !found {
+		return &types.QueryGetChainletDebtResponse{
+			Debt:            types.ChainletDebt{ChainId: req.ChainId},
+			GraceEpochsLeft: gracePeriod,
+		}, nil
+	}
+
+	stack, err := k.chainletkeeper.GetChainletStackInfo(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	amounts, err := debtAmounts(debt, stack)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var amountsDue []string
+	for _, amount := range amounts {
+		amountsDue = append(amountsDue, amount.String())
+	}
+
+	var graceEpochsLeft uint64
+	if gracePeriod > debt.UnpaidEpochs {
+		graceEpochsLeft = gracePeriod - debt.UnpaidEpochs
+	}
+
+	return &types.QueryGetChainletDebtResponse{
+		Debt:            debt,
+		GraceEpochsLeft: graceEpochsLeft,
+		AmountsDue:      amountsDue,
+	}, nil
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,19 +15,27 @@ func (k Keeper) GetValidatorPayoutHistory(goCtx context.Context, req *types.Quer
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	filter, err := newHistoryFilter(req.FromEpoch, req.ToEpoch, req.Result)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s-%s", types.ValidatorPayoutHistoryKey, req.ValidatorAddress)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorPayoutHistoryKey)
+	store = prefix.NewStore(store, types.ValidatorPayoutHistoryPrefix(req.ValidatorAddress, req.EpochIdentifier))
 
 	var vph []*types.ValidatorPayoutHistory
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		var vphr types.ValidatorPayoutHistory
 		k.cdc.MustUnmarshal(value, &vphr)
-		vph = append(vph, &vphr)
-		return nil
+		if !filter.matches(vphr.EpochNumber, vphr.Failed) {
+			return false, nil
+		}
+		if accumulate {
+			vph = append(vph, &vphr)
+		}
+		return true, nil
 	})
-
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingRewards(goCtx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards := k.getPendingRewards(ctx, req.ValidatorAddress)
+
+	return &types.QueryPendingRewardsResponse{
+		Amount:          rewards.Amount,
+		WithdrawAddress: k.GetRewardWithdrawAddress(ctx, req.ValidatorAddress),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateNextBilling bills the chainlets for the next billing epoch on a cached context that is
+// never written, as if the epoch started now or when it is due, whichever is later. The escrow
+// top-ups due at the start of the epoch are deposited first, as they are by the epoch hooks.
+func (k Keeper) SimulateNextBilling(goCtx context.Context, req *types.QuerySimulateNextBillingRequest) (*types.QuerySimulateNextBillingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	epochIdentifier := k.GetParams(ctx).BillingEpoch
+	epochInfo := k.epochskeeper.GetEpochInfo(ctx, epochIdentifier)
+	epochNumber := epochInfo.CurrentEpoch + 1
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager()).WithLogger(log.NewNopLogger())
+	if next := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration); next.After(ctx.BlockTime()) {
+		cacheCtx = cacheCtx.WithBlockTime(next)
+	}
+
+	err := k.escrowkeeper.BeforeEpochStart(cacheCtx, epochIdentifier, epochNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	reports, err := k.billChainlets(cacheCtx, epochIdentifier, epochNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Every chainlet is billed before filtering as chainlets of a launcher share its credits
+	filtered := make([]types.ChainletBillingReport, 0, len(reports))
+	for _, report := range reports {
+		if req.ChainId != "" && report.ChainId != req.ChainId {
+			continue
+		}
+		if req.Launcher != "" && report.Launcher != req.Launcher {
+			continue
+		}
+		filtered = append(filtered, report)
+	}
+
+	return &types.QuerySimulateNextBillingResponse{EpochNumber: epochNumber, Reports: filtered}, nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestSimulateNextBilling(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(time.Hour))
+
+	creditID, err := f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 4), epochStartTime.Add(48*time.Hour), "")
+	require.NoError(t, err)
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	f.escrowKeeper.EXPECT().BeforeEpochStart(gomock.Any(), types.SAGA_EPOCH_IDENTIFIER, int64(1)).Return(nil).Times(3)
+
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 6), f.chainlet.ChainId, "billing").Return(nil)
+	res, err := f.keeper.SimulateNextBilling(f.ctx, &types.QuerySimulateNextBillingRequest{Launcher: f.chainlet.Launcher})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.EpochNumber)
+	require.Equal(t, []types.ChainletBillingReport{{
+		ChainId:         f.chainlet.ChainId,
+		Launcher:        f.chainlet.Launcher,
+		Outcome:         types.BillingOutcome_BILLING_OUTCOME_BILLED,
+		EpochFee:        "10utsaga",
+		BilledAmount:    "6utsaga",
+		CreditUsed:      "4utsaga",
+		SufficientFunds: true,
+	}}, res.Reports)
+
+	// Nothing was written
+	credit, found := f.keeper.GetBillingCredit(f.ctx, creditID)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("utsaga", 4), credit.Amount)
+	_, err = f.keeper.GetChainletBillingHistory(f.ctx, f.chainlet.ChainId)
+	require.ErrorIs(t, err, types.ErrNoRecords)
+	require.Empty(t, f.ctx.EventManager().Events())
+
+	// Without the grace period the chainlet would be stopped
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 6), f.chainlet.ChainId, "billing").Return(fmt.Errorf("insufficient funds"))
+	f.chainletKeeper.EXPECT().StopChainlet(gomock.Any(), f.chainlet.ChainId).Return(nil)
+	res, err = f.keeper.SimulateNextBilling(f.ctx, &types.QuerySimulateNextBillingRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Len(t, res.Reports, 1)
+	require.Equal(t, types.BillingOutcome_BILLING_OUTCOME_STOPPED, res.Reports[0].Outcome)
+	require.False(t, res.Reports[0].SufficientFunds)
+	require.Len(t, res.Reports[0].Reasons, 1)
+
+	// Other chainlets and launchers are filtered out
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 6), f.chainlet.ChainId, "billing").Return(nil)
+	res, err = f.keeper.SimulateNextBilling(f.ctx, &types.QuerySimulateNextBillingRequest{ChainId: "other_2-1"})
+	require.NoError(t, err)
+	require.Empty(t, res.Reports)
+}
+
+func TestSimulateNextBillingTopUp(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(time.Hour))
+
+	// The escrow only covers the fee once the top-up due at the start of the epoch is deposited
+	toppedUp := false
+	gomock.InOrder(
+		f.escrowKeeper.EXPECT().BeforeEpochStart(gomock.Any(), types.SAGA_EPOCH_IDENTIFIER, int64(1)).DoAndReturn(
+			func(ctx sdk.Context, _ string, _ int64) error {
+				require.Equal(t, epochStartTime.Add(24*time.Hour), ctx.BlockTime())
+				toppedUp = true
+				return nil
+			}),
+		f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, "billing").DoAndReturn(
+			func(_ sdk.Context, _ sdk.Coin, _, _ string) error {
+				if !toppedUp {
+					return fmt.Errorf("insufficient funds")
+				}
+				return nil
+			}),
+	)
+	res, err := f.keeper.SimulateNextBilling(f.ctx, &types.QuerySimulateNextBillingRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Len(t, res.Reports, 1)
+	require.Equal(t, types.BillingOutcome_BILLING_OUTCOME_BILLED, res.Reports[0].Outcome)
+
+	// A failing top-up run fails the simulation
+	f.escrowKeeper.EXPECT().BeforeEpochStart(gomock.Any(), types.SAGA_EPOCH_IDENTIFIER, int64(1)).Return(fmt.Errorf("top-up failure"))
+	_, err = f.keeper.SimulateNextBilling(f.ctx, &types.QuerySimulateNextBillingRequest{ChainId: f.chainlet.ChainId})
+	require.Error(t, err)
+}
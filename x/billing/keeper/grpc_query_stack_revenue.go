@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StackRevenue(goCtx context.Context, req *types.QueryStackRevenueRequest) (*types.QueryStackRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	share, _ := k.getStackRevenueShare(ctx, req.StackName)
+	revenue := k.getStackRevenue(ctx, req.StackName)
+
+	return &types.QueryStackRevenueResponse{
+		Share:          share,
+		EffectiveShare: k.effectiveStackRevenueShare(ctx, share).String(),
+		Total:          revenue.Total,
+	}, nil
+}
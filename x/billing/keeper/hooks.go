@@ -2,12 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	query "github.com/cosmos/cosmos-sdk/types/query"
 
 	cosmossdkerrors "cosmossdk.io/errors"
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sagaxyz/ssc/x/billing/types"
 	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
@@ -15,11 +15,17 @@ import (
 )
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	_, err := k.billChainlets(ctx, epochIdentifier, epochNumber)
+	return err
+}
+
+// billChainlets bills the active chainlets for an epoch and reports the outcome for each of them.
+func (k Keeper) billChainlets(ctx sdk.Context, epochIdentifier string, epochNumber int64) ([]types.ChainletBillingReport, error) {
 	stacks, err := k.chainletkeeper.ListChainletStack(ctx, &chainlettypes.QueryListChainletStackRequest{})
 
 	if err != nil {
 		ctx.Logger().Error("could not list chainlet stacks. Error: " + err.Error())
-		return cosmossdkerrors.Wrapf(types.ErrInternalFailure, "could not list chainlet stacks. Error: %s", err.Error())
+		return nil, cosmossdkerrors.Wrapf(types.ErrInternalFailure, "could not list chainlet stacks. Error: %s", err.Error())
 	}
 	kvs := make(map[string]*chainlettypes.ChainletStack)
 	for _, stack := range stacks.ChainletStacks {
@@ -30,88 +36,143 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	})
 	if err != nil {
 		ctx.Logger().Error("could not list chainlets. Error: " + err.Error())
-		return cosmossdkerrors.Wrapf(types.ErrInternalFailure, "could not list chainlets. Error: %s", err.Error())
+		return nil, cosmossdkerrors.Wrapf(types.ErrInternalFailure, "could not list chainlets. Error: %s", err.Error())
 	}
 
 	epochInfo := k.epochskeeper.GetEpochInfo(ctx, epochIdentifier)
 	ctx.Logger().Debug("Current epoch start time is " + epochInfo.CurrentEpochStartTime.Format(time.RFC3339))
 	ctx.Logger().Info("attempting billing of chainlets for epoch " + fmt.Sprintf("%d", epochNumber) + " with epoch identifier " + epochIdentifier)
 
+	// Expired credits cannot pay for this epoch or any later one
+	k.pruneExpiredCredits(ctx)
+
 	var skipped, billed, failed []string
+	var running []*chainlettypes.Chainlet
 
 	if len(resp.Chainlets) == 0 {
 		ctx.Logger().Info("no active chainlets to be billed this epoch: " + fmt.Sprintf("%d", epochNumber))
-		return nil
+		return nil, nil
 	}
 
+	reports := make([]types.ChainletBillingReport, 0, len(resp.Chainlets))
 	for _, ch := range resp.Chainlets {
-		// Skip service or offline
-		if ch.IsServiceChainlet {
-			ctx.Logger().Debug("skipping billing for service chainlet: " + ch.ChainId)
-			skipped = append(skipped, ch.ChainId)
-			continue
-		}
-		if ch.Status == chainlettypes.Status_STATUS_OFFLINE {
-			ctx.Logger().Debug("skipping billing for inactive chainlet: " + ch.ChainId)
-			skipped = append(skipped, ch.ChainId)
-			continue
-		}
+		report := k.billChainlet(ctx, ch, kvs[ch.ChainletStackName], epochIdentifier, epochNumber)
+		reports = append(reports, report)
 
-		// Only bill chainlets that appear in kvs (as per your comment)
-		stack, ok := kvs[ch.ChainletStackName]
-		if !ok {
-			ctx.Logger().Debug("skipping billing for chainlet (no stack in kvs): " + ch.ChainId)
+		switch report.Outcome {
+		case types.BillingOutcome_BILLING_OUTCOME_SKIPPED:
 			skipped = append(skipped, ch.ChainId)
-			continue
+		case types.BillingOutcome_BILLING_OUTCOME_BILLED:
+			billed = append(billed, ch.ChainId)
+			running = append(running, ch)
+		case types.BillingOutcome_BILLING_OUTCOME_GRACE_PERIOD:
+			failed = append(failed, ch.ChainId)
+			running = append(running, ch)
+		case types.BillingOutcome_BILLING_OUTCOME_STOPPED:
+			failed = append(failed, ch.ChainId)
 		}
+	}
 
-		// Try multiple fee options until one works
-		succeeded := false
-		var errs []string
-
-		for i, fee := range stack.Fees {
-			epochFee, perr := sdk.ParseCoinNormalized(fee.EpochFee)
-			if perr != nil {
-				msg := fmt.Sprintf("fee[%d] parse failed: %q err=%v", i, fee.EpochFee, perr)
-				ctx.Logger().Error("billing parse error for " + ch.ChainId + ": " + msg)
-				errs = append(errs, msg)
-				continue // try next fee option
-			}
+	ctx.Logger().Info("skipped billing for chainlets: " + fmt.Sprintf("%v", skipped))
+	ctx.Logger().Info("failed billing for chainlets: " + fmt.Sprintf("%v", failed))
+	ctx.Logger().Info("successful billing for chainlets: " + fmt.Sprintf("%v", billed))
 
-			// Attempt billing with this coin option
-			berr := k.BillAccount(ctx, epochFee, *ch, "epoch-start-billing")
-			if berr != nil {
-				msg := fmt.Sprintf("fee[%d] %s billing failed: %v", i, epochFee.String(), berr)
-				ctx.Logger().Error("billing error for " + ch.ChainId + ": " + msg)
-				errs = append(errs, msg)
-				continue // try next fee option
-			}
+	// Warn about chainlets running out of funds
+	for _, ch := range running {
+		k.checkLowBalance(ctx, ch)
+	}
+	return reports, nil
+}
+
+// billChainlet bills a chainlet for an epoch with the first fee option of its stack it can pay.
+// Chainlets that cannot pay accrue debt within the grace period and are stopped after it.
+func (k Keeper) billChainlet(ctx sdk.Context, ch *chainlettypes.Chainlet, stack *chainlettypes.ChainletStack, epochIdentifier string, epochNumber int64) types.ChainletBillingReport {
+	report := types.ChainletBillingReport{
+		ChainId:  ch.ChainId,
+		Launcher: ch.Launcher,
+		Outcome:  types.BillingOutcome_BILLING_OUTCOME_SKIPPED,
+	}
 
-			// Success on this fee option; stop trying others
-			succeeded = true
-			ctx.Logger().Info(fmt.Sprintf("billed %s successfully with %s", ch.ChainId, epochFee.String()))
-			break
+	// Skip service or offline
+	if ch.IsServiceChainlet {
+		ctx.Logger().Debug("skipping billing for service chainlet: " + ch.ChainId)
+		report.Reasons = []string{"service chainlet"}
+		return report
+	}
+	if !ch.Status.IsActive() {
+		ctx.Logger().Debug("skipping billing for inactive chainlet: " + ch.ChainId)
+		report.Reasons = []string{"inactive chainlet"}
+		return report
+	}
+
+	// Only bill chainlets that appear in kvs (as per your comment)
+	if stack == nil {
+		ctx.Logger().Debug("skipping billing for chainlet (no stack in kvs): " + ch.ChainId)
+		report.Reasons = []string{"unknown stack " + ch.ChainletStackName}
+		return report
+	}
+
+	// Try multiple fee options until one works
+	var errs []string
+
+	for i, fee := range stack.Fees {
+		epochFee, perr := sdk.ParseCoinNormalized(fee.EpochFee)
+		if perr != nil {
+			msg := fmt.Sprintf("fee[%d] parse failed: %q err=%v", i, fee.EpochFee, perr)
+			ctx.Logger().Error("billing parse error for " + ch.ChainId + ": " + msg)
+			errs = append(errs, msg)
+			continue // try next fee option
 		}
 
-		if succeeded {
-			billed = append(billed, ch.ChainId)
-			continue
+		// Attempt billing with this coin option, credits first
+		credited, revenue, berr := k.billWithCredits(ctx, epochFee, *ch, "epoch-start-billing")
+		if berr != nil {
+			msg := fmt.Sprintf("fee[%d] %s billing failed: %v", i, epochFee.String(), berr)
+			ctx.Logger().Error("billing error for " + ch.ChainId + ": " + msg)
+			errs = append(errs, msg)
+			continue // try next fee option
 		}
 
-		// All fee options failed -> stop chainlet and record failure
-		stopErr := k.chainletkeeper.StopChainlet(ctx, ch.ChainId)
-		if stopErr != nil {
-			ctx.Logger().Error("could not stop chainlet " + ch.ChainId + ". Error: " + stopErr.Error())
-			errs = append(errs, "stop failed: "+stopErr.Error())
+		// Success on this fee option; stop trying others
+		k.setEpochCharge(ctx, types.EpochCharge{
+			ChainId:      ch.ChainId,
+			EpochNumber:  k.epochskeeper.GetEpochInfo(ctx, k.GetParams(ctx).BillingEpoch).CurrentEpoch,
+			EpochFee:     epochFee,
+			Charged:      epochFee.Sub(credited),
+			StackRevenue: revenue,
+		})
+		ctx.Logger().Info(fmt.Sprintf("billed %s successfully with %s", ch.ChainId, epochFee.String()))
+
+		report.Outcome = types.BillingOutcome_BILLING_OUTCOME_BILLED
+		report.FeeOption = uint32(i)
+		report.EpochFee = epochFee.String()
+		report.BilledAmount = epochFee.Sub(credited).String()
+		report.CreditUsed = credited.String()
+		report.SufficientFunds = true
+		report.Reasons = errs
+		if err := k.settleDebt(ctx, *ch, stack); err != nil {
+			ctx.Logger().Info("could not settle debt of chainlet " + ch.ChainId + ": " + err.Error())
 		}
-		ctx.Logger().Error(fmt.Sprintf("all billing options failed for %s; reasons: %v", ch.ChainId, errs))
-		failed = append(failed, ch.ChainId)
+		return report
 	}
+	report.Reasons = errs
 
-	ctx.Logger().Info("skipped billing for chainlets: " + fmt.Sprintf("%v", skipped))
-	ctx.Logger().Info("failed billing for chainlets: " + fmt.Sprintf("%v", failed))
-	ctx.Logger().Info("successful billing for chainlets: " + fmt.Sprintf("%v", billed))
-	return nil
+	// All fee options failed -> keep the chainlet running and accrue debt within the grace period
+	if k.accrueDebt(ctx, ch.ChainId, epochIdentifier, epochNumber) {
+		ctx.Logger().Error(fmt.Sprintf("all billing options failed for %s, in grace period; reasons: %v", ch.ChainId, errs))
+		report.Outcome = types.BillingOutcome_BILLING_OUTCOME_GRACE_PERIOD
+		return report
+	}
+
+	// Grace period exhausted -> stop chainlet and record failure
+	stopErr := k.chainletkeeper.StopChainlet(ctx, ch.ChainId)
+	if stopErr != nil {
+		ctx.Logger().Error("could not stop chainlet " + ch.ChainId + ". Error: " + stopErr.Error())
+		report.Reasons = append(report.Reasons, "stop failed: "+stopErr.Error())
+	}
+	ctx.Logger().Error(fmt.Sprintf("all billing options failed for %s; reasons: %v", ch.ChainId, report.Reasons))
+	report.Outcome = types.BillingOutcome_BILLING_OUTCOME_STOPPED
+	return report
 }
 
 // AfterEpochEnd is the epoch end hook.
@@ -143,65 +204,89 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			validatorAddrs = append(validatorAddrs, sdk.AccAddress(valAddr).String())
 		}
 	}
-	numValidators := len(validatorAddrs)
-	if numValidators == 0 {
+	if len(validatorAddrs) == 0 {
 		ctx.Logger().Info("no validators available for reward distribution")
 		return nil
 	}
 	moduleAccount := k.accountkeeper.GetModuleAccount(ctx, "billing") // module account address for the billing module
 	moduleAccountBalance := k.bankkeeper.GetAllBalances(ctx, moduleAccount.GetAddress())
-	validatorDepositAmount := moduleAccountBalance.QuoInt(math.NewIntFromUint64(uint64(numValidators)))
-	ctx.Logger().Debug("Validator deposit amount is " + validatorDepositAmount.String())
 
-	ctx.Logger().Debug("Module account address is " + moduleAccount.GetAddress().String() + " and module account balance is " + moduleAccountBalance.String())
-
-	epochInfo := k.epochskeeper.GetEpochInfo(ctx, epochIdentifier)
-	epochEventStartTime := epochInfo.CurrentEpochStartTime.Format(time.RFC3339)
-
-	for _, v := range validatorAddrs {
+	// Rewards validators have not claimed yet stay in the module account
+	distributable, negative := moduleAccountBalance.SafeSub(k.getPendingRewardsTotal(ctx)...)
+	if negative {
+		ctx.Logger().Error("billing module balance " + moduleAccountBalance.String() + " does not cover the pending validator rewards")
+		return nil
+	}
 
-		addr, err := sdk.AccAddressFromBech32(v)
-		if err != nil {
-			ctx.Logger().Error("could not parse validator address: " + v + ". Error: " + err.Error())
+	// Split the epoch fees between the treasury, the community pool and the validators. The
+	// rounding remainder of the validator splits goes to the community pool.
+	params := k.GetParams(ctx)
+	var treasuryAmount, communityPoolAmount sdk.Coins
+	recipients := append([]string(nil), validatorAddrs...)
+	rewards := make(map[string]sdk.Coins)
+	chainletRewards := make(map[string][]types.ChainletRevenue)
+
+	// The revenue of CCV consumer chainlets goes only to the validators that opted in to them. It
+	// stays with the other fees if no validator opted in.
+	otherAmount := distributable
+	for _, revenue := range k.takeConsumerRevenues(ctx) {
+		amount := revenue.Amount.Min(otherAmount)
+		optedIn := k.optedInValidators(ctx, revenue.ChainId)
+		if amount.IsZero() || len(optedIn) == 0 {
 			continue
 		}
+		otherAmount = otherAmount.Sub(amount...)
+
+		treasury, communityPool, validatorsAmount := splitRevenue(amount, params)
+		parts, remainder := splitByWeight(validatorsAmount, k.validatorWeights(ctx, params.ValidatorPayoutMode, optedIn))
+		treasuryAmount = treasuryAmount.Add(treasury...)
+		communityPoolAmount = communityPoolAmount.Add(communityPool...).Add(remainder...)
+		for i, v := range optedIn {
+			if parts[i].IsZero() {
+				continue
+			}
+			if !slices.Contains(recipients, v) {
+				recipients = append(recipients, v)
+			}
+			rewards[v] = rewards[v].Add(parts[i]...)
+			chainletRewards[v] = append(chainletRewards[v], types.ChainletRevenue{ChainId: revenue.ChainId, Amount: parts[i]})
+		}
+	}
 
-		// Keep for post-ccv
-		// var valAddr sdk.ValAddress
-		// var err error
+	treasury, communityPool, validatorsAmount := splitRevenue(otherAmount, params)
+	parts, remainder := splitByWeight(validatorsAmount, k.validatorWeights(ctx, params.ValidatorPayoutMode, validatorAddrs))
+	treasuryAmount = treasuryAmount.Add(treasury...)
+	communityPoolAmount = communityPoolAmount.Add(communityPool...).Add(remainder...)
+	for i, v := range validatorAddrs {
+		rewards[v] = rewards[v].Add(parts[i]...)
+	}
+	ctx.Logger().Debug("Validator deposit amounts are " + fmt.Sprintf("%v", rewards))
 
-		// ctx.Logger().Debug("Validator being processed is " + v.OperatorAddress)
+	ctx.Logger().Debug("Module account address is " + moduleAccount.GetAddress().String() + " and module account balance is " + moduleAccountBalance.String())
 
-		// if validatorDepositAmount.IsValid() && validatorDepositAmount.IsAllPositive() {
-		// 	valAddr, err = sdk.ValAddressFromBech32(v.OperatorAddress)
-		// 	if err != nil {
-		// 		ctx.Logger().Error("could not get the validator address from operator address: " + v.OperatorAddress + ". Error: " + err.Error())
-		// 		continue
-		// 	}
+	epochInfo := k.epochskeeper.GetEpochInfo(ctx, epochIdentifier)
+	epochEventStartTime := epochInfo.CurrentEpochStartTime.Format(time.RFC3339)
 
-		// 	ctx.Logger().Debug("Validator hex address is: " + valAddr.String())
-		// } else {
-		// 	ctx.Logger().Error("funds in billing module with address " + moduleAccount.GetAddress().String() + " could not be validated, or no funds exist, for distribution to validators: " + v.OperatorAddress)
-		// 	continue
-		// }
+	k.payRevenueShare(ctx, treasuryAmount, types.PayoutDestination_PAYOUT_DESTINATION_TREASURY, epochIdentifier, epochNumber, epochEventStartTime)
+	k.payRevenueShare(ctx, communityPoolAmount, types.PayoutDestination_PAYOUT_DESTINATION_COMMUNITY_POOL, epochIdentifier, epochNumber, epochEventStartTime)
 
-		// err = k.PayEpochFeeToValidator(ctx, validatorDepositAmount, "billing", sdk.AccAddress(valAddr), "epoch fee reward")
-		err = k.PayEpochFeeToValidator(ctx, validatorDepositAmount, "billing", addr, "epoch fee reward")
-		if err != nil {
-			// ctx.Logger().Error("could not pay epoch fee to validator " + v.OperatorAddress + ". Error: " + err.Error())
-			ctx.Logger().Error("could not pay epoch fee to validator " + v + ". Error: " + err.Error())
+	// Rewards accrue to the validators, who claim them with MsgClaimBillingRewards
+	for _, v := range recipients {
+		if _, err := sdk.AccAddressFromBech32(v); err != nil {
+			ctx.Logger().Error("could not parse validator address: " + v + ". Error: " + err.Error())
 			continue
 		}
-		err = k.SaveValidatorPayoutHistory(ctx, types.ValidatorPayoutHistory{
+		k.accrueRewards(ctx, v, rewards[v])
+
+		err := k.SaveValidatorPayoutHistory(ctx, types.ValidatorPayoutHistory{
 			ValidatorAddress: v,
-			// ValidatorAddress: sdk.AccAddress(valAddr).String(),
-			EpochIdentifier: epochIdentifier,
-			EpochNumber:     epochNumber,
-			EpochStartTime:  epochEventStartTime,
-			RewardAmount:    validatorDepositAmount.String(),
+			EpochIdentifier:  epochIdentifier,
+			EpochNumber:      epochNumber,
+			EpochStartTime:   epochEventStartTime,
+			RewardAmount:     rewards[v].String(),
+			ChainletRevenues: chainletRewards[v],
 		})
 		if err != nil {
-			// ctx.Logger().Error("could not save validator payout history for validator " + sdk.AccAddress(valAddr).String() + ". Error: " + err.Error())
 			ctx.Logger().Error("could not save validator payout history for validator " + v + ". Error: " + err.Error())
 		}
 	}
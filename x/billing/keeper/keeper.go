@@ -20,8 +20,11 @@ type (
 		escrowkeeper   types.EscrowKeeper
 		accountkeeper  types.AccountKeeper
 		stakingkeeper  types.StakingKeeper
+		distrkeeper    types.DistributionKeeper
 		chainletkeeper types.ChainletKeeper
 		epochskeeper   types.EpochsKeeper
+		peerskeeper    types.PeersKeeper
+		providerkeeper types.ProviderKeeper
 		authority      string
 	}
 )
@@ -34,6 +37,7 @@ func NewKeeper(
 	escrowkeeper types.EscrowKeeper,
 	accountkeeper types.AccountKeeper,
 	stakingkeeper types.StakingKeeper,
+	distrkeeper types.DistributionKeeper,
 	chainletkeeper types.ChainletKeeper,
 	epochskeeper types.EpochsKeeper,
 	authority string,
@@ -51,6 +55,7 @@ func NewKeeper(
 		escrowkeeper:   escrowkeeper,
 		accountkeeper:  accountkeeper,
 		stakingkeeper:  stakingkeeper,
+		distrkeeper:    distrkeeper,
 		chainletkeeper: chainletkeeper,
 		epochskeeper:   epochskeeper,
 		authority:      authority,
@@ -68,6 +73,10 @@ func (k *Keeper) UpdateKeeper(newKeeper interface{}) {
 		k.escrowkeeper = newk
 	} else if newk, ok := newKeeper.(types.EpochsKeeper); ok {
 		k.epochskeeper = newk
+	} else if newk, ok := newKeeper.(types.PeersKeeper); ok {
+		k.peerskeeper = newk
+	} else if newk, ok := newKeeper.(types.ProviderKeeper); ok {
+		k.providerkeeper = newk
 	}
 }
 
@@ -86,3 +95,7 @@ func (k Keeper) GetPlatformValidators(ctx sdk.Context) []string {
 	params := k.GetParams(ctx)
 	return params.PlatformValidators
 }
+
+func (k Keeper) GetBillingEpoch(ctx sdk.Context) string {
+	return k.GetParams(ctx).BillingEpoch
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/keeper"
+	billingtestutil "github.com/sagaxyz/ssc/x/billing/testutil"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	epochstypes "github.com/sagaxyz/ssc/x/epochs/types"
+	escrowtypes "github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+var (
+	epochStartTime = time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	authority      = sdk.AccAddress("authority")
+)
+
+type billingFixture struct {
+	keeper         *keeper.Keeper
+	ctx            sdk.Context
+	accountKeeper  *billingtestutil.MockAccountKeeper
+	bankKeeper     *billingtestutil.MockBankKeeper
+	escrowKeeper   *billingtestutil.MockEscrowKeeper
+	distrKeeper    *billingtestutil.MockDistributionKeeper
+	stakingKeeper  *billingtestutil.MockStakingKeeper
+	chainletKeeper *billingtestutil.MockChainletKeeper
+	chainlet       chainlettypes.Chainlet
+	stack          chainlettypes.ChainletStack
+	// Escrow balances of the chainlet
+	balances sdk.Coins
+}
+
+func setupBillingFixture(t *testing.T, gracePeriod uint64) *billingFixture {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(key, tkey)
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := billingtestutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := billingtestutil.NewMockBankKeeper(ctrl)
+	escrowKeeper := billingtestutil.NewMockEscrowKeeper(ctrl)
+	distrKeeper := billingtestutil.NewMockDistributionKeeper(ctrl)
+	stakingKeeper := billingtestutil.NewMockStakingKeeper(ctrl)
+	chainletKeeper := billingtestutil.NewMockChainletKeeper(ctrl)
+	epochsKeeper := billingtestutil.NewMockEpochsKeeper(ctrl)
+	epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), types.SAGA_EPOCH_IDENTIFIER).Return(epochstypes.EpochInfo{
+		Identifier:            types.SAGA_EPOCH_IDENTIFIER,
+		Duration:              24 * time.Hour,
+		CurrentEpochStartTime: epochStartTime,
+	}).AnyTimes()
+
+	subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, key, tkey, types.ModuleName)
+	k := keeper.NewKeeper(encCfg.Codec, key, subspace, bankKeeper, escrowKeeper, accountKeeper, stakingKeeper, distrKeeper, chainletKeeper, epochsKeeper, authority.String())
+	params := types.DefaultParams()
+	params.GracePeriodEpochs = gracePeriod
+	k.SetParams(ctx, params)
+
+	f := &billingFixture{
+		keeper:         k,
+		ctx:            ctx,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		escrowKeeper:   escrowKeeper,
+		distrKeeper:    distrKeeper,
+		stakingKeeper:  stakingKeeper,
+		chainletKeeper: chainletKeeper,
+		chainlet: chainlettypes.Chainlet{
+			ChainId:           "chain_1-1",
+			Launcher:          "launcher",
+			ChainletStackName: "stack",
+			Status:            chainlettypes.Status_STATUS_ONLINE,
+		},
+		stack: chainlettypes.ChainletStack{
+			DisplayName: "stack",
+			Fees:        []chainlettypes.ChainletStackFees{{Denom: "utsaga", EpochFee: "10utsaga"}},
+		},
+	}
+	chainletKeeper.EXPECT().GetParams(gomock.Any()).Return(chainlettypes.DefaultParams()).AnyTimes()
+	chainletKeeper.EXPECT().ListChainletStack(gomock.Any(), gomock.Any()).Return(&chainlettypes.QueryListChainletStackResponse{
+		ChainletStacks: []*chainlettypes.ChainletStack{&f.stack},
+	}, nil).AnyTimes()
+	chainletKeeper.EXPECT().ListChainlets(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, _ *chainlettypes.QueryListChainletsRequest) (*chainlettypes.QueryListChainletsResponse, error) {
+			chainlet := f.chainlet
+			return &chainlettypes.QueryListChainletsResponse{Chainlets: []*chainlettypes.Chainlet{&chainlet}}, nil
+		}).AnyTimes()
+	chainletKeeper.EXPECT().GetChainletInfo(gomock.Any(), f.chainlet.ChainId).DoAndReturn(
+		func(_ sdk.Context, _ string) (*chainlettypes.Chainlet, error) {
+			chainlet := f.chainlet
+			return &chainlet, nil
+		}).AnyTimes()
+	chainletKeeper.EXPECT().GetChainletStackInfo(gomock.Any(), f.chainlet.ChainId).Return(&f.stack, nil).AnyTimes()
+	escrowKeeper.EXPECT().GetChainletWithPools(gomock.Any(), f.chainlet.ChainId).DoAndReturn(
+		func(_ sdk.Context, chainId string) (escrowtypes.ChainletAccount, []*escrowtypes.DenomPool, error) {
+			var pools []*escrowtypes.DenomPool
+			for _, balance := range f.balances {
+				pools = append(pools, &escrowtypes.DenomPool{ChainId: chainId, Denom: balance.Denom, Balance: balance})
+			}
+			return escrowtypes.ChainletAccount{ChainId: chainId}, pools, nil
+		}).AnyTimes()
+
+	return f
+}
+
+func (f *billingFixture) debt(t *testing.T) *types.QueryGetChainletDebtResponse {
+	res, err := f.keeper.GetChainletDebt(f.ctx, &types.QueryGetChainletDebtRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	return res
+}
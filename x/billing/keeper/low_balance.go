@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k Keeper) getLowBalanceAlert(ctx sdk.Context, chainId string) types.LowBalanceAlert {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LowBalanceAlertKey)
+	bz := store.Get([]byte(chainId))
+	if bz == nil {
+		return types.LowBalanceAlert{ChainId: chainId}
+	}
+	var alert types.LowBalanceAlert
+	k.cdc.MustUnmarshal(bz, &alert)
+	return alert
+}
+
+func (k Keeper) setLowBalanceAlert(ctx sdk.Context, alert types.LowBalanceAlert) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LowBalanceAlertKey)
+	if alert.Threshold == 0 && alert.LastThreshold == 0 {
+		store.Delete([]byte(alert.ChainId))
+		return
+	}
+	store.Set([]byte(alert.ChainId), k.cdc.MustMarshal(&alert))
+}
+
+func (k Keeper) deleteLowBalanceAlert(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LowBalanceAlertKey)
+	store.Delete([]byte(chainId))
+}
+
+// SetLowBalanceThreshold sets the launcher threshold of a chainlet, zero removes it.
+func (k Keeper) SetLowBalanceThreshold(ctx sdk.Context, chainId string, threshold uint64) {
+	alert := k.getLowBalanceAlert(ctx, chainId)
+	alert.Threshold = threshold
+	k.setLowBalanceAlert(ctx, alert)
+}
+
+// checkLowBalance emits an event when the runway of a chainlet drops to or below a threshold.
+// Each threshold fires once until the runway rises above it again.
+func (k Keeper) checkLowBalance(ctx sdk.Context, chainlet *chainlettypes.Chainlet) {
+	alert := k.getLowBalanceAlert(ctx, chainlet.ChainId)
+	thresholds := k.GetParams(ctx).LowBalanceThresholds
+	if alert.Threshold != 0 {
+		thresholds = append([]uint64{alert.Threshold}, thresholds...)
+	}
+	if len(thresholds) == 0 && alert.LastThreshold == 0 {
+		return
+	}
+
+	runway, err := k.chainletRunway(ctx, chainlet)
+	if err != nil {
+		ctx.Logger().Error("could not compute runway of chainlet " + chainlet.ChainId + ": " + err.Error())
+		return
+	}
+
+	// Lowest threshold the runway is at or below
+	var crossed uint64
+	for _, threshold := range thresholds {
+		if runway.TotalEpochsLeft <= threshold && (crossed == 0 || threshold < crossed) {
+			crossed = threshold
+		}
+	}
+	if crossed == alert.LastThreshold {
+		return
+	}
+	if crossed != 0 && (alert.LastThreshold == 0 || crossed < alert.LastThreshold) {
+		//nolint:errcheck // Event emission errors are non-critical
+		ctx.EventManager().EmitTypedEvent(&types.EventLowEscrowBalance{
+			ChainId:           chainlet.ChainId,
+			Launcher:          chainlet.Launcher,
+			Threshold:         crossed,
+			EpochsLeft:        runway.TotalEpochsLeft,
+			ProjectedStopTime: runway.ProjectedStopTime,
+		})
+	}
+	alert.LastThreshold = crossed
+	k.setLowBalanceAlert(ctx, alert)
+}
+
+// ExportLowBalanceAlerts exports all low balance alert states from the store
+func (k Keeper) ExportLowBalanceAlerts(ctx sdk.Context) []types.LowBalanceAlert {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LowBalanceAlertKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var alerts []types.LowBalanceAlert
+	for ; iterator.Valid(); iterator.Next() {
+		var alert types.LowBalanceAlert
+		k.cdc.MustUnmarshal(iterator.Value(), &alert)
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+// ImportLowBalanceAlert imports a single low balance alert state into the store
+func (k Keeper) ImportLowBalanceAlert(ctx sdk.Context, alert types.LowBalanceAlert) {
+	k.setLowBalanceAlert(ctx, alert)
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/keeper"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestLowBalanceEvents(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	launcher := sdk.AccAddress("launcher").String()
+	f.chainlet.Launcher = launcher
+
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), gomock.Any(), f.chainlet.ChainId, "billing").Return(nil).AnyTimes()
+
+	// Runs a billing pass with the given balance left afterwards and returns the thresholds of
+	// the emitted events
+	epoch := int64(0)
+	billingPass := func(balanceLeft int64) []uint64 {
+		f.balances = sdk.NewCoins(sdk.NewInt64Coin("utsaga", balanceLeft))
+		epoch++
+		ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.keeper.BeforeEpochStart(ctx, types.SAGA_EPOCH_IDENTIFIER, epoch))
+
+		var thresholds []uint64
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != "ssc.billing.EventLowEscrowBalance" {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			lowBalance := msg.(*types.EventLowEscrowBalance)
+			require.Equal(t, launcher, lowBalance.Launcher)
+			thresholds = append(thresholds, lowBalance.Threshold)
+		}
+		return thresholds
+	}
+
+	require.Empty(t, billingPass(50))
+	require.Equal(t, []uint64{3}, billingPass(30))
+	require.Empty(t, billingPass(20))
+	require.Equal(t, []uint64{1}, billingPass(10))
+	require.Empty(t, billingPass(0))
+
+	// Thresholds fire again once the runway recovered
+	require.Empty(t, billingPass(100))
+	require.Equal(t, []uint64{1}, billingPass(10))
+
+	// Launcher threshold
+	msgServer := keeper.NewMsgServerImpl(*f.keeper)
+	_, err := msgServer.SetLowBalanceThreshold(f.ctx, &types.MsgSetLowBalanceThreshold{
+		Creator:   sdk.AccAddress("other").String(),
+		ChainId:   f.chainlet.ChainId,
+		Threshold: 5,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.SetLowBalanceThreshold(f.ctx, &types.MsgSetLowBalanceThreshold{
+		Creator:   launcher,
+		ChainId:   f.chainlet.ChainId,
+		Threshold: 5,
+	})
+	require.NoError(t, err)
+
+	require.Empty(t, billingPass(100))
+	require.Equal(t, []uint64{5}, billingPass(50))
+	require.Equal(t, []uint64{3}, billingPass(30))
+
+	require.Len(t, f.keeper.ExportLowBalanceAlerts(f.ctx), 1)
+
+	// Nothing is left once the chainlet is decommissioned
+	require.NoError(t, f.keeper.OnChainletDecommissioned(f.ctx, f.chainlet))
+	require.Empty(t, f.keeper.ExportLowBalanceAlerts(f.ctx))
+}
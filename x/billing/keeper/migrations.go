@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/sagaxyz/ssc/x/billing/migrations/v2"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.chainletkeeper, m.keeper.epochskeeper)
+	if err != nil {
+		return err
+	}
+
+	// Params added in version 2 are missing from the param store and would read as zero values
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
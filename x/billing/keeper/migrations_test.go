@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/keeper"
+	billingtestutil "github.com/sagaxyz/ssc/x/billing/testutil"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestMigrate1to2Params(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(key, tkey)
+
+	ctrl := gomock.NewController(t)
+	subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, key, tkey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+	k := keeper.NewKeeper(encCfg.Codec, key, subspace,
+		billingtestutil.NewMockBankKeeper(ctrl),
+		billingtestutil.NewMockEscrowKeeper(ctrl),
+		billingtestutil.NewMockAccountKeeper(ctrl),
+		billingtestutil.NewMockStakingKeeper(ctrl),
+		billingtestutil.NewMockDistributionKeeper(ctrl),
+		billingtestutil.NewMockChainletKeeper(ctrl),
+		billingtestutil.NewMockEpochsKeeper(ctrl),
+		authority.String(),
+	)
+
+	// Version 1 only stored the epochs and platform validators
+	subspace.Set(ctx, []byte("ValidatorPayoutEpoch"), "hour")
+	subspace.Set(ctx, []byte("BillingEpoch"), "hour")
+	subspace.Set(ctx, []byte("PlatformValidators"), []string{})
+	require.Nil(t, k.GetParams(ctx).LowBalanceThresholds)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, []uint64{3, 1}, params.LowBalanceThresholds)
+	require.Equal(t, types.DefaultMaxStackRevenueShare, params.MaxStackRevenueShare)
+	require.NoError(t, params.Validate())
+
+	// Existing params are kept
+	require.Equal(t, "hour", params.BillingEpoch)
+	require.Equal(t, "hour", params.ValidatorPayoutEpoch)
+	require.Empty(t, params.PlatformValidators)
+}
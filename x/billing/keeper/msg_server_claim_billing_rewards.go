@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) ClaimBillingRewards(goCtx context.Context, msg *types.MsgClaimBillingRewards) (*types.MsgClaimBillingRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	amount, err := m.Keeper.ClaimRewards(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBillingRewardsResponse{Amount: amount}, nil
+}
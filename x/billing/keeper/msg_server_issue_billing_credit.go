@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) IssueBillingCredit(goCtx context.Context, msg *types.MsgIssueBillingCredit) (*types.MsgIssueBillingCreditResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	id, err := m.Keeper.IssueBillingCredit(ctx, msg.ChainId, msg.Launcher, msg.Amount, msg.Expiry, msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIssueBillingCreditResponse{Id: id}, nil
+}
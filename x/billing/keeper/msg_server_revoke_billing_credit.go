@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) RevokeBillingCredit(goCtx context.Context, msg *types.MsgRevokeBillingCredit) (*types.MsgRevokeBillingCreditResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+
+	err := m.Keeper.RevokeBillingCredit(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeBillingCreditResponse{}, nil
+}
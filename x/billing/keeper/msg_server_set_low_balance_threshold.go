@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) SetLowBalanceThreshold(goCtx context.Context, msg *types.MsgSetLowBalanceThreshold) (*types.MsgSetLowBalanceThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	chainlet, err := m.chainletkeeper.GetChainletInfo(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if chainlet.Launcher != msg.Creator {
+		return nil, types.ErrUnauthorized.Wrap("only the launcher can set the low balance threshold")
+	}
+
+	m.Keeper.SetLowBalanceThreshold(ctx, msg.ChainId, msg.Threshold)
+
+	return &types.MsgSetLowBalanceThresholdResponse{}, nil
+}
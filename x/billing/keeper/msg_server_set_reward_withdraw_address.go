@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) SetRewardWithdrawAddress(goCtx context.Context, msg *types.MsgSetRewardWithdrawAddress) (*types.MsgSetRewardWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.WithdrawAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+			return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address (%s)", err)
+		}
+	}
+
+	m.Keeper.SetRewardWithdrawAddress(ctx, msg.Creator, msg.WithdrawAddress)
+
+	return &types.MsgSetRewardWithdrawAddressResponse{}, nil
+}
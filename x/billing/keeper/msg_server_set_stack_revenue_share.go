@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) SetStackRevenueShare(goCtx context.Context, msg *types.MsgSetStackRevenueShare) (*types.MsgSetStackRevenueShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PayoutAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.PayoutAddress); err != nil {
+			return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payout address (%s)", err)
+		}
+	}
+	share, err := math.LegacyNewDecFromStr(msg.Share)
+	if err != nil {
+		return nil, cosmossdkerrors.Wrapf(types.ErrInvalidRevenueShare, "invalid share %s: %s", msg.Share, err)
+	}
+
+	err = m.Keeper.SetStackRevenueShare(ctx, msg.Creator, types.StackRevenueShare{
+		StackName:     msg.StackName,
+		Share:         share,
+		PayoutAddress: msg.PayoutAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetStackRevenueShareResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.GetAuthority(), msg.Authority)
+	}
+	if msg.Params == nil {
+		return nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing params")
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params (%s)", err)
+	}
+
+	m.SetParams(ctx, *msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
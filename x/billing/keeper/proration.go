@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k Keeper) getEpochCharge(ctx sdk.Context, chainId string) (types.EpochCharge, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochChargeKey)
+	bz := store.Get([]byte(chainId))
+	if bz == nil {
+		return types.EpochCharge{}, false
+	}
+	var charge types.EpochCharge
+	k.cdc.MustUnmarshal(bz, &charge)
+	return charge, true
+}
+
+func (k Keeper) setEpochCharge(ctx sdk.Context, charge types.EpochCharge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochChargeKey)
+	store.Set([]byte(charge.ChainId), k.cdc.MustMarshal(&charge))
+}
+
+func (k Keeper) deleteEpochCharge(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochChargeKey)
+	store.Delete([]byte(chainId))
+}
+
+// remainingEpochFraction returns the part of the current billing epoch left at the block time.
+func (k Keeper) remainingEpochFraction(ctx sdk.Context) math.LegacyDec {
+	epochInfo := k.epochskeeper.GetEpochInfo(ctx, k.GetParams(ctx).BillingEpoch)
+	if epochInfo.Duration <= 0 {
+		return math.LegacyOneDec()
+	}
+	remaining := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration).Sub(ctx.BlockTime())
+	if remaining <= 0 {
+		return math.LegacyZeroDec()
+	}
+	if remaining >= epochInfo.Duration {
+		return math.LegacyOneDec()
+	}
+	return math.LegacyNewDec(int64(remaining)).QuoInt64(int64(epochInfo.Duration))
+}
+
+// BillEpochFee bills a chainlet for the current billing epoch along with a one-time setup fee.
+// With prorated epoch fees, only the rest of the epoch is charged, rounded up.
+func (k Keeper) BillEpochFee(ctx sdk.Context, epochFee, setupFee sdk.Coin, chainlet chainlettypes.Chainlet, memo string) error {
+	charged := epochFee
+	if k.GetParams(ctx).ProrateEpochFees {
+		amount := math.LegacyNewDecFromInt(epochFee.Amount).Mul(k.remainingEpochFraction(ctx)).Ceil().TruncateInt()
+		charged = sdk.NewCoin(epochFee.Denom, amount)
+	}
+
+	billed := charged.Add(setupFee)
+	revenue, err := k.billAccount(ctx, billed, sdk.NewCoin(billed.Denom, math.ZeroInt()), chainlet, memo)
+	if err != nil {
+		return err
+	}
+
+	// The stack creator was paid a share of the setup fee as well
+	stackRevenue := revenue
+	if setupFee.IsPositive() {
+		amount := math.LegacyNewDecFromInt(revenue.Amount).MulInt(charged.Amount).QuoInt(billed.Amount).Ceil().TruncateInt()
+		stackRevenue = sdk.NewCoin(revenue.Denom, amount)
+	}
+
+	k.setEpochCharge(ctx, types.EpochCharge{
+		ChainId:      chainlet.ChainId,
+		EpochNumber:  k.epochskeeper.GetEpochInfo(ctx, k.GetParams(ctx).BillingEpoch).CurrentEpoch,
+		EpochFee:     epochFee,
+		Charged:      charged,
+		StackRevenue: stackRevenue,
+	})
+	return nil
+}
+
+// RefundUnusedEpochFee returns the unused part of the epoch fee charged to a chainlet for the
+// current billing epoch to its escrow, rounded down. The stack creator keeps its revenue share,
+// so only the rest of the unused part is refunded. Nothing is refunded unless epoch fees are
+// prorated. Chainlets stopped because they could not be billed were not charged for the epoch.
+// The charge is only removed once refunded, a chainlet that was not refunded keeps having paid
+// for the epoch. It is kept as well if the refund fails, so that it can be retried.
+func (k Keeper) RefundUnusedEpochFee(ctx sdk.Context, chainlet chainlettypes.Chainlet) error {
+	charge, found := k.getEpochCharge(ctx, chainlet.ChainId)
+	if !found || !k.GetParams(ctx).ProrateEpochFees {
+		return nil
+	}
+	if charge.EpochNumber != k.epochskeeper.GetEpochInfo(ctx, k.GetParams(ctx).BillingEpoch).CurrentEpoch {
+		k.deleteEpochCharge(ctx, chainlet.ChainId)
+		return nil
+	}
+
+	unused := math.LegacyNewDecFromInt(charge.EpochFee.Amount).Mul(k.remainingEpochFraction(ctx)).TruncateInt()
+	unused = math.MinInt(unused, charge.Charged.Amount)
+	if !unused.IsPositive() {
+		return nil
+	}
+	creatorPart := math.LegacyNewDecFromInt(unused).MulInt(charge.StackRevenue.Amount).QuoInt(charge.Charged.Amount).Ceil().TruncateInt()
+	refund := sdk.NewCoin(charge.Charged.Denom, unused.Sub(creatorPart))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	// The refund cannot come out of the rewards validators have not claimed yet
+	balance := k.bankkeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), refund.Denom)
+	if balance.Amount.Sub(k.getPendingRewardsTotal(ctx).AmountOf(refund.Denom)).LT(refund.Amount) {
+		return types.ErrInternalBillingFailure.Wrapf("billing module cannot cover the refund of %s to chainlet %s", refund, chainlet.ChainId)
+	}
+	err := k.escrowkeeper.RefundBilling(ctx, refund, chainlet.ChainId, types.ModuleName)
+	if err != nil {
+		return err
+	}
+	k.deleteEpochCharge(ctx, chainlet.ChainId)
+	k.deductConsumerRevenue(ctx, chainlet.ChainId, sdk.NewCoins(refund))
+
+	memo := "unused epoch fee refund"
+	//nolint:errcheck // Event emission errors are non-critical
+	ctx.EventManager().EmitTypedEvent(&types.BillingEvent{
+		ChainId: chainlet.ChainId,
+		Amount:  refund.String(),
+		Memo:    memo,
+		Success: true,
+		Debit:   false,
+	})
+	record := k.newBillingRecord(ctx, chainlet, memo)
+	record.RefundedAmount = refund.String()
+	err = k.SaveBillingHistory(ctx, record)
+	if err != nil {
+		ctx.Logger().Error("could not save billing history for chainlet " + chainlet.ChainletName + ". Error: " + err.Error())
+	}
+	return nil
+}
+
+// ChargedForCurrentEpoch reports whether a chainlet paid for the current billing epoch and was
+// not refunded since.
+func (k Keeper) ChargedForCurrentEpoch(ctx sdk.Context, chainId string) bool {
+	charge, found := k.getEpochCharge(ctx, chainId)
+	return found && charge.EpochNumber == k.epochskeeper.GetEpochInfo(ctx, k.GetParams(ctx).BillingEpoch).CurrentEpoch
+}
+
+// ExportEpochCharges exports the epoch fees last charged to chainlets from the store
+func (k Keeper) ExportEpochCharges(ctx sdk.Context) []types.EpochCharge {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochChargeKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var charges []types.EpochCharge
+	for ; it.Valid(); it.Next() {
+		var charge types.EpochCharge
+		k.cdc.MustUnmarshal(it.Value(), &charge)
+		charges = append(charges, charge)
+	}
+	return charges
+}
+
+// ImportEpochCharge imports the epoch fee last charged to a single chainlet into the store
+func (k Keeper) ImportEpochCharge(ctx sdk.Context, charge types.EpochCharge) {
+	k.setEpochCharge(ctx, charge)
+}
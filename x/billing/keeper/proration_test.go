@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestProratedEpochFees(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	epochFee := sdk.NewInt64Coin("utsaga", 100)
+	setupFee := sdk.NewInt64Coin("utsaga", 5)
+
+	// Full epoch fee without proration
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(18 * time.Hour))
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 105), f.chainlet.ChainId, "billing").Return(nil)
+	require.NoError(t, f.keeper.BillEpochFee(f.ctx, epochFee, setupFee, f.chainlet, "launching chainlet"))
+	require.NoError(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+	require.True(t, f.keeper.ChargedForCurrentEpoch(f.ctx, f.chainlet.ChainId))
+	require.NoError(t, f.keeper.OnChainletDecommissioned(f.ctx, f.chainlet))
+	require.Empty(t, f.keeper.ExportEpochCharges(f.ctx))
+
+	params := f.keeper.GetParams(f.ctx)
+	params.ProrateEpochFees = true
+	f.keeper.SetParams(f.ctx, params)
+
+	// A quarter of the epoch is left at launch
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 30), f.chainlet.ChainId, "billing").Return(nil)
+	require.NoError(t, f.keeper.BillEpochFee(f.ctx, epochFee, setupFee, f.chainlet, "launching chainlet"))
+	charges := f.keeper.ExportEpochCharges(f.ctx)
+	require.Len(t, charges, 1)
+	require.Equal(t, sdk.NewInt64Coin("utsaga", 25), charges[0].Charged)
+
+	// An eighth of the epoch is left when the chainlet is stopped, rounded down
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(21 * time.Hour))
+	f.bankKeeper.EXPECT().GetBalance(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), "utsaga").Return(sdk.NewInt64Coin("utsaga", 100))
+	f.escrowKeeper.EXPECT().RefundBilling(gomock.Any(), sdk.NewInt64Coin("utsaga", 12), f.chainlet.ChainId, types.ModuleName).Return(nil)
+	require.NoError(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+
+	history, err := f.keeper.GetChainletBillingHistory(f.ctx, f.chainlet.ChainId)
+	require.NoError(t, err)
+	require.Equal(t, "12utsaga", history[len(history)-1].RefundedAmount)
+
+	// The epoch fee is refunded only once
+	require.NoError(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+}
+
+func TestRefundUnusedEpochFeeStackRevenue(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	payout := sdk.AccAddress("payout")
+	params := f.keeper.GetParams(f.ctx)
+	params.ProrateEpochFees = true
+	f.keeper.SetParams(f.ctx, params)
+	f.keeper.ImportStackRevenueShare(f.ctx, types.StackRevenueShare{
+		StackName:     "stack",
+		Share:         math.LegacyNewDecWithPrec(1, 1),
+		PayoutAddress: payout.String(),
+	})
+
+	// A quarter of the epoch is left at launch, the stack creator gets 3 of the 30 billed
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(18 * time.Hour))
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 30), f.chainlet.ChainId, "billing").Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, payout, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 3))).Return(nil)
+	require.NoError(t, f.keeper.BillEpochFee(f.ctx, sdk.NewInt64Coin("utsaga", 100), sdk.NewInt64Coin("utsaga", 5), f.chainlet, "launching chainlet"))
+	charges := f.keeper.ExportEpochCharges(f.ctx)
+	require.Len(t, charges, 1)
+	require.Equal(t, sdk.NewInt64Coin("utsaga", 3), charges[0].StackRevenue)
+
+	// 12 of the 25 charged are unused, the stack creator keeps 2 of them
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(21 * time.Hour))
+	f.bankKeeper.EXPECT().GetBalance(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), "utsaga").Return(sdk.NewInt64Coin("utsaga", 27))
+	f.escrowKeeper.EXPECT().RefundBilling(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), f.chainlet.ChainId, types.ModuleName).Return(nil)
+	require.NoError(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+}
+
+func TestSuspendResumeEpochFee(t *testing.T) {
+	for _, tc := range []struct {
+		prorate bool
+		// Escrow balance after launch, suspension and resumption
+		balances []int64
+	}{
+		// The unused fee is refunded and the rest of the epoch billed again
+		{true, []int64{975, 987, 974}},
+		// The epoch was paid in full and is not billed twice
+		{false, []int64{900, 900, 900}},
+	} {
+		t.Run(fmt.Sprintf("prorate=%t", tc.prorate), func(t *testing.T) {
+			f := setupBillingFixture(t, 0)
+			params := f.keeper.GetParams(f.ctx)
+			params.ProrateEpochFees = tc.prorate
+			f.keeper.SetParams(f.ctx, params)
+
+			escrow := sdk.NewInt64Coin("utsaga", 1000)
+			f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), gomock.Any(), f.chainlet.ChainId, "billing").DoAndReturn(
+				func(_ sdk.Context, amount sdk.Coin, _, _ string) error {
+					escrow = escrow.Sub(amount)
+					return nil
+				}).AnyTimes()
+			f.escrowKeeper.EXPECT().RefundBilling(gomock.Any(), gomock.Any(), f.chainlet.ChainId, types.ModuleName).DoAndReturn(
+				func(_ sdk.Context, amount sdk.Coin, _, _ string) error {
+					escrow = escrow.Add(amount)
+					return nil
+				}).AnyTimes()
+			f.bankKeeper.EXPECT().GetBalance(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), "utsaga").Return(sdk.NewInt64Coin("utsaga", 100)).AnyTimes()
+			epochFee := sdk.NewInt64Coin("utsaga", 100)
+			noSetupFee := sdk.NewInt64Coin("utsaga", 0)
+
+			// Launched with a quarter of the epoch left
+			f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(18 * time.Hour))
+			require.NoError(t, f.keeper.BillEpochFee(f.ctx, epochFee, noSetupFee, f.chainlet, "launching chainlet"))
+			require.Equal(t, tc.balances[0], escrow.Amount.Int64())
+			require.True(t, f.keeper.ChargedForCurrentEpoch(f.ctx, f.chainlet.ChainId))
+
+			// Suspended and resumed with an eighth of the epoch left, only billed if refunded
+			f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(21 * time.Hour))
+			require.NoError(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+			require.Equal(t, tc.balances[1], escrow.Amount.Int64())
+			require.Equal(t, !tc.prorate, f.keeper.ChargedForCurrentEpoch(f.ctx, f.chainlet.ChainId))
+			if !f.keeper.ChargedForCurrentEpoch(f.ctx, f.chainlet.ChainId) {
+				require.NoError(t, f.keeper.BillEpochFee(f.ctx, epochFee, noSetupFee, f.chainlet, "resuming chainlet"))
+			}
+			require.Equal(t, tc.balances[2], escrow.Amount.Int64())
+			require.True(t, f.keeper.ChargedForCurrentEpoch(f.ctx, f.chainlet.ChainId))
+		})
+	}
+}
+
+func TestRefundUnusedEpochFeeFailure(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	params := f.keeper.GetParams(f.ctx)
+	params.ProrateEpochFees = true
+	f.keeper.SetParams(f.ctx, params)
+
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(18 * time.Hour))
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), sdk.NewInt64Coin("utsaga", 25), f.chainlet.ChainId, "billing").Return(nil)
+	require.NoError(t, f.keeper.BillEpochFee(f.ctx, sdk.NewInt64Coin("utsaga", 100), sdk.NewInt64Coin("utsaga", 0), f.chainlet, "launching chainlet"))
+
+	// The charge is kept when the billing module cannot cover the refund or the escrow rejects it
+	f.ctx = f.ctx.WithBlockTime(epochStartTime.Add(21 * time.Hour))
+	gomock.InOrder(
+		f.bankKeeper.EXPECT().GetBalance(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), "utsaga").Return(sdk.NewInt64Coin("utsaga", 11)),
+		f.bankKeeper.EXPECT().GetBalance(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName), "utsaga").Return(sdk.NewInt64Coin("utsaga", 100)).Times(2),
+	)
+	require.ErrorIs(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet), types.ErrInternalBillingFailure)
+	require.Len(t, f.keeper.ExportEpochCharges(f.ctx), 1)
+
+	gomock.InOrder(
+		f.escrowKeeper.EXPECT().RefundBilling(gomock.Any(), sdk.NewInt64Coin("utsaga", 12), f.chainlet.ChainId, types.ModuleName).Return(fmt.Errorf("pool drained")),
+		f.escrowKeeper.EXPECT().RefundBilling(gomock.Any(), sdk.NewInt64Coin("utsaga", 12), f.chainlet.ChainId, types.ModuleName).Return(nil),
+	)
+	require.Error(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+	require.Len(t, f.keeper.ExportEpochCharges(f.ctx), 1)
+
+	// Retrying refunds it
+	require.NoError(t, f.keeper.RefundUnusedEpochFee(f.ctx, f.chainlet))
+	require.Empty(t, f.keeper.ExportEpochCharges(f.ctx))
+}
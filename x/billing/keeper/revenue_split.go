@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+// shareOfCoins returns the share of each coin, rounded down.
+func shareOfCoins(coins sdk.Coins, share math.LegacyDec) sdk.Coins {
+	var out sdk.Coins
+	for _, coin := range coins {
+		amount := math.LegacyNewDecFromInt(coin.Amount).Mul(share).TruncateInt()
+		out = out.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return out
+}
+
+// splitRevenue splits epoch fees into the treasury and community pool shares and the part paid to
+// validators.
+func splitRevenue(amount sdk.Coins, params types.Params) (treasury, communityPool, validators sdk.Coins) {
+	treasury = shareOfCoins(amount, params.GetTreasuryShare())
+	communityPool = shareOfCoins(amount, params.GetCommunityPoolShare())
+	validators = amount.Sub(treasury...).Sub(communityPool...)
+	return treasury, communityPool, validators
+}
+
+// payRevenueShare pays a part of the epoch fees to the treasury or the community pool and records it
+// in the payout history. Nothing is paid out if the amount is empty.
+func (k Keeper) payRevenueShare(ctx sdk.Context, amount sdk.Coins, destination types.PayoutDestination, epochIdentifier string, epochNumber int64, epochStartTime string) {
+	if amount.IsZero() {
+		return
+	}
+
+	var recipient string
+	var err error
+	switch destination {
+	case types.PayoutDestination_PAYOUT_DESTINATION_TREASURY:
+		recipient = k.GetParams(ctx).TreasuryAddress
+		var addr sdk.AccAddress
+		addr, err = sdk.AccAddressFromBech32(recipient)
+		if err == nil {
+			err = k.bankkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount)
+		}
+	case types.PayoutDestination_PAYOUT_DESTINATION_COMMUNITY_POOL:
+		recipient = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+		err = k.distrkeeper.FundCommunityPool(ctx, amount, authtypes.NewModuleAddress(types.ModuleName))
+	default:
+		return
+	}
+	failed := err != nil
+	if failed {
+		ctx.Logger().Error("could not pay " + amount.String() + " to " + destination.String() + " " + recipient + ". Error: " + err.Error())
+	}
+
+	err = k.SaveValidatorPayoutHistory(ctx, types.ValidatorPayoutHistory{
+		ValidatorAddress: recipient,
+		EpochIdentifier:  epochIdentifier,
+		EpochNumber:      epochNumber,
+		EpochStartTime:   epochStartTime,
+		RewardAmount:     amount.String(),
+		Failed:           failed,
+		Destination:      destination,
+	})
+	if err != nil {
+		ctx.Logger().Error("could not save payout history for " + destination.String() + " " + recipient + ". Error: " + err.Error())
+	}
+}
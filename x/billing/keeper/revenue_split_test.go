@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/keeper"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestRevenueSplit(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	treasury := sdk.AccAddress("treasury")
+	val1 := sdk.AccAddress("val1")
+	val2 := sdk.AccAddress("val2")
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.PlatformValidators = []string{val1.String(), val2.String()}
+	params.TreasuryShare = math.LegacyNewDecWithPrec(2, 1)
+	params.TreasuryAddress = treasury.String()
+	params.CommunityPoolShare = math.LegacyNewDecWithPrec(1, 1)
+
+	msgServer := keeper.NewMsgServerImpl(*f.keeper)
+	_, err := msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other").String(), Params: &params})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: &params})
+	require.NoError(t, err)
+
+	f.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAccount.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin("utsaga", 1003))).AnyTimes()
+
+	// 20% to the treasury, 10% and the remainder of the validator split to the community pool
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, treasury, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 200))).Return(nil)
+	f.distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("utsaga", 101)), moduleAccount.GetAddress()).Return(nil)
+
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 1))
+
+	// The validator share accrues to the validators
+	for _, val := range []sdk.AccAddress{val1, val2} {
+		res, err := f.keeper.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{ValidatorAddress: val.String()})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 351)), res.Amount)
+	}
+
+	history, err := f.keeper.GetKprValidatorPayoutHistory(f.ctx, treasury.String())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, types.PayoutDestination_PAYOUT_DESTINATION_TREASURY, history[0].Destination)
+	require.Equal(t, "200utsaga", history[0].RewardAmount)
+
+	history, err = f.keeper.GetKprValidatorPayoutHistory(f.ctx, authtypes.NewModuleAddress(distrtypes.ModuleName).String())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, types.PayoutDestination_PAYOUT_DESTINATION_COMMUNITY_POOL, history[0].Destination)
+	require.Equal(t, "101utsaga", history[0].RewardAmount)
+
+	history, err = f.keeper.GetKprValidatorPayoutHistory(f.ctx, val1.String())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, types.PayoutDestination_PAYOUT_DESTINATION_VALIDATOR, history[0].Destination)
+	require.Equal(t, "351utsaga", history[0].RewardAmount)
+}
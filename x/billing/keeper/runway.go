@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	stdmath "math"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	epochstypes "github.com/sagaxyz/ssc/x/epochs/types"
+)
+
+// chainletRunway computes how many epochs the escrow and the billing credits of a chainlet pay
+// for with each fee option of its stack, and when the chainlet is projected to be stopped.
+func (k Keeper) chainletRunway(ctx sdk.Context, chainlet *chainlettypes.Chainlet) (*types.QueryRunwayResponse, error) {
+	stack, err := k.chainletkeeper.GetChainletStackInfo(ctx, chainlet.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	_, pools, err := k.escrowkeeper.GetChainletWithPools(ctx, chainlet.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]math.Int)
+	for _, pool := range pools {
+		balances[pool.Denom] = pool.Balance.Amount
+	}
+
+	params := k.GetParams(ctx)
+	var unpaidEpochs, graceEpochsLeft uint64
+	if debt, found := k.getChainletDebt(ctx, chainlet.ChainId); found {
+		unpaidEpochs = debt.UnpaidEpochs
+	}
+	if params.GracePeriodEpochs > unpaidEpochs {
+		graceEpochsLeft = params.GracePeriodEpochs - unpaidEpochs
+	}
+	billed := !chainlet.IsServiceChainlet && chainlet.Status.IsActive()
+	epochInfo := k.epochskeeper.GetEpochInfo(ctx, params.BillingEpoch)
+
+	res := &types.QueryRunwayResponse{}
+	var covered uint64
+	for _, fee := range stack.Fees {
+		epochFee, err := sdk.ParseCoinNormalized(fee.EpochFee)
+		if err != nil {
+			return nil, err
+		}
+		balance, ok := balances[epochFee.Denom]
+		if !ok {
+			balance = math.ZeroInt()
+		}
+
+		credit := math.ZeroInt()
+		for _, c := range k.usableCredits(ctx, *chainlet, epochFee.Denom) {
+			credit = credit.Add(c.Amount.Amount)
+		}
+
+		epochs := epochsCovered(balance, credit, epochFee.Amount)
+		if res.NextFeeOption == "" && epochs > 0 {
+			res.NextFeeOption = epochFee.String()
+		}
+		option := types.RunwayOption{
+			Denom:      epochFee.Denom,
+			Balance:    sdk.NewCoin(epochFee.Denom, balance).String(),
+			EpochFee:   epochFee.String(),
+			EpochsLeft: saturatingSub(epochs, unpaidEpochs),
+		}
+		if billed {
+			option.ProjectedStopTime = projectedStopTime(epochInfo, saturatingAdd(option.EpochsLeft, graceEpochsLeft))
+		}
+		res.Options = append(res.Options, option)
+
+		// Billing falls back to the next fee option once a pool runs out
+		covered = saturatingAdd(covered, epochs)
+	}
+
+	res.TotalEpochsLeft = saturatingSub(covered, unpaidEpochs)
+	if billed {
+		res.ProjectedStopTime = projectedStopTime(epochInfo, saturatingAdd(res.TotalEpochsLeft, graceEpochsLeft))
+	}
+	return res, nil
+}
+
+// epochsCovered returns the number of epoch fees the balance and credits cover. Credits pay the
+// fees first, the escrow has to cover the rest of a fee partly paid with credits.
+func epochsCovered(balance, credit, epochFee math.Int) uint64 {
+	if !epochFee.IsPositive() {
+		return stdmath.MaxUint64
+	}
+	epochs := credit.Quo(epochFee)
+	if rest := epochFee.Sub(credit.Mod(epochFee)); rest.LT(epochFee) {
+		if balance.LT(rest) {
+			return saturatingUint64(epochs)
+		}
+		epochs = epochs.AddRaw(1)
+		balance = balance.Sub(rest)
+	}
+	return saturatingUint64(epochs.Add(balance.Quo(epochFee)))
+}
+
+func saturatingUint64(epochs math.Int) uint64 {
+	if !epochs.IsUint64() {
+		return stdmath.MaxUint64
+	}
+	return epochs.Uint64()
+}
+
+// projectedStopTime returns the start of the first epoch that is not paid for. Chainlets are
+// billed at the start of every epoch, the current one is already paid for.
+func projectedStopTime(epochInfo epochstypes.EpochInfo, epochs uint64) string {
+	if epochInfo.Duration <= 0 || epochs >= uint64(stdmath.MaxInt64/int64(epochInfo.Duration)) {
+		return ""
+	}
+	return epochInfo.CurrentEpochStartTime.Add(time.Duration(epochs+1) * epochInfo.Duration).Format(time.RFC3339)
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	if a > stdmath.MaxUint64-b {
+		return stdmath.MaxUint64
+	}
+	return a + b
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if a == stdmath.MaxUint64 {
+		return a
+	}
+	if a < b {
+		return 0
+	}
+	return a - b
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func TestRunway(t *testing.T) {
+	f := setupBillingFixture(t, 2)
+	f.stack.Fees = append(f.stack.Fees, chainlettypes.ChainletStackFees{Denom: "uusdc", EpochFee: "5uusdc"})
+	f.balances = sdk.NewCoins(sdk.NewInt64Coin("utsaga", 35), sdk.NewInt64Coin("uusdc", 12))
+	day := 24 * time.Hour
+	stopTime := func(epochs int) string {
+		return epochStartTime.Add(time.Duration(epochs) * day).Format(time.RFC3339)
+	}
+
+	res, err := f.keeper.Runway(f.ctx, &types.QueryRunwayRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryRunwayResponse{
+		Options: []types.RunwayOption{
+			{Denom: "utsaga", Balance: "35utsaga", EpochFee: "10utsaga", EpochsLeft: 3, ProjectedStopTime: stopTime(3 + 2 + 1)},
+			{Denom: "uusdc", Balance: "12uusdc", EpochFee: "5uusdc", EpochsLeft: 2, ProjectedStopTime: stopTime(2 + 2 + 1)},
+		},
+		NextFeeOption:     "10utsaga",
+		TotalEpochsLeft:   5,
+		ProjectedStopTime: stopTime(5 + 2 + 1),
+	}, res)
+
+	// Outstanding debt is settled first and shortens the grace period
+	f.keeper.ImportChainletDebt(f.ctx, types.ChainletDebt{ChainId: f.chainlet.ChainId, EpochIdentifier: types.SAGA_EPOCH_IDENTIFIER, FirstUnpaidEpoch: 1, UnpaidEpochs: 1})
+	res, err = f.keeper.Runway(f.ctx, &types.QueryRunwayRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Options[0].EpochsLeft)
+	require.Equal(t, stopTime(2+1+1), res.Options[0].ProjectedStopTime)
+	require.Equal(t, uint64(4), res.TotalEpochsLeft)
+	require.Equal(t, stopTime(4+1+1), res.ProjectedStopTime)
+
+	// Stopped chainlets have no projected stop time
+	f.chainlet.Status = chainlettypes.Status_STATUS_SUSPENDED_BILLING
+	res, err = f.keeper.Runway(f.ctx, &types.QueryRunwayRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Empty(t, res.ProjectedStopTime)
+	require.Empty(t, res.Options[0].ProjectedStopTime)
+}
+
+func TestRunwayCredits(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.ctx = f.ctx.WithBlockTime(epochStartTime)
+	f.stack.Fees = append(f.stack.Fees, chainlettypes.ChainletStackFees{Denom: "uusdc", EpochFee: "5uusdc"})
+	f.balances = sdk.NewCoins(sdk.NewInt64Coin("utsaga", 35), sdk.NewInt64Coin("uusdc", 2))
+	expiry := epochStartTime.Add(72 * time.Hour)
+
+	// Credits pay one fee in full and 7 of the next one, the escrow pays the remaining 3 and 3 more fees
+	_, err := f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("utsaga", 14), expiry, "")
+	require.NoError(t, err)
+	_, err = f.keeper.IssueBillingCredit(f.ctx, "", f.chainlet.Launcher, sdk.NewInt64Coin("utsaga", 3), expiry, "")
+	require.NoError(t, err)
+	// The escrow does not cover the 2 left to pay with the credit
+	_, err = f.keeper.IssueBillingCredit(f.ctx, f.chainlet.ChainId, "", sdk.NewInt64Coin("uusdc", 2), expiry, "")
+	require.NoError(t, err)
+
+	res, err := f.keeper.Runway(f.ctx, &types.QueryRunwayRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Options[0].EpochsLeft)
+	require.Equal(t, uint64(0), res.Options[1].EpochsLeft)
+	require.Equal(t, uint64(5), res.TotalEpochsLeft)
+	require.Equal(t, "10utsaga", res.NextFeeOption)
+
+	// Credits alone keep the chainlet running
+	f.balances = sdk.NewCoins()
+	res, err = f.keeper.Runway(f.ctx, &types.QueryRunwayRequest{ChainId: f.chainlet.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Options[0].EpochsLeft)
+	require.Equal(t, uint64(1), res.TotalEpochsLeft)
+	require.Equal(t, "10utsaga", res.NextFeeOption)
+}
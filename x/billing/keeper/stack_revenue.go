@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k Keeper) getStackRevenueShare(ctx sdk.Context, stackName string) (types.StackRevenueShare, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StackRevenueShareKey)
+	bz := store.Get([]byte(stackName))
+	if bz == nil {
+		return types.StackRevenueShare{StackName: stackName, Share: math.LegacyZeroDec()}, false
+	}
+	var share types.StackRevenueShare
+	k.cdc.MustUnmarshal(bz, &share)
+	return share, true
+}
+
+func (k Keeper) setStackRevenueShare(ctx sdk.Context, share types.StackRevenueShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StackRevenueShareKey)
+	if !share.Share.IsPositive() && share.PayoutAddress == "" {
+		store.Delete([]byte(share.StackName))
+		return
+	}
+	store.Set([]byte(share.StackName), k.cdc.MustMarshal(&share))
+}
+
+func (k Keeper) getStackRevenue(ctx sdk.Context, stackName string) types.StackRevenue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StackRevenueKey)
+	bz := store.Get([]byte(stackName))
+	if bz == nil {
+		return types.StackRevenue{StackName: stackName}
+	}
+	var revenue types.StackRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue
+}
+
+func (k Keeper) setStackRevenue(ctx sdk.Context, revenue types.StackRevenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StackRevenueKey)
+	store.Set([]byte(revenue.StackName), k.cdc.MustMarshal(&revenue))
+}
+
+// SetStackRevenueShare sets the revenue share of a chainlet stack. Only its creator can set it.
+func (k Keeper) SetStackRevenueShare(ctx sdk.Context, creator string, share types.StackRevenueShare) error {
+	res, err := k.chainletkeeper.GetChainletStack(ctx, &chainlettypes.QueryGetChainletStackRequest{DisplayName: share.StackName})
+	if err != nil {
+		return err
+	}
+	if res.ChainletStack.Creator != creator {
+		return types.ErrUnauthorized.Wrapf("only the creator of stack %s can set its revenue share", share.StackName)
+	}
+	if share.Share.IsNegative() {
+		return cosmossdkerrors.Wrapf(types.ErrInvalidRevenueShare, "share %s is negative", share.Share)
+	}
+	if max := k.GetParams(ctx).GetMaxStackRevenueShare(); share.Share.GT(max) {
+		return cosmossdkerrors.Wrapf(types.ErrInvalidRevenueShare, "share %s is above the maximum of %s", share.Share, max)
+	}
+
+	k.setStackRevenueShare(ctx, share)
+	return nil
+}
+
+// effectiveStackRevenueShare caps the share of a stack by the current params, which governance
+// may have lowered since the share was set.
+func (k Keeper) effectiveStackRevenueShare(ctx sdk.Context, share types.StackRevenueShare) math.LegacyDec {
+	return math.LegacyMinDec(share.Share, k.GetParams(ctx).GetMaxStackRevenueShare())
+}
+
+// payStackRevenue pays the revenue share of the stack of a billed chainlet out of the billed amount.
+// It returns the amount paid and its recipient, nothing if the stack has no share or the payment
+// failed, in which case the whole amount stays with the validators.
+func (k Keeper) payStackRevenue(ctx sdk.Context, chainlet chainlettypes.Chainlet, billed sdk.Coin) (sdk.Coin, string) {
+	none := sdk.NewCoin(billed.Denom, math.ZeroInt())
+
+	share, found := k.getStackRevenueShare(ctx, chainlet.ChainletStackName)
+	if !found {
+		return none, ""
+	}
+	amount := math.LegacyNewDecFromInt(billed.Amount).Mul(k.effectiveStackRevenueShare(ctx, share)).TruncateInt()
+	if !amount.IsPositive() {
+		return none, ""
+	}
+	revenue := sdk.NewCoin(billed.Denom, amount)
+
+	recipient := share.PayoutAddress
+	if recipient == "" {
+		stack, err := k.chainletkeeper.GetChainletStackInfo(ctx, chainlet.ChainId)
+		if err != nil {
+			ctx.Logger().Error("could not get stack of chainlet " + chainlet.ChainId + ": " + err.Error())
+			return none, ""
+		}
+		recipient = stack.Creator
+	}
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		ctx.Logger().Error("invalid revenue recipient " + recipient + " of stack " + chainlet.ChainletStackName + ": " + err.Error())
+		return none, ""
+	}
+	err = k.bankkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(revenue))
+	if err != nil {
+		ctx.Logger().Error("could not pay revenue of stack " + chainlet.ChainletStackName + " to " + recipient + ": " + err.Error())
+		return none, ""
+	}
+
+	total := k.getStackRevenue(ctx, chainlet.ChainletStackName)
+	total.Total = total.Total.Add(revenue)
+	k.setStackRevenue(ctx, total)
+
+	//nolint:errcheck // Event emission errors are non-critical
+	ctx.EventManager().EmitTypedEvent(&types.EventStackRevenue{
+		ChainId:   chainlet.ChainId,
+		StackName: chainlet.ChainletStackName,
+		Recipient: recipient,
+		Amount:    revenue.String(),
+	})
+	return revenue, recipient
+}
+
+// ExportStackRevenueShares exports all stack revenue shares from the store
+func (k Keeper) ExportStackRevenueShares(ctx sdk.Context) []types.StackRevenueShare {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StackRevenueShareKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var shares []types.StackRevenueShare
+	for ; it.Valid(); it.Next() {
+		var share types.StackRevenueShare
+		k.cdc.MustUnmarshal(it.Value(), &share)
+		shares = append(shares, share)
+	}
+	return shares
+}
+
+// ImportStackRevenueShare imports a single stack revenue share into the store
+func (k Keeper) ImportStackRevenueShare(ctx sdk.Context, share types.StackRevenueShare) {
+	k.setStackRevenueShare(ctx, share)
+}
+
+// ExportStackRevenues exports the revenue paid out per stack from the store
+func (k Keeper) ExportStackRevenues(ctx sdk.Context) []types.StackRevenue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StackRevenueKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var revenues []types.StackRevenue
+	for ; it.Valid(); it.Next() {
+		var revenue types.StackRevenue
+		k.cdc.MustUnmarshal(it.Value(), &revenue)
+		revenues = append(revenues, revenue)
+	}
+	return revenues
+}
+
+// ImportStackRevenue imports the revenue paid out for a single stack into the store
+func (k Keeper) ImportStackRevenue(ctx sdk.Context, revenue types.StackRevenue) {
+	k.setStackRevenue(ctx, revenue)
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/keeper"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func TestStackRevenueShare(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	stackCreator := sdk.AccAddress("stack-creator")
+	payout := sdk.AccAddress("payout")
+	f.stack.Creator = stackCreator.String()
+
+	f.chainletKeeper.EXPECT().GetChainletStack(gomock.Any(), &chainlettypes.QueryGetChainletStackRequest{DisplayName: "stack"}).Return(
+		&chainlettypes.QueryGetChainletStackResponse{ChainletStack: f.stack}, nil).AnyTimes()
+	f.escrowKeeper.EXPECT().BillAccount(gomock.Any(), gomock.Any(), f.chainlet.ChainId, "billing").Return(nil).AnyTimes()
+
+	msgServer := keeper.NewMsgServerImpl(*f.keeper)
+	setShare := func(creator, share, payoutAddress string) error {
+		_, err := msgServer.SetStackRevenueShare(f.ctx, &types.MsgSetStackRevenueShare{
+			Creator:       creator,
+			StackName:     "stack",
+			Share:         share,
+			PayoutAddress: payoutAddress,
+		})
+		return err
+	}
+	require.ErrorIs(t, setShare(sdk.AccAddress("other").String(), "0.05", ""), types.ErrUnauthorized)
+	require.ErrorIs(t, setShare(stackCreator.String(), "0.5", ""), types.ErrInvalidRevenueShare)
+	require.ErrorIs(t, setShare(stackCreator.String(), "-0.05", ""), types.ErrInvalidRevenueShare)
+
+	// Nothing is paid out without a share
+	require.NoError(t, f.keeper.BillAccount(f.ctx, sdk.NewInt64Coin("utsaga", 100), f.chainlet, "epoch"))
+
+	// The share goes to the stack creator
+	require.NoError(t, setShare(stackCreator.String(), "0.05", ""))
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, stackCreator, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 5))).Return(nil)
+	require.NoError(t, f.keeper.BillAccount(f.ctx, sdk.NewInt64Coin("utsaga", 100), f.chainlet, "epoch"))
+
+	// or to the payout address
+	require.NoError(t, setShare(stackCreator.String(), "0.1", payout.String()))
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, payout, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 10))).Return(nil)
+	require.NoError(t, f.keeper.BillAccount(f.ctx, sdk.NewInt64Coin("utsaga", 100), f.chainlet, "epoch"))
+
+	history, err := f.keeper.GetChainletBillingHistory(f.ctx, f.chainlet.ChainId)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Empty(t, history[0].CreatorRevenueRecipient)
+	require.Equal(t, "5utsaga", history[1].CreatorRevenue)
+	require.Equal(t, stackCreator.String(), history[1].CreatorRevenueRecipient)
+	require.Equal(t, "10utsaga", history[2].CreatorRevenue)
+	require.Equal(t, payout.String(), history[2].CreatorRevenueRecipient)
+
+	// Lowering the maximum caps existing shares
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxStackRevenueShare = math.LegacyNewDecWithPrec(2, 2)
+	f.keeper.SetParams(f.ctx, params)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, payout, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 2))).Return(nil)
+	require.NoError(t, f.keeper.BillAccount(f.ctx, sdk.NewInt64Coin("utsaga", 100), f.chainlet, "epoch"))
+
+	res, err := f.keeper.StackRevenue(f.ctx, &types.QueryStackRevenueRequest{StackName: "stack"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), res.Share.Share)
+	require.Equal(t, payout.String(), res.Share.PayoutAddress)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 2).String(), res.EffectiveShare)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 17)), res.Total)
+}
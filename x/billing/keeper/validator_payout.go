@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// splitByWeight splits the amount proportionally to the weights and returns the parts along with
+// the remainder. Each part is rounded down per denom, so the remainder is less than one unit per
+// denom and part. Nothing is split if all weights are zero.
+func splitByWeight(amount sdk.Coins, weights []math.Int) ([]sdk.Coins, sdk.Coins) {
+	total := math.ZeroInt()
+	for _, weight := range weights {
+		total = total.Add(weight)
+	}
+
+	parts := make([]sdk.Coins, len(weights))
+	remainder := amount
+	if !total.IsPositive() {
+		return parts, remainder
+	}
+	for i, weight := range weights {
+		for _, coin := range amount {
+			parts[i] = parts[i].Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(weight).Quo(total)))
+		}
+		remainder = remainder.Sub(parts[i]...)
+	}
+	return parts, remainder
+}
+
+// validatorWeights returns the payout weight of each validator for the payout mode. Jailed
+// validators and accounts that are not validators get nothing in the weighted modes. If no
+// validator has any weight, they all share equally.
+func (k Keeper) validatorWeights(ctx sdk.Context, mode types.PayoutMode, validatorAddrs []string) []math.Int {
+	weights := make([]math.Int, len(validatorAddrs))
+	for i := range weights {
+		weights[i] = math.OneInt()
+	}
+	if mode == types.PayoutMode_PAYOUT_MODE_EQUAL {
+		return weights
+	}
+
+	var chainlets []*chainlettypes.Chainlet
+	if mode == types.PayoutMode_PAYOUT_MODE_PARTICIPATION_WEIGHTED {
+		chainlets = k.billedChainlets(ctx)
+	}
+
+	weighted := make([]math.Int, len(validatorAddrs))
+	total := math.ZeroInt()
+	for i, addr := range validatorAddrs {
+		weighted[i] = math.ZeroInt()
+
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			continue
+		}
+		validator, err := k.stakingkeeper.GetValidator(ctx, sdk.ValAddress(accAddr))
+		if err != nil || validator.IsJailed() {
+			continue
+		}
+
+		switch mode {
+		case types.PayoutMode_PAYOUT_MODE_STAKE_WEIGHTED:
+			weighted[i] = validator.GetBondedTokens()
+		case types.PayoutMode_PAYOUT_MODE_PARTICIPATION_WEIGHTED:
+			weighted[i] = math.NewInt(k.participation(ctx, validator, chainlets))
+		}
+		total = total.Add(weighted[i])
+	}
+	if !total.IsPositive() {
+		ctx.Logger().Info("no validator qualifies for the " + mode.String() + " payout, splitting equally")
+		return weights
+	}
+	return weighted
+}
+
+// billedChainlets returns the chainlets billed for epoch fees.
+func (k Keeper) billedChainlets(ctx sdk.Context) []*chainlettypes.Chainlet {
+	resp, err := k.chainletkeeper.ListChainlets(ctx, &chainlettypes.QueryListChainletsRequest{
+		Pagination: &query.PageRequest{Limit: k.chainletkeeper.GetParams(ctx).MaxChainlets},
+	})
+	if err != nil {
+		ctx.Logger().Error("could not list chainlets. Error: " + err.Error())
+		return nil
+	}
+
+	var chainlets []*chainlettypes.Chainlet
+	for _, ch := range resp.Chainlets {
+		if ch.IsServiceChainlet || !ch.Status.IsActive() {
+			continue
+		}
+		chainlets = append(chainlets, ch)
+	}
+	return chainlets
+}
+
+// participation returns the number of chainlets a validator runs: it submitted peers for them and,
+// for CCV consumer chainlets, opted in to the consumer chain.
+func (k Keeper) participation(ctx sdk.Context, validator stakingtypes.Validator, chainlets []*chainlettypes.Chainlet) int64 {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		ctx.Logger().Error("could not get consensus address of validator " + validator.OperatorAddress + ". Error: " + err.Error())
+		return 0
+	}
+	providerAddr := ccvprovidertypes.NewProviderConsAddress(consAddr)
+
+	var n int64
+	for _, ch := range chainlets {
+		if !k.peerskeeper.HasPeers(ctx, ch.ChainId, validator.OperatorAddress) {
+			continue
+		}
+		if ch.IsCCVConsumer && !k.providerkeeper.IsOptedIn(ctx, ch.ConsumerId, providerAddr) {
+			continue
+		}
+		n++
+	}
+	return n
+}
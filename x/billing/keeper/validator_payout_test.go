@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	billingtestutil "github.com/sagaxyz/ssc/x/billing/testutil"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestWeightedValidatorPayouts(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	f.chainlet.IsCCVConsumer = true
+	f.chainlet.ConsumerId = "7"
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	f.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAccount.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin("utsaga", 1001))).AnyTimes()
+
+	ctrl := gomock.NewController(t)
+	peersKeeper := billingtestutil.NewMockPeersKeeper(ctrl)
+	providerKeeper := billingtestutil.NewMockProviderKeeper(ctrl)
+	f.keeper.UpdateKeeper(peersKeeper)
+	f.keeper.UpdateKeeper(providerKeeper)
+
+	// Validators 1 and 2 are bonded, validator 3 is jailed
+	var addrs []string
+	validators := make(map[string]stakingtypes.Validator)
+	for i, tokens := range []int64{300, 100, 500} {
+		accAddr := sdk.AccAddress([]byte{byte(i + 1)})
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(accAddr).String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = math.NewInt(tokens)
+		validator.Jailed = i == 2
+		validators[validator.OperatorAddress] = validator
+		addrs = append(addrs, accAddr.String())
+		f.stakingKeeper.EXPECT().GetValidator(gomock.Any(), sdk.ValAddress(accAddr)).Return(validator, nil).AnyTimes()
+	}
+	params := f.keeper.GetParams(f.ctx)
+	params.PlatformValidators = addrs
+	epoch := int64(0)
+
+	// Runs the payout for the mode and returns the amounts paid to each validator and the community pool
+	payout := func(mode types.PayoutMode) ([]int64, int64) {
+		params.ValidatorPayoutMode = mode
+		f.keeper.SetParams(f.ctx, params)
+
+		var communityPool int64
+		f.distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), gomock.Any(), moduleAccount.GetAddress()).DoAndReturn(
+			func(_ interface{}, amount sdk.Coins, _ sdk.AccAddress) error {
+				communityPool = amount.AmountOf("utsaga").Int64()
+				return nil
+			}).MaxTimes(1)
+
+		epoch++
+		require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, types.SAGA_EPOCH_IDENTIFIER, epoch))
+
+		// Claim the accrued rewards so the next epoch starts from the same balance
+		paid := make([]int64, len(addrs))
+		for i, addr := range addrs {
+			res, err := f.keeper.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{ValidatorAddress: addr})
+			require.NoError(t, err)
+			paid[i] = res.Amount.AmountOf("utsaga").Int64()
+			if paid[i] == 0 {
+				continue
+			}
+			f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(addr), res.Amount).Return(nil)
+			_, err = f.keeper.ClaimRewards(f.ctx, addr)
+			require.NoError(t, err)
+		}
+		return paid, communityPool
+	}
+
+	paid, communityPool := payout(types.PayoutMode_PAYOUT_MODE_EQUAL)
+	require.Equal(t, []int64{333, 333, 333}, paid)
+	require.Equal(t, int64(2), communityPool)
+
+	paid, communityPool = payout(types.PayoutMode_PAYOUT_MODE_STAKE_WEIGHTED)
+	require.Equal(t, []int64{750, 250, 0}, paid)
+	require.Equal(t, int64(1), communityPool)
+
+	// Validators 1 and 2 run the chainlet but only validator 1 opted in to the consumer chain
+	val1, val2 := validators[sdk.ValAddress(sdk.AccAddress([]byte{1})).String()], validators[sdk.ValAddress(sdk.AccAddress([]byte{2})).String()]
+	peersKeeper.EXPECT().HasPeers(gomock.Any(), f.chainlet.ChainId, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ string, addr string) bool {
+			return addr == val1.OperatorAddress || addr == val2.OperatorAddress
+		}).AnyTimes()
+	consAddr, err := val1.GetConsAddr()
+	require.NoError(t, err)
+	providerKeeper.EXPECT().IsOptedIn(gomock.Any(), "7", gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ string, providerAddr ccvprovidertypes.ProviderConsAddress) bool {
+			return providerAddr.ToSdkConsAddr().Equals(sdk.ConsAddress(consAddr))
+		}).AnyTimes()
+
+	paid, communityPool = payout(types.PayoutMode_PAYOUT_MODE_PARTICIPATION_WEIGHTED)
+	require.Equal(t, []int64{1001, 0, 0}, paid)
+	require.Equal(t, int64(0), communityPool)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func (k Keeper) getPendingRewards(ctx sdk.Context, validatorAddr string) types.PendingRewards {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRewardsKey)
+	bz := store.Get([]byte(validatorAddr))
+	if bz == nil {
+		return types.PendingRewards{ValidatorAddress: validatorAddr}
+	}
+	var rewards types.PendingRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards
+}
+
+func (k Keeper) setPendingRewards(ctx sdk.Context, rewards types.PendingRewards) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRewardsKey)
+	if rewards.Amount.IsZero() {
+		store.Delete([]byte(rewards.ValidatorAddress))
+		return
+	}
+	store.Set([]byte(rewards.ValidatorAddress), k.cdc.MustMarshal(&rewards))
+}
+
+// getPendingRewardsTotal returns the rewards of all validators not claimed yet. They are held by
+// the module account and excluded from the fees distributed at the end of an epoch.
+func (k Keeper) getPendingRewardsTotal(ctx sdk.Context) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingRewardsTotalKey)
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var total types.PendingRewards
+	k.cdc.MustUnmarshal(bz, &total)
+	return total.Amount
+}
+
+func (k Keeper) setPendingRewardsTotal(ctx sdk.Context, amount sdk.Coins) {
+	total := types.PendingRewards{Amount: amount}
+	ctx.KVStore(k.storeKey).Set(types.PendingRewardsTotalKey, k.cdc.MustMarshal(&total))
+}
+
+// accrueRewards adds to the rewards a validator can claim.
+func (k Keeper) accrueRewards(ctx sdk.Context, validatorAddr string, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+	rewards := k.getPendingRewards(ctx, validatorAddr)
+	rewards.Amount = rewards.Amount.Add(amount...)
+	k.setPendingRewards(ctx, rewards)
+	k.setPendingRewardsTotal(ctx, k.getPendingRewardsTotal(ctx).Add(amount...))
+}
+
+// GetRewardWithdrawAddress returns the address the rewards of a validator are claimed to.
+func (k Keeper) GetRewardWithdrawAddress(ctx sdk.Context, validatorAddr string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardWithdrawAddressKey)
+	bz := store.Get([]byte(validatorAddr))
+	if bz == nil {
+		return validatorAddr
+	}
+	return string(bz)
+}
+
+// SetRewardWithdrawAddress sets the address the rewards of a validator are claimed to. The
+// override is removed if the address is empty or the validator address itself.
+func (k Keeper) SetRewardWithdrawAddress(ctx sdk.Context, validatorAddr, withdrawAddr string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardWithdrawAddressKey)
+	if withdrawAddr == "" || withdrawAddr == validatorAddr {
+		store.Delete([]byte(validatorAddr))
+		return
+	}
+	store.Set([]byte(validatorAddr), []byte(withdrawAddr))
+}
+
+// ClaimRewards pays the pending rewards of a validator to its withdraw address.
+func (k Keeper) ClaimRewards(ctx sdk.Context, validatorAddr string) (sdk.Coins, error) {
+	rewards := k.getPendingRewards(ctx, validatorAddr)
+	if rewards.Amount.IsZero() {
+		return nil, types.ErrNoRecords.Wrapf("no pending rewards for %s", validatorAddr)
+	}
+
+	recipient := k.GetRewardWithdrawAddress(ctx, validatorAddr)
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, rewards.Amount)
+	if err != nil {
+		return nil, types.ErrInternalBillingFailure.Wrapf("could not send rewards of %s to %s: %s", validatorAddr, recipient, err)
+	}
+
+	k.setPendingRewardsTotal(ctx, k.getPendingRewardsTotal(ctx).Sub(rewards.Amount...))
+	k.setPendingRewards(ctx, types.PendingRewards{ValidatorAddress: validatorAddr})
+
+	//nolint:errcheck // Event emission errors are non-critical
+	ctx.EventManager().EmitTypedEvent(&types.EventBillingRewardsClaimed{
+		ValidatorAddress: validatorAddr,
+		Recipient:        recipient,
+		Amount:           rewards.Amount.String(),
+	})
+	return rewards.Amount, nil
+}
+
+// ExportPendingRewards exports the pending rewards of all validators from the store
+func (k Keeper) ExportPendingRewards(ctx sdk.Context) []types.PendingRewards {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRewardsKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var rewards []types.PendingRewards
+	for ; it.Valid(); it.Next() {
+		var r types.PendingRewards
+		k.cdc.MustUnmarshal(it.Value(), &r)
+		rewards = append(rewards, r)
+	}
+	return rewards
+}
+
+// ImportPendingRewards imports the pending rewards of a single validator into the store
+func (k Keeper) ImportPendingRewards(ctx sdk.Context, rewards types.PendingRewards) {
+	k.accrueRewards(ctx, rewards.ValidatorAddress, rewards.Amount)
+}
+
+// ExportRewardWithdrawAddresses exports the withdraw addresses set by validators from the store
+func (k Keeper) ExportRewardWithdrawAddresses(ctx sdk.Context) []types.RewardWithdrawAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardWithdrawAddressKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var addrs []types.RewardWithdrawAddress
+	for ; it.Valid(); it.Next() {
+		addrs = append(addrs, types.RewardWithdrawAddress{
+			ValidatorAddress: string(it.Key()),
+			WithdrawAddress:  string(it.Value()),
+		})
+	}
+	return addrs
+}
+
+// ImportRewardWithdrawAddress imports the withdraw address of a single validator into the store
+func (k Keeper) ImportRewardWithdrawAddress(ctx sdk.Context, addr types.RewardWithdrawAddress) {
+	k.SetRewardWithdrawAddress(ctx, addr.ValidatorAddress, addr.WithdrawAddress)
+}
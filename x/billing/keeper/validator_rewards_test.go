@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/billing/keeper"
+	"github.com/sagaxyz/ssc/x/billing/types"
+)
+
+func TestClaimValidatorRewards(t *testing.T) {
+	f := setupBillingFixture(t, 0)
+	val1 := sdk.AccAddress("val1")
+	val2 := sdk.AccAddress("val2")
+	withdraw := sdk.AccAddress("withdraw")
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	msgServer := keeper.NewMsgServerImpl(*f.keeper)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.PlatformValidators = []string{val1.String(), val2.String()}
+	f.keeper.SetParams(f.ctx, params)
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin("utsaga", 100))
+	f.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAccount).AnyTimes()
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAccount.GetAddress()).DoAndReturn(
+		func(_ interface{}, _ sdk.AccAddress) sdk.Coins {
+			return balance
+		}).AnyTimes()
+
+	_, err := msgServer.ClaimBillingRewards(f.ctx, &types.MsgClaimBillingRewards{Creator: val1.String()})
+	require.ErrorIs(t, err, types.ErrNoRecords)
+
+	// Nothing is paid out at the end of the epoch
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 1))
+	res, err := f.keeper.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{ValidatorAddress: val1.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 50)), res.Amount)
+	require.Equal(t, val1.String(), res.WithdrawAddress)
+
+	// Unclaimed rewards are not distributed again
+	balance = balance.Add(sdk.NewInt64Coin("utsaga", 20))
+	require.NoError(t, f.keeper.AfterEpochEnd(f.ctx, types.SAGA_EPOCH_IDENTIFIER, 2))
+	res, err = f.keeper.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{ValidatorAddress: val1.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 60)), res.Amount)
+
+	// Rewards are claimed to the withdraw address
+	_, err = msgServer.SetRewardWithdrawAddress(f.ctx, &types.MsgSetRewardWithdrawAddress{Creator: val1.String(), WithdrawAddress: withdraw.String()})
+	require.NoError(t, err)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, withdraw, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 60))).Return(nil)
+	claimed, err := msgServer.ClaimBillingRewards(f.ctx, &types.MsgClaimBillingRewards{Creator: val1.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 60)), claimed.Amount)
+	balance = balance.Sub(claimed.Amount...)
+
+	res, err = f.keeper.PendingRewards(f.ctx, &types.QueryPendingRewardsRequest{ValidatorAddress: val1.String()})
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+	require.Equal(t, withdraw.String(), res.WithdrawAddress)
+
+	// The rewards of validator 2 stay pending and are exported
+	pending := f.keeper.ExportPendingRewards(f.ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, val2.String(), pending[0].ValidatorAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 60)), pending[0].Amount)
+	require.Equal(t, []types.RewardWithdrawAddress{{ValidatorAddress: val1.String(), WithdrawAddress: withdraw.String()}}, f.keeper.ExportRewardWithdrawAddresses(f.ctx))
+
+	// Resetting the withdraw address pays the validator again
+	_, err = msgServer.SetRewardWithdrawAddress(f.ctx, &types.MsgSetRewardWithdrawAddress{Creator: val1.String()})
+	require.NoError(t, err)
+	require.Equal(t, val1.String(), f.keeper.GetRewardWithdrawAddress(f.ctx, val1.String()))
+}
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+var (
+	legacyBillingHistoryPrefix         = []byte("billinghistory-")
+	legacyValidatorPayoutHistoryPrefix = []byte("validatorpayout-")
+)
+
+// MigrateStore moves the billing and validator payout history from the string-concatenated
+// keys to length-prefixed keys. Billing records are assigned IDs and a snapshot of the
+// chainlet, as far as it still exists.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, chainletKeeper types.ChainletKeeper, epochsKeeper types.EpochsKeeper) error {
+	store := ctx.KVStore(storeKey)
+
+	var legacyBilling []types.SaveBillingHistory
+	legacyStore := prefix.NewStore(store, legacyBillingHistoryPrefix)
+	iterator := legacyStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SaveBillingHistory
+		cdc.MustUnmarshal(iterator.Value(), &record)
+		legacyBilling = append(legacyBilling, record)
+	}
+	iterator.Close()
+	deleteAll(legacyStore)
+
+	billingStore := prefix.NewStore(store, types.BillingHistoryKey)
+	chainlets := make(map[string]*chainlettypes.Chainlet)
+	var id uint64
+	for _, legacy := range legacyBilling {
+		id++
+		record := types.BillingHistory{
+			Id:              id,
+			ChainletId:      legacy.ChainletId,
+			EpochIdentifier: legacy.EpochIdentifier,
+			EpochNumber:     legacy.EpochNumber,
+			BilledAmount:    legacy.BilledAmount,
+		}
+
+		chainlet, ok := chainlets[legacy.ChainletId]
+		if !ok {
+			res, err := chainletKeeper.GetChainlet(ctx, &chainlettypes.QueryGetChainletRequest{ChainId: legacy.ChainletId})
+			if err == nil {
+				chainlet = &res.Chainlet
+			}
+			chainlets[legacy.ChainletId] = chainlet
+		}
+		if chainlet != nil {
+			record.ChainletName = chainlet.ChainletName
+			record.ChainletOwner = chainlet.Launcher
+			record.ChainletStackName = chainlet.ChainletStackName
+		}
+
+		epochInfo := epochsKeeper.GetEpochInfo(ctx, legacy.EpochIdentifier)
+		epochSince := epochInfo.CurrentEpoch - legacy.EpochNumber
+		epochStartTime := epochInfo.CurrentEpochStartTime.Add(-time.Duration(epochSince * int64(epochInfo.Duration)))
+		record.EpochStartTime = epochStartTime.Format(time.RFC3339)
+
+		billingStore.Set(types.BillingHistoryRecordKey(record), cdc.MustMarshal(&record))
+	}
+	if id > 0 {
+		store.Set(types.BillingHistorySeqKey, binary.BigEndian.AppendUint64(nil, id))
+	}
+
+	var legacyPayouts []types.ValidatorPayoutHistory
+	legacyStore = prefix.NewStore(store, legacyValidatorPayoutHistoryPrefix)
+	iterator = legacyStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorPayoutHistory
+		cdc.MustUnmarshal(iterator.Value(), &record)
+		legacyPayouts = append(legacyPayouts, record)
+	}
+	iterator.Close()
+	deleteAll(legacyStore)
+
+	payoutStore := prefix.NewStore(store, types.ValidatorPayoutHistoryKey)
+	for _, record := range legacyPayouts {
+		payoutStore.Set(types.ValidatorPayoutHistoryRecordKey(record), cdc.MustMarshal(&record))
+	}
+
+	return nil
+}
+
+func deleteAll(store prefix.Store) {
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
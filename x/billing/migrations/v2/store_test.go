@@ -0,0 +1,98 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/sagaxyz/ssc/x/billing/migrations/v2"
+	billingtestutil "github.com/sagaxyz/ssc/x/billing/testutil"
+	"github.com/sagaxyz/ssc/x/billing/types"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	epochstypes "github.com/sagaxyz/ssc/x/epochs/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	ctrl := gomock.NewController(t)
+	chainletKeeper := billingtestutil.NewMockChainletKeeper(ctrl)
+	epochsKeeper := billingtestutil.NewMockEpochsKeeper(ctrl)
+
+	chainletKeeper.EXPECT().GetChainlet(gomock.Any(), &chainlettypes.QueryGetChainletRequest{ChainId: "chain-1"}).
+		Return(&chainlettypes.QueryGetChainletResponse{Chainlet: chainlettypes.Chainlet{
+			ChainId:           "chain-1",
+			ChainletName:      "one",
+			Launcher:          "owner1",
+			ChainletStackName: "stack",
+		}}, nil)
+	chainletKeeper.EXPECT().GetChainlet(gomock.Any(), &chainlettypes.QueryGetChainletRequest{ChainId: "chain-10"}).
+		Return(nil, fmt.Errorf("not found"))
+	epochStart := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "day").Return(epochstypes.EpochInfo{
+		Identifier:            "day",
+		Duration:              24 * time.Hour,
+		CurrentEpoch:          10,
+		CurrentEpochStartTime: epochStart,
+	}).AnyTimes()
+
+	// Legacy layout: the chain ID was concatenated with the record key
+	store := ctx.KVStore(key)
+	legacyBilling := []types.SaveBillingHistory{
+		{ChainletId: "chain-1", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "10usaga"},
+		{ChainletId: "chain-1", EpochIdentifier: "day", EpochNumber: 2, BilledAmount: "10usaga"},
+		{ChainletId: "chain-10", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "20usaga"},
+	}
+	for _, record := range legacyBilling {
+		legacyStore := prefix.NewStore(store, []byte("billinghistory-"+record.ChainletId))
+		legacyStore.Set([]byte(fmt.Sprintf("%s-%d", record.EpochIdentifier, record.EpochNumber)), cdc.MustMarshal(&record))
+	}
+	payout := types.ValidatorPayoutHistory{ValidatorAddress: "val1", EpochIdentifier: "day", EpochNumber: 1, RewardAmount: "5usaga"}
+	legacyStore := prefix.NewStore(store, []byte("validatorpayout-"+payout.ValidatorAddress))
+	legacyStore.Set([]byte("day-1"), cdc.MustMarshal(&payout))
+
+	require.NoError(t, v2.MigrateStore(ctx, key, cdc, chainletKeeper, epochsKeeper))
+
+	require.False(t, prefix.NewStore(store, []byte("billinghistory-")).Iterator(nil, nil).Valid())
+	require.False(t, prefix.NewStore(store, []byte("validatorpayout-")).Iterator(nil, nil).Valid())
+
+	chain1 := migratedRecords(t, cdc, store, "chain-1")
+	require.Len(t, chain1, 2)
+	require.Equal(t, "one", chain1[0].ChainletName)
+	require.Equal(t, "owner1", chain1[0].ChainletOwner)
+	require.Equal(t, "stack", chain1[0].ChainletStackName)
+	require.Equal(t, epochStart.Add(-9*24*time.Hour).Format(time.RFC3339), chain1[0].EpochStartTime)
+	require.NotEqual(t, chain1[0].Id, chain1[1].Id)
+
+	chain10 := migratedRecords(t, cdc, store, "chain-10")
+	require.Len(t, chain10, 1)
+	require.Equal(t, "20usaga", chain10[0].BilledAmount)
+	require.Empty(t, chain10[0].ChainletName)
+	require.NotNil(t, store.Get(types.BillingHistorySeqKey))
+
+	require.True(t, prefix.NewStore(store, types.ValidatorPayoutHistoryKey).Has(types.ValidatorPayoutHistoryRecordKey(payout)))
+}
+
+func migratedRecords(t *testing.T, cdc codec.Codec, store storetypes.KVStore, chainId string) []types.BillingHistory {
+	t.Helper()
+
+	var records []types.BillingHistory
+	iterator := prefix.NewStore(store, append(types.BillingHistoryKey, types.BillingHistoryPrefix(chainId, "")...)).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BillingHistory
+		cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
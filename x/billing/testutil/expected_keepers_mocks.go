@@ -11,10 +11,11 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types2 "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	gomock "github.com/golang/mock/gomock"
-	types2 "github.com/sagaxyz/ssc/x/chainlet/types"
-	types3 "github.com/sagaxyz/ssc/x/epochs/types"
-	types4 "github.com/sagaxyz/ssc/x/escrow/types"
+	types3 "github.com/sagaxyz/ssc/x/chainlet/types"
+	types4 "github.com/sagaxyz/ssc/x/epochs/types"
+	types5 "github.com/sagaxyz/ssc/x/escrow/types"
 )
 
 // MockAccountKeeper is a mock of AccountKeeper interface.
@@ -278,6 +279,20 @@ func (m *MockEscrowKeeper) EXPECT() *MockEscrowKeeperMockRecorder {
 	return m.recorder
 }
 
+// BeforeEpochStart mocks base method.
+func (m *MockEscrowKeeper) BeforeEpochStart(ctx types.Context, epochIdentifier string, epochNumber int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeEpochStart", ctx, epochIdentifier, epochNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeforeEpochStart indicates an expected call of BeforeEpochStart.
+func (mr *MockEscrowKeeperMockRecorder) BeforeEpochStart(ctx, epochIdentifier, epochNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeEpochStart", reflect.TypeOf((*MockEscrowKeeper)(nil).BeforeEpochStart), ctx, epochIdentifier, epochNumber)
+}
+
 // BillAccount mocks base method.
 func (m *MockEscrowKeeper) BillAccount(ctx types.Context, amount types.Coin, chainId, toModule string) error {
 	m.ctrl.T.Helper()
@@ -293,11 +308,11 @@ func (mr *MockEscrowKeeperMockRecorder) BillAccount(ctx, amount, chainId, toModu
 }
 
 // GetChainletWithPools mocks base method.
-func (m *MockEscrowKeeper) GetChainletWithPools(ctx types.Context, chainId string) (types4.ChainletAccount, []*types4.DenomPool, error) {
+func (m *MockEscrowKeeper) GetChainletWithPools(ctx types.Context, chainId string) (types5.ChainletAccount, []*types5.DenomPool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChainletWithPools", ctx, chainId)
-	ret0, _ := ret[0].(types4.ChainletAccount)
-	ret1, _ := ret[1].([]*types4.DenomPool)
+	ret0, _ := ret[0].(types5.ChainletAccount)
+	ret1, _ := ret[1].([]*types5.DenomPool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
@@ -308,6 +323,20 @@ func (mr *MockEscrowKeeperMockRecorder) GetChainletWithPools(ctx, chainId interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainletWithPools", reflect.TypeOf((*MockEscrowKeeper)(nil).GetChainletWithPools), ctx, chainId)
 }
 
+// RefundBilling mocks base method.
+func (m *MockEscrowKeeper) RefundBilling(ctx types.Context, amount types.Coin, chainId, fromModule string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundBilling", ctx, amount, chainId, fromModule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundBilling indicates an expected call of RefundBilling.
+func (mr *MockEscrowKeeperMockRecorder) RefundBilling(ctx, amount, chainId, fromModule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundBilling", reflect.TypeOf((*MockEscrowKeeper)(nil).RefundBilling), ctx, amount, chainId, fromModule)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -331,6 +360,36 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types.ValAddress) (types1.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// GetValidatorByConsAddr mocks base method.
+func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx context.Context, consAddr types.ConsAddress) (types1.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorByConsAddr", ctx, consAddr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorByConsAddr indicates an expected call of GetValidatorByConsAddr.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorByConsAddr), ctx, consAddr)
+}
+
 // GetValidators mocks base method.
 func (m *MockStakingKeeper) GetValidators(ctx context.Context, maxRetrieve uint32) ([]types1.Validator, error) {
 	m.ctrl.T.Helper()
@@ -346,6 +405,131 @@ func (mr *MockStakingKeeperMockRecorder) GetValidators(ctx, maxRetrieve interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidators), ctx, maxRetrieve)
 }
 
+// MockPeersKeeper is a mock of PeersKeeper interface.
+type MockPeersKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPeersKeeperMockRecorder
+}
+
+// MockPeersKeeperMockRecorder is the mock recorder for MockPeersKeeper.
+type MockPeersKeeperMockRecorder struct {
+	mock *MockPeersKeeper
+}
+
+// NewMockPeersKeeper creates a new mock instance.
+func NewMockPeersKeeper(ctrl *gomock.Controller) *MockPeersKeeper {
+	mock := &MockPeersKeeper{ctrl: ctrl}
+	mock.recorder = &MockPeersKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPeersKeeper) EXPECT() *MockPeersKeeperMockRecorder {
+	return m.recorder
+}
+
+// HasPeers mocks base method.
+func (m *MockPeersKeeper) HasPeers(ctx types.Context, chainID, addr string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPeers", ctx, chainID, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasPeers indicates an expected call of HasPeers.
+func (mr *MockPeersKeeperMockRecorder) HasPeers(ctx, chainID, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPeers", reflect.TypeOf((*MockPeersKeeper)(nil).HasPeers), ctx, chainID, addr)
+}
+
+// MockProviderKeeper is a mock of ProviderKeeper interface.
+type MockProviderKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderKeeperMockRecorder
+}
+
+// MockProviderKeeperMockRecorder is the mock recorder for MockProviderKeeper.
+type MockProviderKeeperMockRecorder struct {
+	mock *MockProviderKeeper
+}
+
+// NewMockProviderKeeper creates a new mock instance.
+func NewMockProviderKeeper(ctrl *gomock.Controller) *MockProviderKeeper {
+	mock := &MockProviderKeeper{ctrl: ctrl}
+	mock.recorder = &MockProviderKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderKeeper) EXPECT() *MockProviderKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetAllOptedIn mocks base method.
+func (m *MockProviderKeeper) GetAllOptedIn(ctx types.Context, consumerId string) []types2.ProviderConsAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllOptedIn", ctx, consumerId)
+	ret0, _ := ret[0].([]types2.ProviderConsAddress)
+	return ret0
+}
+
+// GetAllOptedIn indicates an expected call of GetAllOptedIn.
+func (mr *MockProviderKeeperMockRecorder) GetAllOptedIn(ctx, consumerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOptedIn", reflect.TypeOf((*MockProviderKeeper)(nil).GetAllOptedIn), ctx, consumerId)
+}
+
+// IsOptedIn mocks base method.
+func (m *MockProviderKeeper) IsOptedIn(ctx types.Context, consumerId string, providerAddr types2.ProviderConsAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOptedIn", ctx, consumerId, providerAddr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsOptedIn indicates an expected call of IsOptedIn.
+func (mr *MockProviderKeeperMockRecorder) IsOptedIn(ctx, consumerId, providerAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOptedIn", reflect.TypeOf((*MockProviderKeeper)(nil).IsOptedIn), ctx, consumerId, providerAddr)
+}
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
 // MockEpochsKeeper is a mock of EpochsKeeper interface.
 type MockEpochsKeeper struct {
 	ctrl     *gomock.Controller
@@ -370,10 +554,10 @@ func (m *MockEpochsKeeper) EXPECT() *MockEpochsKeeperMockRecorder {
 }
 
 // GetEpochInfo mocks base method.
-func (m *MockEpochsKeeper) GetEpochInfo(ctx types.Context, identifier string) types3.EpochInfo {
+func (m *MockEpochsKeeper) GetEpochInfo(ctx types.Context, identifier string) types4.EpochInfo {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEpochInfo", ctx, identifier)
-	ret0, _ := ret[0].(types3.EpochInfo)
+	ret0, _ := ret[0].(types4.EpochInfo)
 	return ret0
 }
 
@@ -421,10 +605,10 @@ func (mr *MockChainletKeeperMockRecorder) ChainletExists(ctx, chainId interface{
 }
 
 // GetChainlet mocks base method.
-func (m *MockChainletKeeper) GetChainlet(ctx context.Context, req *types2.QueryGetChainletRequest) (*types2.QueryGetChainletResponse, error) {
+func (m *MockChainletKeeper) GetChainlet(ctx context.Context, req *types3.QueryGetChainletRequest) (*types3.QueryGetChainletResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChainlet", ctx, req)
-	ret0, _ := ret[0].(*types2.QueryGetChainletResponse)
+	ret0, _ := ret[0].(*types3.QueryGetChainletResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -436,10 +620,10 @@ func (mr *MockChainletKeeperMockRecorder) GetChainlet(ctx, req interface{}) *gom
 }
 
 // GetChainletInfo mocks base method.
-func (m *MockChainletKeeper) GetChainletInfo(ctx types.Context, chainId string) (*types2.Chainlet, error) {
+func (m *MockChainletKeeper) GetChainletInfo(ctx types.Context, chainId string) (*types3.Chainlet, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChainletInfo", ctx, chainId)
-	ret0, _ := ret[0].(*types2.Chainlet)
+	ret0, _ := ret[0].(*types3.Chainlet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -450,11 +634,26 @@ func (mr *MockChainletKeeperMockRecorder) GetChainletInfo(ctx, chainId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainletInfo", reflect.TypeOf((*MockChainletKeeper)(nil).GetChainletInfo), ctx, chainId)
 }
 
+// GetChainletStack mocks base method.
+func (m *MockChainletKeeper) GetChainletStack(ctx context.Context, req *types3.QueryGetChainletStackRequest) (*types3.QueryGetChainletStackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainletStack", ctx, req)
+	ret0, _ := ret[0].(*types3.QueryGetChainletStackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChainletStack indicates an expected call of GetChainletStack.
+func (mr *MockChainletKeeperMockRecorder) GetChainletStack(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainletStack", reflect.TypeOf((*MockChainletKeeper)(nil).GetChainletStack), ctx, req)
+}
+
 // GetChainletStackInfo mocks base method.
-func (m *MockChainletKeeper) GetChainletStackInfo(ctx types.Context, chainId string) (*types2.ChainletStack, error) {
+func (m *MockChainletKeeper) GetChainletStackInfo(ctx types.Context, chainId string) (*types3.ChainletStack, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChainletStackInfo", ctx, chainId)
-	ret0, _ := ret[0].(*types2.ChainletStack)
+	ret0, _ := ret[0].(*types3.ChainletStack)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -466,10 +665,10 @@ func (mr *MockChainletKeeperMockRecorder) GetChainletStackInfo(ctx, chainId inte
 }
 
 // GetParams mocks base method.
-func (m *MockChainletKeeper) GetParams(ctx types.Context) types2.Params {
+func (m *MockChainletKeeper) GetParams(ctx types.Context) types3.Params {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetParams", ctx)
-	ret0, _ := ret[0].(types2.Params)
+	ret0, _ := ret[0].(types3.Params)
 	return ret0
 }
 
@@ -495,10 +694,10 @@ func (mr *MockChainletKeeperMockRecorder) IsChainletStarted(ctx, chainId interfa
 }
 
 // ListChainletStack mocks base method.
-func (m *MockChainletKeeper) ListChainletStack(ctx context.Context, req *types2.QueryListChainletStackRequest) (*types2.QueryListChainletStackResponse, error) {
+func (m *MockChainletKeeper) ListChainletStack(ctx context.Context, req *types3.QueryListChainletStackRequest) (*types3.QueryListChainletStackResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListChainletStack", ctx, req)
-	ret0, _ := ret[0].(*types2.QueryListChainletStackResponse)
+	ret0, _ := ret[0].(*types3.QueryListChainletStackResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -510,10 +709,10 @@ func (mr *MockChainletKeeperMockRecorder) ListChainletStack(ctx, req interface{}
 }
 
 // ListChainlets mocks base method.
-func (m *MockChainletKeeper) ListChainlets(ctx context.Context, req *types2.QueryListChainletsRequest) (*types2.QueryListChainletsResponse, error) {
+func (m *MockChainletKeeper) ListChainlets(ctx context.Context, req *types3.QueryListChainletsRequest) (*types3.QueryListChainletsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListChainlets", ctx, req)
-	ret0, _ := ret[0].(*types2.QueryListChainletsResponse)
+	ret0, _ := ret[0].(*types3.QueryListChainletsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -551,3 +750,40 @@ func (mr *MockChainletKeeperMockRecorder) StopChainlet(ctx, chainId interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopChainlet", reflect.TypeOf((*MockChainletKeeper)(nil).StopChainlet), ctx, chainId)
 }
+
+// MockBillingKeeper is a mock of BillingKeeper interface.
+type MockBillingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBillingKeeperMockRecorder
+}
+
+// MockBillingKeeperMockRecorder is the mock recorder for MockBillingKeeper.
+type MockBillingKeeperMockRecorder struct {
+	mock *MockBillingKeeper
+}
+
+// NewMockBillingKeeper creates a new mock instance.
+func NewMockBillingKeeper(ctrl *gomock.Controller) *MockBillingKeeper {
+	mock := &MockBillingKeeper{ctrl: ctrl}
+	mock.recorder = &MockBillingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBillingKeeper) EXPECT() *MockBillingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetPlatformValidators mocks base method.
+func (m *MockBillingKeeper) GetPlatformValidators(ctx types.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatformValidators", ctx)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetPlatformValidators indicates an expected call of GetPlatformValidators.
+func (mr *MockBillingKeeperMockRecorder) GetPlatformValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatformValidators", reflect.TypeOf((*MockBillingKeeper)(nil).GetPlatformValidators), ctx)
+}
@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ssc/billing/billing_credit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BillingCredit pays epoch fees of a chainlet, or of all chainlets of a
+// launcher, before their escrow is billed. Exactly one of chainId and
+// launcher is set.
+type BillingCredit struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId  string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Launcher string `protobuf:"bytes,3,opt,name=launcher,proto3" json:"launcher,omitempty"`
+	// Amount left
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// Amount issued
+	Issued types.Coin `protobuf:"bytes,5,opt,name=issued,proto3" json:"issued"`
+	// The credit cannot be used from this time on
+	Expiry time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	Memo   string    `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *BillingCredit) Reset()         { *m = BillingCredit{} }
+func (m *BillingCredit) String() string { return proto.CompactTextString(m) }
+func (*BillingCredit) ProtoMessage()    {}
+func (*BillingCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d30f19efb16ed46, []int{0}
+}
+func (m *BillingCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BillingCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingCredit.Merge(m, src)
+}
+func (m *BillingCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingCredit proto.InternalMessageInfo
+
+func (m *BillingCredit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BillingCredit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *BillingCredit) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+func (m *BillingCredit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *BillingCredit) GetIssued() types.Coin {
+	if m != nil {
+		return m.Issued
+	}
+	return types.Coin{}
+}
+
+func (m *BillingCredit) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *BillingCredit) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BillingCredit)(nil), "ssc.billing.BillingCredit")
+}
+
+func init() { proto.RegisterFile("ssc/billing/billing_credit.proto", fileDescriptor_3d30f19efb16ed46) }
+
+var fileDescriptor_3d30f19efb16ed46 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x31, 0x6f, 0xa3, 0x30,
+	0x18, 0xc5, 0x1c, 0x47, 0x72, 0x8e, 0xee, 0x06, 0xeb, 0x06, 0x1f, 0x03, 0x41, 0x37, 0x54, 0x99,
+	0x6c, 0xa5, 0x1d, 0xba, 0x74, 0xa8, 0xc8, 0xd4, 0x15, 0x75, 0xea, 0x52, 0x19, 0xe3, 0x12, 0x4b,
+	0x80, 0x11, 0x36, 0x55, 0xd2, 0x5f, 0x91, 0xb5, 0xff, 0x28, 0x63, 0xc6, 0x4e, 0x6d, 0x95, 0xfc,
+	0x91, 0x0a, 0x03, 0x99, 0x3b, 0xf1, 0x7d, 0xbc, 0xf7, 0xf4, 0x9e, 0xdf, 0x07, 0x23, 0xad, 0x39,
+	0x4d, 0x65, 0x51, 0xc8, 0x2a, 0x1f, 0xbf, 0x8f, 0xbc, 0x11, 0x99, 0x34, 0xa4, 0x6e, 0x94, 0x51,
+	0x68, 0xa6, 0x35, 0x27, 0x03, 0x12, 0xfc, 0xcd, 0x55, 0xae, 0xec, 0x7f, 0xda, 0x4d, 0x3d, 0x25,
+	0x98, 0xe7, 0x4a, 0xe5, 0x85, 0xa0, 0x76, 0x4b, 0xdb, 0x27, 0x6a, 0x64, 0x29, 0xb4, 0x61, 0x65,
+	0x3d, 0x10, 0x42, 0xae, 0x74, 0xa9, 0x34, 0x4d, 0x99, 0x16, 0xf4, 0x79, 0x99, 0x0a, 0xc3, 0x96,
+	0x94, 0x2b, 0x59, 0xf5, 0xf8, 0xff, 0x57, 0x17, 0xfe, 0x8e, 0x7b, 0x8b, 0x95, 0xf5, 0x46, 0x7f,
+	0xa0, 0x2b, 0x33, 0x0c, 0x22, 0xb0, 0xf0, 0x12, 0x57, 0x66, 0x08, 0xc3, 0x09, 0x5f, 0x33, 0x59,
+	0xdd, 0x65, 0xd8, 0x8d, 0xc0, 0xe2, 0x57, 0x32, 0xae, 0x28, 0x80, 0xd3, 0x82, 0xb5, 0x15, 0x5f,
+	0x8b, 0x06, 0xff, 0xb0, 0xd0, 0x79, 0x47, 0xd7, 0xd0, 0x67, 0xa5, 0x6a, 0x2b, 0x83, 0xbd, 0x08,
+	0x2c, 0x66, 0x97, 0xff, 0x48, 0x1f, 0x84, 0x74, 0x41, 0xc8, 0x10, 0x84, 0xac, 0x94, 0xac, 0x62,
+	0x6f, 0xff, 0x3e, 0x77, 0x92, 0x81, 0xde, 0x09, 0xa5, 0xd6, 0xad, 0xc8, 0xf0, 0xcf, 0x6f, 0x0a,
+	0x7b, 0x3a, 0xba, 0x81, 0xbe, 0xd8, 0xd4, 0xb2, 0xd9, 0x62, 0xdf, 0x0a, 0x03, 0xd2, 0x77, 0x43,
+	0xc6, 0x6e, 0xc8, 0xfd, 0xd8, 0x4d, 0x3c, 0xed, 0x94, 0xbb, 0x8f, 0x39, 0x48, 0x06, 0x0d, 0x42,
+	0xd0, 0x2b, 0x45, 0xa9, 0xf0, 0xc4, 0xbe, 0xc3, 0xce, 0xf1, 0xed, 0xfe, 0x18, 0x82, 0xc3, 0x31,
+	0x04, 0x9f, 0xc7, 0x10, 0xec, 0x4e, 0xa1, 0x73, 0x38, 0x85, 0xce, 0xdb, 0x29, 0x74, 0x1e, 0x2e,
+	0x72, 0x69, 0xd6, 0x6d, 0x4a, 0xb8, 0x2a, 0xa9, 0x66, 0x39, 0xdb, 0x6c, 0x5f, 0x68, 0x77, 0xce,
+	0xcd, 0xf9, 0xa0, 0x66, 0x5b, 0x0b, 0x9d, 0xfa, 0xd6, 0xfb, 0xea, 0x6b, 0x00, 0x96, 0xc4, 0xbf,
+	0x45, 0xec, 0x01, 0x00, 0x00,
+}
+
+func (m *BillingCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintBillingCredit(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBillingCredit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Issued.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBillingCredit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBillingCredit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintBillingCredit(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBillingCredit(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBillingCredit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBillingCredit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBillingCredit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BillingCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBillingCredit(uint64(m.Id))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBillingCredit(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovBillingCredit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBillingCredit(uint64(l))
+	l = m.Issued.Size()
+	n += 1 + l + sovBillingCredit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovBillingCredit(uint64(l))
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovBillingCredit(uint64(l))
+	}
+	return n
+}
+
+func sovBillingCredit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBillingCredit(x uint64) (n int) {
+	return sovBillingCredit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BillingCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBillingCredit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BillingCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BillingCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBillingCredit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBillingCredit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBillingCredit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBillingCredit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBillingCredit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBillingCredit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBillingCredit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBillingCredit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBillingCredit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBillingCredit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBillingCredit = fmt.Errorf("proto: unexpected end of group")
+)
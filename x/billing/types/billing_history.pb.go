@@ -31,6 +31,18 @@ type BillingHistory struct {
 	EpochNumber       int64  `protobuf:"varint,6,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
 	EpochStartTime    string `protobuf:"bytes,7,opt,name=epochStartTime,proto3" json:"epochStartTime,omitempty"`
 	BilledAmount      string `protobuf:"bytes,8,opt,name=billedAmount,proto3" json:"billedAmount,omitempty"`
+	// Unique ID of the record, increasing with time
+	Id   uint64 `protobuf:"varint,9,opt,name=id,proto3" json:"id,omitempty"`
+	Memo string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The billing attempt failed and nothing was charged
+	Failed bool `protobuf:"varint,11,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Part of the billed amount paid to the chainlet stack creator
+	CreatorRevenue          string `protobuf:"bytes,12,opt,name=creatorRevenue,proto3" json:"creatorRevenue,omitempty"`
+	CreatorRevenueRecipient string `protobuf:"bytes,13,opt,name=creatorRevenueRecipient,proto3" json:"creatorRevenueRecipient,omitempty"`
+	// Unused part of the epoch fee returned to the escrow of the chainlet
+	RefundedAmount string `protobuf:"bytes,14,opt,name=refundedAmount,proto3" json:"refundedAmount,omitempty"`
+	// Part of the billed amount paid with billing credits instead of the escrow
+	CreditUsed string `protobuf:"bytes,15,opt,name=creditUsed,proto3" json:"creditUsed,omitempty"`
 }
 
 func (m *BillingHistory) Reset()         { *m = BillingHistory{} }
@@ -122,6 +134,55 @@ func (m *BillingHistory) GetBilledAmount() string {
 	return ""
 }
 
+func (m *BillingHistory) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BillingHistory) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *BillingHistory) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *BillingHistory) GetCreatorRevenue() string {
+	if m != nil {
+		return m.CreatorRevenue
+	}
+	return ""
+}
+
+func (m *BillingHistory) GetCreatorRevenueRecipient() string {
+	if m != nil {
+		return m.CreatorRevenueRecipient
+	}
+	return ""
+}
+
+func (m *BillingHistory) GetRefundedAmount() string {
+	if m != nil {
+		return m.RefundedAmount
+	}
+	return ""
+}
+
+func (m *BillingHistory) GetCreditUsed() string {
+	if m != nil {
+		return m.CreditUsed
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BillingHistory)(nil), "ssc.billing.BillingHistory")
 }
@@ -129,26 +190,32 @@ func init() {
 func init() { proto.RegisterFile("ssc/billing/billing_history.proto", fileDescriptor_b2a9cabf2a680108) }
 
 var fileDescriptor_b2a9cabf2a680108 = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xeb, 0xf6, 0xde, 0x02, 0x2e, 0x14, 0xe1, 0xc9, 0x93, 0x55, 0x2a, 0x54, 0x65, 0x40,
-	0xc9, 0xc0, 0x0b, 0x40, 0x27, 0xba, 0x14, 0xa9, 0x65, 0x62, 0x41, 0x8e, 0x63, 0x12, 0x8b, 0x24,
-	0x8e, 0x6c, 0x47, 0x34, 0x3c, 0x05, 0x8f, 0xc4, 0xc8, 0xd8, 0x91, 0x11, 0x25, 0x2f, 0x82, 0xe2,
-	0x10, 0x94, 0x96, 0x29, 0xf9, 0xbf, 0xf3, 0xe9, 0x48, 0xc7, 0x3f, 0x3c, 0xd7, 0x9a, 0x79, 0xbe,
-	0x88, 0x63, 0x91, 0x86, 0xed, 0xf7, 0x31, 0x12, 0xda, 0x48, 0x55, 0xb8, 0x99, 0x92, 0x46, 0xa2,
-	0x91, 0xd6, 0xcc, 0xfd, 0x19, 0x4d, 0xdf, 0xfb, 0x70, 0x3c, 0x6f, 0xfe, 0x6f, 0x1b, 0x0b, 0x5d,
-	0xc0, 0x13, 0x16, 0x51, 0x91, 0xc6, 0xdc, 0xdc, 0xbd, 0xa4, 0x5c, 0x61, 0x30, 0x01, 0xce, 0xd1,
-	0x6a, 0x17, 0xa2, 0x29, 0x3c, 0x6e, 0xc1, 0x92, 0x26, 0x1c, 0xf7, 0xad, 0xb4, 0xc3, 0x10, 0x81,
-	0xb0, 0xcd, 0x8b, 0x00, 0x0f, 0xac, 0xd1, 0x21, 0xe8, 0x12, 0x9e, 0xb5, 0x69, 0x6d, 0x28, 0x7b,
-	0xb6, 0x8b, 0xfe, 0x59, 0xed, 0xef, 0x00, 0x39, 0xf0, 0x94, 0x67, 0x92, 0x45, 0x8b, 0x80, 0xa7,
-	0x46, 0x3c, 0x09, 0xae, 0xf0, 0x7f, 0xeb, 0xee, 0x63, 0x34, 0x81, 0x23, 0x8b, 0x96, 0x79, 0xe2,
-	0x73, 0x85, 0x87, 0x13, 0xe0, 0x0c, 0x56, 0x5d, 0x84, 0x66, 0x70, 0x6c, 0xe3, 0xda, 0x50, 0x65,
-	0xee, 0x45, 0xc2, 0xf1, 0x81, 0x5d, 0xb5, 0x47, 0xeb, 0x2b, 0xeb, 0x97, 0xe2, 0xc1, 0x4d, 0x22,
-	0xf3, 0xd4, 0xe0, 0xc3, 0xe6, 0xca, 0x2e, 0x9b, 0x5f, 0x7f, 0x94, 0x04, 0x6c, 0x4b, 0x02, 0xbe,
-	0x4a, 0x02, 0xde, 0x2a, 0xd2, 0xdb, 0x56, 0xa4, 0xf7, 0x59, 0x91, 0xde, 0xc3, 0x2c, 0x14, 0x26,
-	0xca, 0x7d, 0x97, 0xc9, 0xc4, 0xd3, 0x34, 0xa4, 0x9b, 0xe2, 0xd5, 0xab, 0xfb, 0xd9, 0xfc, 0x36,
-	0x64, 0x8a, 0x8c, 0x6b, 0x7f, 0x68, 0x8b, 0xb9, 0xfa, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xcd,
-	0xba, 0xee, 0xbd, 0x01, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x5e, 0x28, 0x77, 0xee, 0x5d, 0x4e, 0x78, 0x00, 0x4f, 0x51, 0x38, 0xa1, 0x53,
+	0x06, 0xd4, 0x0e, 0x2c, 0x8c, 0x70, 0x13, 0x5d, 0x8a, 0x94, 0xc2, 0xc2, 0x82, 0x1c, 0xfb, 0xb5,
+	0xb1, 0x48, 0xec, 0xc8, 0x76, 0xa0, 0xe5, 0x53, 0xf0, 0x29, 0xf8, 0x2c, 0x8c, 0x1d, 0x19, 0x51,
+	0xfb, 0x45, 0x50, 0x9c, 0xa6, 0x6a, 0x8a, 0x98, 0xe2, 0xff, 0xef, 0xfd, 0xe3, 0xf7, 0x9e, 0xdf,
+	0xc3, 0xcf, 0xad, 0xe5, 0xd3, 0x4c, 0x16, 0x85, 0x54, 0xab, 0xee, 0xfb, 0x39, 0x97, 0xd6, 0x69,
+	0xb3, 0x99, 0x54, 0x46, 0x3b, 0x4d, 0xc6, 0xd6, 0xf2, 0xc9, 0x21, 0x74, 0xf7, 0x33, 0xc0, 0xe1,
+	0x43, 0x7b, 0x7e, 0xd7, 0xba, 0xc8, 0x0b, 0x7c, 0xc3, 0x73, 0x26, 0x55, 0x01, 0xee, 0xfd, 0x37,
+	0x05, 0x86, 0xa2, 0x18, 0x25, 0x57, 0x69, 0x1f, 0x92, 0x3b, 0x7c, 0xdd, 0x81, 0x39, 0x2b, 0x81,
+	0x0e, 0xbd, 0xa9, 0xc7, 0x48, 0x84, 0x71, 0xa7, 0x67, 0x82, 0x5e, 0x78, 0xc7, 0x09, 0x21, 0x2f,
+	0xf1, 0x93, 0x4e, 0x2d, 0x1c, 0xe3, 0x5f, 0xfc, 0x45, 0x81, 0xb7, 0xfd, 0x1b, 0x20, 0x09, 0xbe,
+	0x85, 0x4a, 0xf3, 0x7c, 0x26, 0x40, 0x39, 0xb9, 0x94, 0x60, 0xe8, 0x23, 0xef, 0x3d, 0xc7, 0x24,
+	0xc6, 0x63, 0x8f, 0xe6, 0x75, 0x99, 0x81, 0xa1, 0xa3, 0x18, 0x25, 0x17, 0xe9, 0x29, 0x22, 0xf7,
+	0x38, 0xf4, 0x72, 0xe1, 0x98, 0x71, 0x1f, 0x64, 0x09, 0xf4, 0xb1, 0xbf, 0xea, 0x8c, 0x36, 0x5d,
+	0x36, 0x2f, 0x05, 0xe2, 0x6d, 0xa9, 0x6b, 0xe5, 0xe8, 0x65, 0xdb, 0xe5, 0x29, 0x23, 0x21, 0x1e,
+	0x4a, 0x41, 0xaf, 0x62, 0x94, 0x04, 0xe9, 0x50, 0x0a, 0x42, 0x70, 0x50, 0x42, 0xa9, 0x29, 0xf6,
+	0x5e, 0x7f, 0x26, 0x4f, 0xf1, 0x68, 0xc9, 0x64, 0x01, 0x82, 0x8e, 0x63, 0x94, 0x5c, 0xa6, 0x07,
+	0xd5, 0xd4, 0xc1, 0x0d, 0x30, 0xa7, 0x4d, 0x0a, 0x5f, 0x41, 0xd5, 0x40, 0xaf, 0xdb, 0x3a, 0xfa,
+	0x94, 0xbc, 0xc6, 0xcf, 0xfa, 0x24, 0x05, 0x2e, 0x2b, 0x09, 0xca, 0xd1, 0x1b, 0xff, 0xc3, 0xff,
+	0xc2, 0x4d, 0x06, 0x03, 0xcb, 0x5a, 0x89, 0x63, 0x0f, 0x61, 0x9b, 0xa1, 0x4f, 0xfd, 0xac, 0x0c,
+	0x08, 0xe9, 0x3e, 0x5a, 0x10, 0xf4, 0xf6, 0x30, 0xab, 0x23, 0x79, 0x78, 0xf3, 0x6b, 0x17, 0xa1,
+	0xed, 0x2e, 0x42, 0x7f, 0x76, 0x11, 0xfa, 0xb1, 0x8f, 0x06, 0xdb, 0x7d, 0x34, 0xf8, 0xbd, 0x8f,
+	0x06, 0x9f, 0xee, 0x57, 0xd2, 0xe5, 0x75, 0x36, 0xe1, 0xba, 0x9c, 0x5a, 0xb6, 0x62, 0xeb, 0xcd,
+	0xf7, 0x69, 0xb3, 0x85, 0xeb, 0xe3, 0x1e, 0xba, 0x4d, 0x05, 0x36, 0x1b, 0xf9, 0xf5, 0x7b, 0xf5,
+	0x77, 0x00, 0xf0, 0x67, 0x97, 0x74, 0xa3, 0x02, 0x00, 0x00,
 }
 
 func (m *BillingHistory) Marshal() (dAtA []byte, err error) {
@@ -171,6 +238,56 @@ func (m *BillingHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreditUsed) > 0 {
+		i -= len(m.CreditUsed)
+		copy(dAtA[i:], m.CreditUsed)
+		i = encodeVarintBillingHistory(dAtA, i, uint64(len(m.CreditUsed)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RefundedAmount) > 0 {
+		i -= len(m.RefundedAmount)
+		copy(dAtA[i:], m.RefundedAmount)
+		i = encodeVarintBillingHistory(dAtA, i, uint64(len(m.RefundedAmount)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.CreatorRevenueRecipient) > 0 {
+		i -= len(m.CreatorRevenueRecipient)
+		copy(dAtA[i:], m.CreatorRevenueRecipient)
+		i = encodeVarintBillingHistory(dAtA, i, uint64(len(m.CreatorRevenueRecipient)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.CreatorRevenue) > 0 {
+		i -= len(m.CreatorRevenue)
+		copy(dAtA[i:], m.CreatorRevenue)
+		i = encodeVarintBillingHistory(dAtA, i, uint64(len(m.CreatorRevenue)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintBillingHistory(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Id != 0 {
+		i = encodeVarintBillingHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.BilledAmount) > 0 {
 		i -= len(m.BilledAmount)
 		copy(dAtA[i:], m.BilledAmount)
@@ -276,6 +393,32 @@ func (m *BillingHistory) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBillingHistory(uint64(l))
 	}
+	if m.Id != 0 {
+		n += 1 + sovBillingHistory(uint64(m.Id))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovBillingHistory(uint64(l))
+	}
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.CreatorRevenue)
+	if l > 0 {
+		n += 1 + l + sovBillingHistory(uint64(l))
+	}
+	l = len(m.CreatorRevenueRecipient)
+	if l > 0 {
+		n += 1 + l + sovBillingHistory(uint64(l))
+	}
+	l = len(m.RefundedAmount)
+	if l > 0 {
+		n += 1 + l + sovBillingHistory(uint64(l))
+	}
+	l = len(m.CreditUsed)
+	if l > 0 {
+		n += 1 + l + sovBillingHistory(uint64(l))
+	}
 	return n
 }
 
@@ -557,6 +700,205 @@ func (m *BillingHistory) Unmarshal(dAtA []byte) error {
 			}
 			m.BilledAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorRevenue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorRevenueRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorRevenueRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditUsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBillingHistory(dAtA[iNdEx:])
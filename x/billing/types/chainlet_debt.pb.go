@@ -0,0 +1,441 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ssc/billing/chainlet_debt.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainletDebt tracks the epochs a chainlet kept running without being billed
+type ChainletDebt struct {
+	ChainId         string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epochIdentifier,proto3" json:"epochIdentifier,omitempty"`
+	// First epoch that could not be billed
+	FirstUnpaidEpoch int64  `protobuf:"varint,3,opt,name=firstUnpaidEpoch,proto3" json:"firstUnpaidEpoch,omitempty"`
+	UnpaidEpochs     uint64 `protobuf:"varint,4,opt,name=unpaidEpochs,proto3" json:"unpaidEpochs,omitempty"`
+}
+
+func (m *ChainletDebt) Reset()         { *m = ChainletDebt{} }
+func (m *ChainletDebt) String() string { return proto.CompactTextString(m) }
+func (*ChainletDebt) ProtoMessage()    {}
+func (*ChainletDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3df4dd0db8110ae, []int{0}
+}
+func (m *ChainletDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainletDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainletDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainletDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainletDebt.Merge(m, src)
+}
+func (m *ChainletDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainletDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainletDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainletDebt proto.InternalMessageInfo
+
+func (m *ChainletDebt) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainletDebt) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *ChainletDebt) GetFirstUnpaidEpoch() int64 {
+	if m != nil {
+		return m.FirstUnpaidEpoch
+	}
+	return 0
+}
+
+func (m *ChainletDebt) GetUnpaidEpochs() uint64 {
+	if m != nil {
+		return m.UnpaidEpochs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ChainletDebt)(nil), "ssc.billing.ChainletDebt")
+}
+
+func init() { proto.RegisterFile("ssc/billing/chainlet_debt.proto", fileDescriptor_b3df4dd0db8110ae) }
+
+var fileDescriptor_b3df4dd0db8110ae = []byte{
+	// 224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x2e, 0x4e, 0xd6,
+	0x4f, 0xca, 0xcc, 0xc9, 0xc9, 0xcc, 0x4b, 0xd7, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x49, 0x2d,
+	0x89, 0x4f, 0x49, 0x4d, 0x2a, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x2e, 0x4e,
+	0xd6, 0x83, 0x2a, 0x50, 0x5a, 0xc4, 0xc8, 0xc5, 0xe3, 0x0c, 0x55, 0xe4, 0x92, 0x9a, 0x54, 0x22,
+	0x24, 0xc1, 0xc5, 0x0e, 0xd6, 0xe4, 0x99, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe3,
+	0x0a, 0x69, 0x70, 0xf1, 0xa7, 0x16, 0xe4, 0x27, 0x67, 0x78, 0xa6, 0xa4, 0xe6, 0x95, 0x64, 0xa6,
+	0x65, 0xa6, 0x16, 0x49, 0x30, 0x81, 0x55, 0xa0, 0x0b, 0x0b, 0x69, 0x71, 0x09, 0xa4, 0x65, 0x16,
+	0x15, 0x97, 0x84, 0xe6, 0x15, 0x24, 0x66, 0xa6, 0xb8, 0x82, 0x64, 0x25, 0x98, 0x15, 0x18, 0x35,
+	0x98, 0x83, 0x30, 0xc4, 0x85, 0x94, 0xb8, 0x78, 0x4a, 0x11, 0xdc, 0x62, 0x09, 0x16, 0x05, 0x46,
+	0x0d, 0x96, 0x20, 0x14, 0x31, 0x27, 0x87, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c,
+	0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63,
+	0x88, 0x52, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0x4c,
+	0x4f, 0xac, 0xa8, 0xac, 0xd2, 0x07, 0xf9, 0xbf, 0x02, 0x1e, 0x02, 0x25, 0x95, 0x05, 0xa9, 0xc5,
+	0x49, 0x6c, 0x60, 0xaf, 0x1b, 0x03, 0x06, 0x00, 0xb3, 0x7a, 0x10, 0xa2, 0x1d, 0x01, 0x00, 0x00,
+}
+
+func (m *ChainletDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainletDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainletDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnpaidEpochs != 0 {
+		i = encodeVarintChainletDebt(dAtA, i, uint64(m.UnpaidEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FirstUnpaidEpoch != 0 {
+		i = encodeVarintChainletDebt(dAtA, i, uint64(m.FirstUnpaidEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintChainletDebt(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintChainletDebt(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChainletDebt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChainletDebt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainletDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovChainletDebt(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovChainletDebt(uint64(l))
+	}
+	if m.FirstUnpaidEpoch != 0 {
+		n += 1 + sovChainletDebt(uint64(m.FirstUnpaidEpoch))
+	}
+	if m.UnpaidEpochs != 0 {
+		n += 1 + sovChainletDebt(uint64(m.UnpaidEpochs))
+	}
+	return n
+}
+
+func sovChainletDebt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChainletDebt(x uint64) (n int) {
+	return sovChainletDebt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainletDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainletDebt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainletDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainletDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainletDebt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainletDebt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainletDebt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainletDebt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainletDebt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainletDebt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstUnpaidEpoch", wireType)
+			}
+			m.FirstUnpaidEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainletDebt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstUnpaidEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpaidEpochs", wireType)
+			}
+			m.UnpaidEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainletDebt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnpaidEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainletDebt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainletDebt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChainletDebt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChainletDebt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChainletDebt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChainletDebt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChainletDebt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChainletDebt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChainletDebt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChainletDebt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChainletDebt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChainletDebt = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ssc/billing/epoch_charge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochCharge is the epoch fee a chainlet was last charged, the unused part
+// of which is returned if it is suspended or decommissioned within the epoch
+type EpochCharge struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	EpochNumber int64  `protobuf:"varint,2,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	// Full epoch fee of the chainlet
+	EpochFee types.Coin `protobuf:"bytes,3,opt,name=epochFee,proto3" json:"epochFee"`
+	// Amount charged, less than the epoch fee if it was prorated
+	Charged types.Coin `protobuf:"bytes,4,opt,name=charged,proto3" json:"charged"`
+	// Part of the amount charged paid to the stack creator, which is not refunded
+	StackRevenue types.Coin `protobuf:"bytes,5,opt,name=stackRevenue,proto3" json:"stackRevenue"`
+}
+
+func (m *EpochCharge) Reset()         { *m = EpochCharge{} }
+func (m *EpochCharge) String() string { return proto.CompactTextString(m) }
+func (*EpochCharge) ProtoMessage()    {}
+func (*EpochCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8193b58f02404679, []int{0}
+}
+func (m *EpochCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCharge.Merge(m, src)
+}
+func (m *EpochCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCharge proto.InternalMessageInfo
+
+func (m *EpochCharge) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EpochCharge) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochCharge) GetEpochFee() types.Coin {
+	if m != nil {
+		return m.EpochFee
+	}
+	return types.Coin{}
+}
+
+func (m *EpochCharge) GetCharged() types.Coin {
+	if m != nil {
+		return m.Charged
+	}
+	return types.Coin{}
+}
+
+func (m *EpochCharge) GetStackRevenue() types.Coin {
+	if m != nil {
+		return m.StackRevenue
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*EpochCharge)(nil), "ssc.billing.EpochCharge")
+}
+
+func init() { proto.RegisterFile("ssc/billing/epoch_charge.proto", fileDescriptor_8193b58f02404679) }
+
+var fileDescriptor_8193b58f02404679 = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4f, 0x02, 0x31,
+	0x14, 0xc0, 0xaf, 0x80, 0xff, 0x7a, 0x4e, 0x8d, 0x43, 0x65, 0xa8, 0x17, 0x07, 0xc3, 0xd4, 0x06,
+	0x9d, 0x8c, 0x8b, 0x81, 0x68, 0xe2, 0xe2, 0x70, 0xa3, 0x8b, 0x69, 0xcb, 0x4b, 0xaf, 0x11, 0xae,
+	0xe4, 0x7a, 0x10, 0xf0, 0x03, 0x38, 0xfb, 0xb1, 0x18, 0x19, 0x9d, 0x8c, 0x81, 0x2f, 0x62, 0xae,
+	0x07, 0x44, 0x37, 0xb6, 0xbe, 0x3f, 0xbf, 0xf7, 0x6b, 0xde, 0xc3, 0xcc, 0x7b, 0x2d, 0x94, 0x1d,
+	0x0e, 0x6d, 0x6e, 0x04, 0x8c, 0x9d, 0xce, 0x5e, 0x75, 0x26, 0x0b, 0x03, 0x7c, 0x5c, 0xb8, 0xd2,
+	0x91, 0xd8, 0x7b, 0xcd, 0x37, 0xf5, 0xf6, 0x99, 0x71, 0xc6, 0x85, 0xbc, 0xa8, 0x5e, 0x75, 0x4b,
+	0x9b, 0x69, 0xe7, 0x47, 0xce, 0x0b, 0x25, 0x3d, 0x88, 0x69, 0x57, 0x41, 0x29, 0xbb, 0x42, 0x3b,
+	0x9b, 0xd7, 0xf5, 0xcb, 0x8f, 0x06, 0x8e, 0x1f, 0xaa, 0xc9, 0xfd, 0x30, 0x98, 0x50, 0x7c, 0xa4,
+	0x33, 0x69, 0xf3, 0xa7, 0x01, 0x45, 0x09, 0xea, 0x9c, 0xa4, 0xdb, 0x90, 0x24, 0x38, 0x0e, 0x5f,
+	0x78, 0x9e, 0x8c, 0x14, 0x14, 0xb4, 0x91, 0xa0, 0x4e, 0x33, 0xfd, 0x9b, 0x22, 0x77, 0xf8, 0x38,
+	0x84, 0x8f, 0x00, 0xb4, 0x99, 0xa0, 0x4e, 0x7c, 0x7d, 0xce, 0x6b, 0x3d, 0xaf, 0xf4, 0x7c, 0xa3,
+	0xe7, 0x7d, 0x67, 0xf3, 0x5e, 0x6b, 0xf1, 0x7d, 0x11, 0xa5, 0x3b, 0x80, 0xdc, 0x06, 0x71, 0x61,
+	0x60, 0x40, 0x5b, 0xfb, 0xb1, 0xdb, 0x7e, 0xd2, 0xc7, 0xa7, 0xbe, 0x94, 0xfa, 0x2d, 0x85, 0x29,
+	0xe4, 0x13, 0xa0, 0x07, 0xfb, 0xf1, 0xff, 0xa0, 0xde, 0xfd, 0x62, 0xc5, 0xd0, 0x72, 0xc5, 0xd0,
+	0xcf, 0x8a, 0xa1, 0xcf, 0x35, 0x8b, 0x96, 0x6b, 0x16, 0x7d, 0xad, 0x59, 0xf4, 0x72, 0x65, 0x6c,
+	0x99, 0x4d, 0x14, 0xd7, 0x6e, 0x24, 0xbc, 0x34, 0x72, 0x36, 0x7f, 0x17, 0xd5, 0x61, 0x66, 0xbb,
+	0xd3, 0x94, 0xf3, 0x31, 0x78, 0x75, 0x18, 0x36, 0x7a, 0xf3, 0x3b, 0x00, 0xf5, 0xf3, 0x99, 0xcb,
+	0xb6, 0x01, 0x00, 0x00,
+}
+
+func (m *EpochCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StackRevenue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEpochCharge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Charged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEpochCharge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.EpochFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEpochCharge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintEpochCharge(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEpochCharge(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEpochCharge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEpochCharge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEpochCharge(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovEpochCharge(uint64(m.EpochNumber))
+	}
+	l = m.EpochFee.Size()
+	n += 1 + l + sovEpochCharge(uint64(l))
+	l = m.Charged.Size()
+	n += 1 + l + sovEpochCharge(uint64(l))
+	l = m.StackRevenue.Size()
+	n += 1 + l + sovEpochCharge(uint64(l))
+	return n
+}
+
+func sovEpochCharge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEpochCharge(x uint64) (n int) {
+	return sovEpochCharge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpochCharge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Charged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StackRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpochCharge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpochCharge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEpochCharge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEpochCharge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpochCharge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEpochCharge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEpochCharge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEpochCharge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEpochCharge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEpochCharge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEpochCharge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,4 +11,6 @@ var (
 	ErrDuplicateRecord        = cosmossdkerrors.Register(ModuleName, 7703, "duplicate record")
 	ErrInternalBillingFailure = cosmossdkerrors.Register(ModuleName, 7704, "internal failure")
 	ErrUnauthorized           = cosmossdkerrors.Register(ModuleName, 7705, "unauthorized")
+	ErrInvalidRevenueShare    = cosmossdkerrors.Register(ModuleName, 7706, "invalid revenue share")
+	ErrInvalidCredit          = cosmossdkerrors.Register(ModuleName, 7707, "invalid billing credit")
 )
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
 	epochstypes "github.com/sagaxyz/ssc/x/epochs/types"
 	escrowtypes "github.com/sagaxyz/ssc/x/escrow/types"
@@ -40,11 +41,28 @@ type BankKeeper interface {
 
 type EscrowKeeper interface {
 	BillAccount(ctx sdk.Context, amount sdk.Coin, chainId string, toModule string) error
+	RefundBilling(ctx sdk.Context, amount sdk.Coin, chainId, fromModule string) error
 	GetChainletWithPools(ctx sdk.Context, chainId string) (acc escrowtypes.ChainletAccount, pool []*escrowtypes.DenomPool, err error)
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
 }
 
 type StakingKeeper interface {
 	GetValidators(ctx context.Context, maxRetrieve uint32) (validators []stakingtypes.Validator, err error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, err error)
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, err error)
+}
+
+type PeersKeeper interface {
+	HasPeers(ctx sdk.Context, chainID string, addr string) bool
+}
+
+type ProviderKeeper interface {
+	IsOptedIn(ctx sdk.Context, consumerId string, providerAddr ccvprovidertypes.ProviderConsAddress) bool
+	GetAllOptedIn(ctx sdk.Context, consumerId string) []ccvprovidertypes.ProviderConsAddress
+}
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 type EpochsKeeper interface {
@@ -56,6 +74,7 @@ type ChainletKeeper interface {
 	ListChainletStack(ctx context.Context, req *chainlettypes.QueryListChainletStackRequest) (*chainlettypes.QueryListChainletStackResponse, error)
 	StopChainlet(ctx sdk.Context, chainId string) error
 	GetChainlet(ctx context.Context, req *chainlettypes.QueryGetChainletRequest) (*chainlettypes.QueryGetChainletResponse, error)
+	GetChainletStack(ctx context.Context, req *chainlettypes.QueryGetChainletStackRequest) (*chainlettypes.QueryGetChainletStackResponse, error)
 	ChainletExists(ctx sdk.Context, chainId string) bool
 	StartExistingChainlet(ctx sdk.Context, chainId string) error
 	IsChainletStarted(ctx sdk.Context, chainId string) (bool, error)
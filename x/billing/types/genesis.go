@@ -1,6 +1,11 @@
 package types
 
-import "strconv"
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // this line is used by starport scaffolding # genesis/types/import
 
@@ -11,9 +16,18 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:                 DefaultParams(),
-		BillingHistory:         []SaveBillingHistory{},
-		ValidatorPayoutHistory: []ValidatorPayoutHistory{},
+		Params:                  DefaultParams(),
+		BillingHistory:          []BillingHistory{},
+		ValidatorPayoutHistory:  []ValidatorPayoutHistory{},
+		ChainletDebts:           []ChainletDebt{},
+		LowBalanceAlerts:        []LowBalanceAlert{},
+		StackRevenueShares:      []StackRevenueShare{},
+		StackRevenues:           []StackRevenue{},
+		PendingRewards:          []PendingRewards{},
+		RewardWithdrawAddresses: []RewardWithdrawAddress{},
+		ConsumerRevenues:        []ChainletRevenue{},
+		EpochCharges:            []EpochCharge{},
+		BillingCredits:          []BillingCredit{},
 	}
 }
 
@@ -22,10 +36,10 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	// Validate billing history records have unique {chainletId, epochIdentifier, epochNumber} tuples
+	// Validate billing history records have unique {chainletId, epochIdentifier, epochNumber, id} tuples
 	billingKeys := make(map[string]bool)
 	for _, bh := range gs.BillingHistory {
-		key := bh.ChainletId + "/" + bh.EpochIdentifier + "/" + strconv.FormatInt(bh.EpochNumber, 10)
+		key := string(BillingHistoryRecordKey(bh))
 		if billingKeys[key] {
 			return ErrDuplicateRecord
 		}
@@ -35,12 +49,127 @@ func (gs GenesisState) Validate() error {
 	// Validate validator payout history records have unique {validatorAddress, epochIdentifier, epochNumber} tuples
 	payoutKeys := make(map[string]bool)
 	for _, vph := range gs.ValidatorPayoutHistory {
-		key := vph.ValidatorAddress + "/" + vph.EpochIdentifier + "/" + strconv.FormatInt(vph.EpochNumber, 10)
+		key := string(ValidatorPayoutHistoryRecordKey(vph))
 		if payoutKeys[key] {
 			return ErrDuplicateRecord
 		}
 		payoutKeys[key] = true
 	}
 
+	// Validate chainlet debts are unique per chainlet and not empty
+	debts := make(map[string]bool)
+	for _, debt := range gs.ChainletDebts {
+		if debts[debt.ChainId] {
+			return ErrDuplicateRecord
+		}
+		if debt.UnpaidEpochs == 0 {
+			return fmt.Errorf("empty debt of chainlet %s", debt.ChainId)
+		}
+		debts[debt.ChainId] = true
+	}
+
+	// Validate low balance alerts are unique per chainlet
+	alerts := make(map[string]bool)
+	for _, alert := range gs.LowBalanceAlerts {
+		if alerts[alert.ChainId] {
+			return ErrDuplicateRecord
+		}
+		alerts[alert.ChainId] = true
+	}
+
+	// Validate stack revenue shares are unique per stack and within bounds
+	shares := make(map[string]bool)
+	for _, share := range gs.StackRevenueShares {
+		if shares[share.StackName] {
+			return ErrDuplicateRecord
+		}
+		if share.Share.IsNil() || share.Share.IsNegative() || share.Share.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid revenue share of stack %s", share.StackName)
+		}
+		shares[share.StackName] = true
+	}
+
+	// Validate stack revenues are unique per stack and valid
+	revenues := make(map[string]bool)
+	for _, revenue := range gs.StackRevenues {
+		if revenues[revenue.StackName] {
+			return ErrDuplicateRecord
+		}
+		if !revenue.Total.IsValid() {
+			return fmt.Errorf("invalid revenue of stack %s", revenue.StackName)
+		}
+		revenues[revenue.StackName] = true
+	}
+
+	// Validate pending rewards are unique per validator and valid
+	pending := make(map[string]bool)
+	for _, rewards := range gs.PendingRewards {
+		if pending[rewards.ValidatorAddress] {
+			return ErrDuplicateRecord
+		}
+		if _, err := sdk.AccAddressFromBech32(rewards.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", rewards.ValidatorAddress, err)
+		}
+		if !rewards.Amount.IsValid() {
+			return fmt.Errorf("invalid pending rewards of validator %s", rewards.ValidatorAddress)
+		}
+		pending[rewards.ValidatorAddress] = true
+	}
+
+	// Validate reward withdraw addresses are unique per validator and valid
+	withdrawAddrs := make(map[string]bool)
+	for _, addr := range gs.RewardWithdrawAddresses {
+		if withdrawAddrs[addr.ValidatorAddress] {
+			return ErrDuplicateRecord
+		}
+		if _, err := sdk.AccAddressFromBech32(addr.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", addr.ValidatorAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(addr.WithdrawAddress); err != nil {
+			return fmt.Errorf("invalid withdraw address %s: %w", addr.WithdrawAddress, err)
+		}
+		withdrawAddrs[addr.ValidatorAddress] = true
+	}
+
+	// Validate consumer chainlet revenues are unique per chainlet and valid
+	consumerRevenues := make(map[string]bool)
+	for _, revenue := range gs.ConsumerRevenues {
+		if consumerRevenues[revenue.ChainId] {
+			return ErrDuplicateRecord
+		}
+		if !revenue.Amount.IsValid() {
+			return fmt.Errorf("invalid revenue of chainlet %s", revenue.ChainId)
+		}
+		consumerRevenues[revenue.ChainId] = true
+	}
+
+	// Validate epoch charges are unique per chainlet and valid
+	charges := make(map[string]bool)
+	for _, charge := range gs.EpochCharges {
+		if charges[charge.ChainId] {
+			return ErrDuplicateRecord
+		}
+		if !charge.EpochFee.IsValid() || !charge.Charged.IsValid() || charge.Charged.Denom != charge.EpochFee.Denom ||
+			!charge.StackRevenue.IsValid() || charge.StackRevenue.Denom != charge.Charged.Denom || charge.Charged.IsLT(charge.StackRevenue) {
+			return fmt.Errorf("invalid epoch charge of chainlet %s", charge.ChainId)
+		}
+		charges[charge.ChainId] = true
+	}
+
+	// Validate billing credits have unique IDs and are issued to either a chainlet or a launcher
+	credits := make(map[uint64]bool)
+	for _, credit := range gs.BillingCredits {
+		if credits[credit.Id] {
+			return ErrDuplicateRecord
+		}
+		if (credit.ChainId == "") == (credit.Launcher == "") {
+			return fmt.Errorf("billing credit %d must be issued to either a chainlet or a launcher", credit.Id)
+		}
+		if !credit.Amount.IsValid() || !credit.Issued.IsValid() || credit.Amount.Denom != credit.Issued.Denom {
+			return fmt.Errorf("invalid amount of billing credit %d", credit.Id)
+		}
+		credits[credit.Id] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -27,9 +27,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// Billing history records
-	BillingHistory []SaveBillingHistory `protobuf:"bytes,2,rep,name=billing_history,json=billingHistory,proto3" json:"billing_history"`
+	BillingHistory []BillingHistory `protobuf:"bytes,2,rep,name=billing_history,json=billingHistory,proto3" json:"billing_history"`
 	// Validator payout history records
 	ValidatorPayoutHistory []ValidatorPayoutHistory `protobuf:"bytes,3,rep,name=validator_payout_history,json=validatorPayoutHistory,proto3" json:"validator_payout_history"`
+	// Outstanding debts of chainlets in their grace period
+	ChainletDebts []ChainletDebt `protobuf:"bytes,4,rep,name=chainlet_debts,json=chainletDebts,proto3" json:"chainlet_debts"`
+	// Low escrow balance alert states of chainlets
+	LowBalanceAlerts []LowBalanceAlert `protobuf:"bytes,5,rep,name=low_balance_alerts,json=lowBalanceAlerts,proto3" json:"low_balance_alerts"`
+	// Revenue shares set by chainlet stack creators
+	StackRevenueShares []StackRevenueShare `protobuf:"bytes,6,rep,name=stack_revenue_shares,json=stackRevenueShares,proto3" json:"stack_revenue_shares"`
+	// Revenue paid out per chainlet stack
+	StackRevenues []StackRevenue `protobuf:"bytes,7,rep,name=stack_revenues,json=stackRevenues,proto3" json:"stack_revenues"`
+	// Rewards validators have not claimed yet
+	PendingRewards []PendingRewards `protobuf:"bytes,8,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+	// Withdraw addresses set by validators
+	RewardWithdrawAddresses []RewardWithdrawAddress `protobuf:"bytes,9,rep,name=reward_withdraw_addresses,json=rewardWithdrawAddresses,proto3" json:"reward_withdraw_addresses"`
+	// Revenue of CCV consumer chainlets not paid out to their validators yet
+	ConsumerRevenues []ChainletRevenue `protobuf:"bytes,10,rep,name=consumer_revenues,json=consumerRevenues,proto3" json:"consumer_revenues"`
+	// Epoch fees last charged to chainlets
+	EpochCharges []EpochCharge `protobuf:"bytes,11,rep,name=epoch_charges,json=epochCharges,proto3" json:"epoch_charges"`
+	// Billing credits not used up yet
+	BillingCredits []BillingCredit `protobuf:"bytes,12,rep,name=billing_credits,json=billingCredits,proto3" json:"billing_credits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,7 +90,7 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetBillingHistory() []SaveBillingHistory {
+func (m *GenesisState) GetBillingHistory() []BillingHistory {
 	if m != nil {
 		return m.BillingHistory
 	}
@@ -86,6 +104,69 @@ func (m *GenesisState) GetValidatorPayoutHistory() []ValidatorPayoutHistory {
 	return nil
 }
 
+func (m *GenesisState) GetChainletDebts() []ChainletDebt {
+	if m != nil {
+		return m.ChainletDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLowBalanceAlerts() []LowBalanceAlert {
+	if m != nil {
+		return m.LowBalanceAlerts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStackRevenueShares() []StackRevenueShare {
+	if m != nil {
+		return m.StackRevenueShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStackRevenues() []StackRevenue {
+	if m != nil {
+		return m.StackRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRewards() []PendingRewards {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardWithdrawAddresses() []RewardWithdrawAddress {
+	if m != nil {
+		return m.RewardWithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerRevenues() []ChainletRevenue {
+	if m != nil {
+		return m.ConsumerRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochCharges() []EpochCharge {
+	if m != nil {
+		return m.EpochCharges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBillingCredits() []BillingCredit {
+	if m != nil {
+		return m.BillingCredits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ssc.billing.GenesisState")
 }
@@ -93,26 +174,44 @@ func init() {
 func init() { proto.RegisterFile("ssc/billing/genesis.proto", fileDescriptor_02989b592da35a5b) }
 
 var fileDescriptor_02989b592da35a5b = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x2e, 0x4e, 0xd6,
-	0x4f, 0xca, 0xcc, 0xc9, 0xc9, 0xcc, 0x4b, 0xd7, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x2e, 0x4e, 0xd6, 0x83, 0x4a, 0x49, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0x09, 0x64, 0xdd, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0xcd, 0x52, 0x6a, 0xc8, 0x32, 0xc5, 0x89, 0x65, 0xa9, 0xf1, 0x50, 0x4e,
-	0x7c, 0x46, 0x66, 0x71, 0x49, 0x7e, 0x51, 0x25, 0x54, 0x9d, 0x16, 0xb2, 0xba, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0x82, 0xc4, 0xca, 0xfc, 0xd2, 0x12, 0x54, 0xb5, 0x4a,
-	0xdf, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0x4e, 0x0c, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe4, 0x62,
-	0x83, 0x58, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xac, 0x87, 0xe4, 0x64, 0xbd, 0x00,
-	0xb0, 0x94, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x85, 0x42, 0x7e, 0x5c, 0xfc, 0x68,
-	0x0e, 0x91, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0x47, 0xd1, 0x1b, 0x9c, 0x58, 0x96, 0xea,
-	0x04, 0x61, 0x7b, 0x40, 0x94, 0x41, 0xcd, 0xe1, 0x4b, 0x42, 0x11, 0x15, 0x4a, 0xe6, 0x92, 0xc0,
-	0xe5, 0x6a, 0x09, 0x66, 0xb0, 0xc1, 0xca, 0x28, 0x06, 0x87, 0xc1, 0x14, 0x07, 0x80, 0xd5, 0xa2,
-	0x1a, 0x2e, 0x56, 0x86, 0x5d, 0xd6, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0xd4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b, 0x13, 0xd3,
-	0x13, 0x2b, 0x2a, 0xab, 0xf4, 0x41, 0x21, 0x5a, 0x01, 0x0f, 0xd3, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0x70, 0x08, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xf0, 0x2f, 0x7a, 0xef,
-	0x01, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdd, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc0, 0xdb, 0xff, 0xf6, 0xdf, 0xc0, 0xdd, 0x17, 0x66, 0x02, 0xaf, 0x40, 0x36, 0x36, 0x09,
+	0x4d, 0x5c, 0xb4, 0x62, 0xbc, 0x00, 0x6b, 0xf9, 0x16, 0x12, 0xd5, 0x26, 0x0d, 0x89, 0x9b, 0xc8,
+	0x71, 0xac, 0x24, 0x22, 0x8b, 0x23, 0x1f, 0x77, 0x5d, 0x79, 0x0a, 0xde, 0x88, 0xdb, 0x5d, 0xee,
+	0x92, 0x2b, 0x84, 0xda, 0x17, 0x41, 0xb1, 0x9d, 0xcd, 0xae, 0xc2, 0x55, 0xa2, 0x73, 0x7e, 0xe7,
+	0xa7, 0x9c, 0x73, 0x62, 0xa3, 0x1d, 0x00, 0xd6, 0x8f, 0xb2, 0x3c, 0xcf, 0x8a, 0xa4, 0x9f, 0xf0,
+	0x82, 0x43, 0x06, 0xbd, 0x52, 0x0a, 0x25, 0x70, 0x07, 0x80, 0xf5, 0x6c, 0xaa, 0xbb, 0x9d, 0x88,
+	0x44, 0xe8, 0x78, 0xbf, 0x7a, 0x33, 0x48, 0x97, 0xb8, 0xd5, 0x25, 0x95, 0xf4, 0xdc, 0x16, 0x77,
+	0x9f, 0xba, 0x19, 0xfb, 0x0c, 0xd3, 0x0c, 0x94, 0x90, 0x53, 0x8b, 0x3c, 0x77, 0x91, 0x0b, 0x9a,
+	0x67, 0x31, 0x55, 0x42, 0x86, 0x25, 0x9d, 0x8a, 0xb1, 0x5a, 0x60, 0x77, 0x5d, 0x96, 0xa5, 0x34,
+	0x2b, 0x72, 0xae, 0xc2, 0x98, 0x47, 0xca, 0x02, 0x4f, 0x5c, 0x20, 0x17, 0x93, 0x30, 0xa2, 0x39,
+	0x2d, 0x18, 0x6f, 0xaa, 0x07, 0x45, 0xd9, 0xb7, 0x50, 0xf2, 0x0b, 0x5e, 0x8c, 0x6b, 0xe0, 0xa0,
+	0xf9, 0x63, 0x24, 0x9f, 0x50, 0x19, 0xd7, 0x4d, 0x05, 0x2e, 0xc4, 0x4b, 0xc1, 0xd2, 0x90, 0xa5,
+	0x54, 0x26, 0xb5, 0x64, 0xaf, 0xa9, 0x69, 0x26, 0x79, 0x9c, 0xd9, 0xcf, 0xdc, 0xff, 0xb9, 0x8a,
+	0xd6, 0xde, 0x99, 0x29, 0x9f, 0x2a, 0xaa, 0x38, 0x7e, 0x81, 0x56, 0xcc, 0xdc, 0x48, 0x7b, 0xaf,
+	0x7d, 0xd8, 0x39, 0xba, 0xdf, 0x73, 0xa6, 0xde, 0x1b, 0xe9, 0xd4, 0x60, 0xf9, 0xea, 0xf7, 0x6e,
+	0xeb, 0xc4, 0x82, 0xf8, 0x23, 0xda, 0x5c, 0x18, 0x28, 0xf9, 0x6f, 0x6f, 0xe9, 0xb0, 0x73, 0xf4,
+	0xc8, 0xab, 0x1d, 0x98, 0xe7, 0x7b, 0x83, 0x58, 0xc7, 0x46, 0xe4, 0x45, 0x31, 0x43, 0xe4, 0x5f,
+	0x93, 0x27, 0x4b, 0x5a, 0x7a, 0xe0, 0x49, 0xcf, 0x6a, 0x78, 0xa4, 0x59, 0x5f, 0xfe, 0xe0, 0xa2,
+	0x31, 0x8b, 0xdf, 0xa2, 0x0d, 0x6f, 0x65, 0x40, 0x96, 0xb5, 0x7a, 0xc7, 0x53, 0x0f, 0x2d, 0xf2,
+	0x9a, 0x47, 0xca, 0x0a, 0xd7, 0x99, 0x13, 0x03, 0x3c, 0x42, 0xd8, 0xd9, 0x6c, 0x48, 0x73, 0x2e,
+	0x15, 0x90, 0xff, 0xb5, 0xeb, 0xb1, 0xe7, 0xfa, 0x24, 0x26, 0x03, 0x43, 0x1d, 0x57, 0x90, 0xd5,
+	0x6d, 0xe5, 0x7e, 0x18, 0xf0, 0x19, 0xda, 0xf6, 0x7e, 0x86, 0x10, 0x52, 0x2a, 0x39, 0x90, 0x15,
+	0xed, 0x0c, 0x3c, 0xe7, 0x69, 0x05, 0x9e, 0x18, 0xee, 0xb4, 0xc2, 0xac, 0x15, 0xc3, 0x62, 0x02,
+	0xaa, 0x8e, 0x3d, 0x2f, 0x90, 0xd5, 0x86, 0x8e, 0x5d, 0x63, 0xdd, 0xb1, 0x2b, 0xd3, 0xab, 0x2e,
+	0x79, 0x11, 0x57, 0xab, 0xb6, 0x7f, 0x22, 0xb9, 0xd3, 0xb0, 0xea, 0x91, 0x61, 0x4e, 0x0c, 0x52,
+	0xaf, 0xba, 0xf4, 0xa2, 0x38, 0x46, 0x3b, 0xc6, 0x11, 0x4e, 0x32, 0x95, 0xc6, 0x92, 0x4e, 0x42,
+	0x1a, 0xc7, 0x92, 0x03, 0x70, 0x20, 0x77, 0xb5, 0x75, 0xdf, 0xb3, 0x9a, 0xc2, 0x2f, 0x16, 0x3e,
+	0x36, 0xac, 0x95, 0x3f, 0x94, 0x4d, 0x49, 0x0e, 0xf8, 0x33, 0xba, 0xc7, 0x44, 0x01, 0xe3, 0x73,
+	0x2e, 0x6f, 0x9b, 0x47, 0x0d, 0x2b, 0xaa, 0xd7, 0xed, 0xf7, 0xbf, 0x55, 0x17, 0xdf, 0x8c, 0x60,
+	0x88, 0xd6, 0xdd, 0x93, 0x06, 0xa4, 0xa3, 0x65, 0xc4, 0x93, 0xbd, 0xa9, 0x88, 0xa1, 0x06, 0xac,
+	0x68, 0x8d, 0xdf, 0x86, 0x00, 0x7f, 0x40, 0x9b, 0xfe, 0x71, 0x04, 0xb2, 0xa6, 0x35, 0xdd, 0xa6,
+	0x23, 0x33, 0xd4, 0xc8, 0xc2, 0x89, 0x31, 0x41, 0x18, 0xbc, 0xba, 0x9a, 0x05, 0xed, 0xeb, 0x59,
+	0xd0, 0xfe, 0x33, 0x0b, 0xda, 0x3f, 0xe6, 0x41, 0xeb, 0x7a, 0x1e, 0xb4, 0x7e, 0xcd, 0x83, 0xd6,
+	0xd7, 0x67, 0x49, 0xa6, 0xd2, 0x71, 0xd4, 0x63, 0xe2, 0xbc, 0x0f, 0x34, 0xa1, 0x97, 0xd3, 0xef,
+	0xfd, 0xea, 0x42, 0xb8, 0xbc, 0xb9, 0x12, 0xd4, 0xb4, 0xe4, 0x10, 0xad, 0xe8, 0xab, 0xe0, 0xe5,
+	0xdf, 0x01, 0x00, 0x4f, 0xea, 0xb4, 0x41, 0x7b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +234,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BillingCredits) > 0 {
+		for iNdEx := len(m.BillingCredits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BillingCredits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.EpochCharges) > 0 {
+		for iNdEx := len(m.EpochCharges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCharges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConsumerRevenues) > 0 {
+		for iNdEx := len(m.ConsumerRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RewardWithdrawAddresses) > 0 {
+		for iNdEx := len(m.RewardWithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWithdrawAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.StackRevenues) > 0 {
+		for iNdEx := len(m.StackRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StackRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.StackRevenueShares) > 0 {
+		for iNdEx := len(m.StackRevenueShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StackRevenueShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LowBalanceAlerts) > 0 {
+		for iNdEx := len(m.LowBalanceAlerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowBalanceAlerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ChainletDebts) > 0 {
+		for iNdEx := len(m.ChainletDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainletDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ValidatorPayoutHistory) > 0 {
 		for iNdEx := len(m.ValidatorPayoutHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +432,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ChainletDebts) > 0 {
+		for _, e := range m.ChainletDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LowBalanceAlerts) > 0 {
+		for _, e := range m.LowBalanceAlerts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StackRevenueShares) > 0 {
+		for _, e := range m.StackRevenueShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StackRevenues) > 0 {
+		for _, e := range m.StackRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardWithdrawAddresses) > 0 {
+		for _, e := range m.RewardWithdrawAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerRevenues) > 0 {
+		for _, e := range m.ConsumerRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochCharges) > 0 {
+		for _, e := range m.EpochCharges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BillingCredits) > 0 {
+		for _, e := range m.BillingCredits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -307,7 +586,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BillingHistory = append(m.BillingHistory, SaveBillingHistory{})
+			m.BillingHistory = append(m.BillingHistory, BillingHistory{})
 			if err := m.BillingHistory[len(m.BillingHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -346,6 +625,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainletDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainletDebts = append(m.ChainletDebts, ChainletDebt{})
+			if err := m.ChainletDebts[len(m.ChainletDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceAlerts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowBalanceAlerts = append(m.LowBalanceAlerts, LowBalanceAlert{})
+			if err := m.LowBalanceAlerts[len(m.LowBalanceAlerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackRevenueShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackRevenueShares = append(m.StackRevenueShares, StackRevenueShare{})
+			if err := m.StackRevenueShares[len(m.StackRevenueShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackRevenues = append(m.StackRevenues, StackRevenue{})
+			if err := m.StackRevenues[len(m.StackRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, PendingRewards{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWithdrawAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWithdrawAddresses = append(m.RewardWithdrawAddresses, RewardWithdrawAddress{})
+			if err := m.RewardWithdrawAddresses[len(m.RewardWithdrawAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRevenues = append(m.ConsumerRevenues, ChainletRevenue{})
+			if err := m.ConsumerRevenues[len(m.ConsumerRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochCharges = append(m.EpochCharges, EpochCharge{})
+			if err := m.EpochCharges[len(m.EpochCharges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BillingCredits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BillingCredits = append(m.BillingCredits, BillingCredit{})
+			if err := m.BillingCredits[len(m.BillingCredits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/sagaxyz/ssc/x/billing/types"
 	"github.com/stretchr/testify/require"
 )
@@ -22,7 +23,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params:                 types.DefaultParams(),
-				BillingHistory:         []types.SaveBillingHistory{},
+				BillingHistory:         []types.BillingHistory{},
 				ValidatorPayoutHistory: []types.ValidatorPayoutHistory{},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -32,7 +33,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state with data",
 			genState: &types.GenesisState{
 				Params: types.DefaultParams(),
-				BillingHistory: []types.SaveBillingHistory{
+				BillingHistory: []types.BillingHistory{
 					{ChainletId: "chain-1", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "100usaga"},
 					{ChainletId: "chain-1", EpochIdentifier: "day", EpochNumber: 2, BilledAmount: "100usaga"},
 				},
@@ -46,7 +47,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "invalid - duplicate billing history",
 			genState: &types.GenesisState{
 				Params: types.DefaultParams(),
-				BillingHistory: []types.SaveBillingHistory{
+				BillingHistory: []types.BillingHistory{
 					{ChainletId: "chain-1", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "100usaga"},
 					{ChainletId: "chain-1", EpochIdentifier: "day", EpochNumber: 1, BilledAmount: "200usaga"},
 				},
@@ -58,7 +59,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "invalid - duplicate validator payout history",
 			genState: &types.GenesisState{
 				Params:         types.DefaultParams(),
-				BillingHistory: []types.SaveBillingHistory{},
+				BillingHistory: []types.BillingHistory{},
 				ValidatorPayoutHistory: []types.ValidatorPayoutHistory{
 					{ValidatorAddress: "val1", EpochIdentifier: "day", EpochNumber: 1, RewardAmount: "50usaga"},
 					{ValidatorAddress: "val1", EpochIdentifier: "day", EpochNumber: 1, RewardAmount: "100usaga"},
@@ -66,6 +67,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid - duplicate stack revenue share",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StackRevenueShares: []types.StackRevenueShare{
+					{StackName: "stack", Share: math.LegacyNewDecWithPrec(5, 2)},
+					{StackName: "stack", Share: math.LegacyNewDecWithPrec(1, 2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid - stack revenue share above one",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StackRevenueShares: []types.StackRevenueShare{
+					{StackName: "stack", Share: math.LegacyNewDec(2)},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "billing"
@@ -19,10 +23,78 @@ const (
 )
 
 var (
-	BillingHistoryKey         = "billinghistory"
-	ValidatorPayoutHistoryKey = "validatorpayout"
+	BillingHistoryKey         = []byte{0x01}
+	ValidatorPayoutHistoryKey = []byte{0x02}
+	BillingHistorySeqKey      = []byte{0x03}
+	ChainletDebtKey           = []byte{0x04}
+	LowBalanceAlertKey        = []byte{0x05}
+	StackRevenueShareKey      = []byte{0x06}
+	StackRevenueKey           = []byte{0x07}
+	PendingRewardsKey         = []byte{0x08}
+	PendingRewardsTotalKey    = []byte{0x09}
+	RewardWithdrawAddressKey  = []byte{0x0a}
+	ConsumerRevenueKey        = []byte{0x0b}
+	EpochChargeKey            = []byte{0x0c}
+	BillingCreditKey          = []byte{0x0d}
+	BillingCreditSeqKey       = []byte{0x0e}
+	ChainletCreditKey         = []byte{0x0f}
+	LauncherCreditKey         = []byte{0x10}
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// lengthPrefix prefixes a string with its length so that it cannot match the prefix of a
+// longer one.
+func lengthPrefix(s string) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(s))), s...)
+}
+
+// BillingHistoryPrefix returns the prefix of the billing history of a chainlet, optionally
+// narrowed down to a single epoch identifier.
+func BillingHistoryPrefix(chainId string, epochIdentifier string) []byte {
+	key := lengthPrefix(chainId)
+	if epochIdentifier != "" {
+		key = append(key, lengthPrefix(epochIdentifier)...)
+	}
+	return key
+}
+
+// BillingHistoryRecordKey orders records of a chainlet by epoch identifier, epoch number and ID.
+func BillingHistoryRecordKey(record BillingHistory) []byte {
+	key := append(lengthPrefix(record.ChainletId), lengthPrefix(record.EpochIdentifier)...)
+	key = binary.BigEndian.AppendUint64(key, uint64(record.EpochNumber))
+	return binary.BigEndian.AppendUint64(key, record.Id)
+}
+
+// ValidatorPayoutHistoryPrefix returns the prefix of the payout history of a validator,
+// optionally narrowed down to a single epoch identifier.
+func ValidatorPayoutHistoryPrefix(validatorAddress string, epochIdentifier string) []byte {
+	key := lengthPrefix(validatorAddress)
+	if epochIdentifier != "" {
+		key = append(key, lengthPrefix(epochIdentifier)...)
+	}
+	return key
+}
+
+// ValidatorPayoutHistoryRecordKey orders records of a validator by epoch identifier and number.
+func ValidatorPayoutHistoryRecordKey(record ValidatorPayoutHistory) []byte {
+	key := append(lengthPrefix(record.ValidatorAddress), lengthPrefix(record.EpochIdentifier)...)
+	return binary.BigEndian.AppendUint64(key, uint64(record.EpochNumber))
+}
+
+// BillingCreditIDKey returns the key of a billing credit, ordered by ID.
+func BillingCreditIDKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
+
+// CreditIndexPrefix returns the prefix of the credit index of a chainlet or launcher.
+func CreditIndexPrefix(owner string) []byte {
+	return lengthPrefix(owner)
+}
+
+// CreditIndexKey indexes a credit by the chainlet or launcher it was issued to.
+func CreditIndexKey(owner string, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(lengthPrefix(owner), id)
+}
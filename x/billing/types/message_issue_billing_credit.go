@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgIssueBillingCredit = "issue_billing_credit"
+
+var _ sdk.Msg = &MsgIssueBillingCredit{}
+
+func NewMsgIssueBillingCredit(authority, chainId, launcher string, amount sdk.Coin, expiry time.Time, memo string) *MsgIssueBillingCredit {
+	return &MsgIssueBillingCredit{
+		Authority: authority,
+		ChainId:   chainId,
+		Launcher:  launcher,
+		Amount:    amount,
+		Expiry:    expiry,
+		Memo:      memo,
+	}
+}
+
+func (msg *MsgIssueBillingCredit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgIssueBillingCredit) Type() string {
+	return TypeMsgIssueBillingCredit
+}
+
+func (msg *MsgIssueBillingCredit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if (msg.ChainId == "") == (msg.Launcher == "") {
+		return cosmossdkerrors.Wrap(ErrInvalidCredit, "exactly one of chain ID and launcher must be set")
+	}
+	if msg.Launcher != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Launcher); err != nil {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid launcher address (%s)", err)
+		}
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return cosmossdkerrors.Wrapf(ErrInvalidCredit, "invalid amount %s", msg.Amount)
+	}
+	if msg.Expiry.IsZero() {
+		return cosmossdkerrors.Wrap(ErrInvalidCredit, "missing expiry")
+	}
+
+	return nil
+}
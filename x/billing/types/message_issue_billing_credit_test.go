@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgIssueBillingCredit_ValidateBasic(t *testing.T) {
+	amount := sdk.NewInt64Coin("utsaga", 10)
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		msg  MsgIssueBillingCredit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgIssueBillingCredit("invalid_address", "chain_1-1", "", amount, expiry, ""),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no recipient",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "", "", amount, expiry, ""),
+			err:  ErrInvalidCredit,
+		}, {
+			name: "chainlet and launcher",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "chain_1-1", sample.AccAddress(), amount, expiry, ""),
+			err:  ErrInvalidCredit,
+		}, {
+			name: "invalid launcher",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "", "invalid_address", amount, expiry, ""),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "chain_1-1", "", sdk.NewInt64Coin("utsaga", 0), expiry, ""),
+			err:  ErrInvalidCredit,
+		}, {
+			name: "no expiry",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "chain_1-1", "", amount, time.Time{}, ""),
+			err:  ErrInvalidCredit,
+		}, {
+			name: "chainlet credit",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "chain_1-1", "", amount, expiry, "voucher"),
+		}, {
+			name: "launcher credit",
+			msg:  *NewMsgIssueBillingCredit(sample.AccAddress(), "", sample.AccAddress(), amount, expiry, "promotion"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevokeBillingCredit = "revoke_billing_credit"
+
+var _ sdk.Msg = &MsgRevokeBillingCredit{}
+
+func NewMsgRevokeBillingCredit(authority string, id uint64) *MsgRevokeBillingCredit {
+	return &MsgRevokeBillingCredit{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+func (msg *MsgRevokeBillingCredit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeBillingCredit) Type() string {
+	return TypeMsgRevokeBillingCredit
+}
+
+func (msg *MsgRevokeBillingCredit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
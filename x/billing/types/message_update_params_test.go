@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	defaultParams := DefaultParams()
+	splitParams := DefaultParams()
+	splitParams.TreasuryShare = math.LegacyNewDecWithPrec(2, 1)
+	splitParams.TreasuryAddress = sample.AccAddress()
+	splitParams.CommunityPoolShare = math.LegacyNewDecWithPrec(1, 1)
+	noTreasuryParams := splitParams
+	noTreasuryParams.TreasuryAddress = ""
+	overParams := splitParams
+	overParams.CommunityPoolShare = math.LegacyNewDecWithPrec(9, 1)
+	tests := []struct {
+		name string
+		msg  MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    &defaultParams,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing params",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "treasury share without treasury address",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    &noTreasuryParams,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "shares above one",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    &overParams,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid split",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    &splitParams,
+			},
+		}, {
+			name: "valid address",
+			msg: MsgUpdateParams{
+				Authority: sample.AccAddress(),
+				Params:    &defaultParams,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -16,12 +17,29 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// DefaultGracePeriodEpochs stops chainlets at the first epoch they cannot be billed for
+const DefaultGracePeriodEpochs uint64 = 0
+
+// DefaultLowBalanceThresholds are the runways in epochs at which low escrow balance events are emitted
+var DefaultLowBalanceThresholds = []uint64{3, 1}
+
+// DefaultMaxStackRevenueShare is the default maximum revenue share of chainlet stack creators
+var DefaultMaxStackRevenueShare = math.LegacyNewDecWithPrec(1, 1)
+
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
 		ValidatorPayoutEpoch: SAGA_EPOCH_IDENTIFIER,
 		BillingEpoch:         SAGA_EPOCH_IDENTIFIER,
 		PlatformValidators:   nil,
+		GracePeriodEpochs:    DefaultGracePeriodEpochs,
+		LowBalanceThresholds: DefaultLowBalanceThresholds,
+		MaxStackRevenueShare: DefaultMaxStackRevenueShare,
+		TreasuryShare:        math.LegacyZeroDec(),
+		TreasuryAddress:      "",
+		CommunityPoolShare:   math.LegacyZeroDec(),
+		ValidatorPayoutMode:  PayoutMode_PAYOUT_MODE_EQUAL,
+		ProrateEpochFees:     false,
 	}
 }
 
@@ -37,6 +55,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair([]byte("ValidatorPayoutEpoch"), &p.ValidatorPayoutEpoch, validateEpochParam),
 		paramtypes.NewParamSetPair([]byte("BillingEpoch"), &p.BillingEpoch, validateEpochParam),
 		paramtypes.NewParamSetPair([]byte("PlatformValidators"), &p.PlatformValidators, validatePlatformValidatorsParam),
+		paramtypes.NewParamSetPair([]byte("GracePeriodEpochs"), &p.GracePeriodEpochs, validateGracePeriodEpochsParam),
+		paramtypes.NewParamSetPair([]byte("LowBalanceThresholds"), &p.LowBalanceThresholds, validateLowBalanceThresholdsParam),
+		paramtypes.NewParamSetPair([]byte("MaxStackRevenueShare"), &p.MaxStackRevenueShare, validateMaxStackRevenueShareParam),
+		paramtypes.NewParamSetPair([]byte("TreasuryShare"), &p.TreasuryShare, validateRevenueSplitShareParam),
+		paramtypes.NewParamSetPair([]byte("TreasuryAddress"), &p.TreasuryAddress, validateTreasuryAddressParam),
+		paramtypes.NewParamSetPair([]byte("CommunityPoolShare"), &p.CommunityPoolShare, validateRevenueSplitShareParam),
+		paramtypes.NewParamSetPair([]byte("ValidatorPayoutMode"), &p.ValidatorPayoutMode, validateValidatorPayoutModeParam),
+		paramtypes.NewParamSetPair([]byte("ProrateEpochFees"), &p.ProrateEpochFees, validateProrateEpochFeesParam),
 	}
 
 	return psp
@@ -44,7 +70,56 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validatePlatformValidatorsParam(p.PlatformValidators); err != nil {
+		return err
+	}
+	if err := validateLowBalanceThresholdsParam(p.LowBalanceThresholds); err != nil {
+		return err
+	}
+	if err := validateMaxStackRevenueShareParam(p.MaxStackRevenueShare); err != nil {
+		return err
+	}
+	if err := validateValidatorPayoutModeParam(p.ValidatorPayoutMode); err != nil {
+		return err
+	}
+	treasuryShare, communityPoolShare := p.GetTreasuryShare(), p.GetCommunityPoolShare()
+	if err := validateRevenueSplitShareParam(treasuryShare); err != nil {
+		return err
+	}
+	if err := validateRevenueSplitShareParam(communityPoolShare); err != nil {
+		return err
+	}
+	if treasuryShare.Add(communityPoolShare).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("treasury and community pool shares cannot exceed 1: %s + %s", treasuryShare, communityPoolShare)
+	}
+	if treasuryShare.IsPositive() && p.TreasuryAddress == "" {
+		return fmt.Errorf("treasury address must be set with a treasury share")
+	}
+	return validateTreasuryAddressParam(p.TreasuryAddress)
+}
+
+// GetMaxStackRevenueShare returns the maximum revenue share, zero if the param is not set.
+func (p Params) GetMaxStackRevenueShare() math.LegacyDec {
+	if p.MaxStackRevenueShare.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return p.MaxStackRevenueShare
+}
+
+// GetTreasuryShare returns the treasury share, zero if the param is not set.
+func (p Params) GetTreasuryShare() math.LegacyDec {
+	if p.TreasuryShare.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return p.TreasuryShare
+}
+
+// GetCommunityPoolShare returns the community pool share, zero if the param is not set.
+func (p Params) GetCommunityPoolShare() math.LegacyDec {
+	if p.CommunityPoolShare.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return p.CommunityPoolShare
 }
 
 // String implements the Stringer interface.
@@ -74,3 +149,87 @@ func validatePlatformValidatorsParam(v interface{}) error {
 	}
 	return nil
 }
+
+func validateGracePeriodEpochsParam(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("could not unmarshal grace-period-epochs parm for validation")
+	}
+	return nil
+}
+
+func validateLowBalanceThresholdsParam(v interface{}) error {
+	thresholds, ok := v.([]uint64)
+	if !ok {
+		return fmt.Errorf("could not unmarshal low-balance-thresholds parm for validation")
+	}
+	for _, threshold := range thresholds {
+		if threshold == 0 {
+			return fmt.Errorf("low balance threshold cannot be zero")
+		}
+	}
+	return nil
+}
+
+func validateMaxStackRevenueShareParam(v interface{}) error {
+	share, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("could not unmarshal max-stack-revenue-share parm for validation")
+	}
+	// not set on chains that predate the param
+	if share.IsNil() {
+		return nil
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max stack revenue share must be between 0 and 1: %s", share)
+	}
+	return nil
+}
+
+func validateRevenueSplitShareParam(v interface{}) error {
+	share, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("could not unmarshal revenue split share parm for validation")
+	}
+	// not set on chains that predate the param
+	if share.IsNil() {
+		return nil
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("revenue split share must be between 0 and 1: %s", share)
+	}
+	return nil
+}
+
+func validateTreasuryAddressParam(v interface{}) error {
+	addr, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("could not unmarshal treasury-address parm for validation")
+	}
+	if addr == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("invalid treasury address: %s", addr)
+	}
+	return nil
+}
+
+func validateValidatorPayoutModeParam(v interface{}) error {
+	mode, ok := v.(PayoutMode)
+	if !ok {
+		return fmt.Errorf("could not unmarshal validator-payout-mode parm for validation")
+	}
+	if _, ok := PayoutMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid validator payout mode: %d", mode)
+	}
+	return nil
+}
+
+func validateProrateEpochFeesParam(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("could not unmarshal prorate-epoch-fees parm for validation")
+	}
+	return nil
+}
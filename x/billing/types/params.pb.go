@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,11 +25,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// How the validator part of the epoch fees is split between validators
+type PayoutMode int32
+
+const (
+	// Equal parts for each validator
+	PayoutMode_PAYOUT_MODE_EQUAL PayoutMode = 0
+	// Proportional to the bonded tokens, nothing for jailed validators
+	PayoutMode_PAYOUT_MODE_STAKE_WEIGHTED PayoutMode = 1
+	// Proportional to the number of billed chainlets a validator runs: it
+	// submitted peers for the chainlet and, for CCV consumer chainlets, opted in
+	PayoutMode_PAYOUT_MODE_PARTICIPATION_WEIGHTED PayoutMode = 2
+)
+
+var PayoutMode_name = map[int32]string{
+	0: "PAYOUT_MODE_EQUAL",
+	1: "PAYOUT_MODE_STAKE_WEIGHTED",
+	2: "PAYOUT_MODE_PARTICIPATION_WEIGHTED",
+}
+
+var PayoutMode_value = map[string]int32{
+	"PAYOUT_MODE_EQUAL":                  0,
+	"PAYOUT_MODE_STAKE_WEIGHTED":         1,
+	"PAYOUT_MODE_PARTICIPATION_WEIGHTED": 2,
+}
+
+func (x PayoutMode) String() string {
+	return proto.EnumName(PayoutMode_name, int32(x))
+}
+
+func (PayoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_46fb5cb2ae268601, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	ValidatorPayoutEpoch string   `protobuf:"bytes,1,opt,name=validator_payout_epoch,json=validatorPayoutEpoch,proto3" json:"validator_payout_epoch,omitempty"`
 	BillingEpoch         string   `protobuf:"bytes,2,opt,name=billing_epoch,json=billingEpoch,proto3" json:"billing_epoch,omitempty"`
 	PlatformValidators   []string `protobuf:"bytes,3,rep,name=platform_validators,json=platformValidators,proto3" json:"platform_validators,omitempty"`
+	// Number of epochs a chainlet keeps running and accrues debt after billing
+	// fails before it is stopped
+	GracePeriodEpochs uint64 `protobuf:"varint,4,opt,name=grace_period_epochs,json=gracePeriodEpochs,proto3" json:"grace_period_epochs,omitempty"`
+	// Runways in epochs at which low escrow balance events are emitted
+	LowBalanceThresholds []uint64 `protobuf:"varint,5,rep,packed,name=low_balance_thresholds,json=lowBalanceThresholds,proto3" json:"low_balance_thresholds,omitempty"`
+	// Maximum revenue share chainlet stack creators can set
+	MaxStackRevenueShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_stack_revenue_share,json=maxStackRevenueShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_stack_revenue_share"`
+	// Part of the epoch fees paid to the treasury
+	TreasuryShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=treasury_share,json=treasuryShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_share"`
+	// Address receiving the treasury share
+	TreasuryAddress string `protobuf:"bytes,8,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// Part of the epoch fees paid to the distribution community pool, the
+	// validators receive the rest
+	CommunityPoolShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=community_pool_share,json=communityPoolShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_share"`
+	// Split of the validator part of the epoch fees. Parts are rounded down
+	// and the remainder is paid to the community pool. Validators share equally
+	// in the weighted modes if none of them has any weight.
+	ValidatorPayoutMode PayoutMode `protobuf:"varint,10,opt,name=validator_payout_mode,json=validatorPayoutMode,proto3,enum=ssc.billing.PayoutMode" json:"validator_payout_mode,omitempty"`
+	// Charge chainlets launched or restarted mid-epoch only for the rest of the
+	// billing epoch, and return the unused part of the epoch fee to the escrow
+	// of chainlets suspended or decommissioned mid-epoch
+	ProrateEpochFees bool `protobuf:"varint,11,opt,name=prorate_epoch_fees,json=prorateEpochFees,proto3" json:"prorate_epoch_fees,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -83,29 +140,87 @@ func (m *Params) GetPlatformValidators() []string {
 	return nil
 }
 
+func (m *Params) GetGracePeriodEpochs() uint64 {
+	if m != nil {
+		return m.GracePeriodEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetLowBalanceThresholds() []uint64 {
+	if m != nil {
+		return m.LowBalanceThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+func (m *Params) GetValidatorPayoutMode() PayoutMode {
+	if m != nil {
+		return m.ValidatorPayoutMode
+	}
+	return PayoutMode_PAYOUT_MODE_EQUAL
+}
+
+func (m *Params) GetProrateEpochFees() bool {
+	if m != nil {
+		return m.ProrateEpochFees
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("ssc.billing.PayoutMode", PayoutMode_name, PayoutMode_value)
 	proto.RegisterType((*Params)(nil), "ssc.billing.Params")
 }
 
 func init() { proto.RegisterFile("ssc/billing/params.proto", fileDescriptor_46fb5cb2ae268601) }
 
 var fileDescriptor_46fb5cb2ae268601 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x2e, 0x4e, 0xd6,
-	0x4f, 0xca, 0xcc, 0xc9, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x2e, 0x4e, 0xd6, 0x83, 0xca, 0x48, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0xa5, 0x59, 0x8c, 0x5c, 0x6c, 0x01, 0x60,
-	0x3d, 0x42, 0x26, 0x5c, 0x62, 0x65, 0x89, 0x39, 0x99, 0x29, 0x89, 0x25, 0xf9, 0x45, 0xf1, 0x05,
-	0x89, 0x95, 0xf9, 0xa5, 0x25, 0xf1, 0xa9, 0x05, 0xf9, 0xc9, 0x19, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
-	0x9c, 0x41, 0x22, 0x70, 0xd9, 0x00, 0xb0, 0xa4, 0x2b, 0x48, 0x4e, 0x48, 0x99, 0x8b, 0x17, 0x6a,
-	0x03, 0x54, 0x31, 0x13, 0x58, 0x31, 0x0f, 0x54, 0x10, 0xa2, 0x48, 0x9f, 0x4b, 0xb8, 0x20, 0x27,
-	0xb1, 0x24, 0x2d, 0xbf, 0x28, 0x37, 0x1e, 0x6e, 0x4a, 0xb1, 0x04, 0xb3, 0x02, 0xb3, 0x06, 0x67,
-	0x90, 0x10, 0x4c, 0x2a, 0x0c, 0x2e, 0x63, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xc3, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x27, 0xa6, 0x27, 0x56, 0x54, 0x56, 0xe9, 0x83, 0x82, 0xa1,
-	0x02, 0x1e, 0x10, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x5f, 0x1a, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x77, 0x38, 0x88, 0xd8, 0x24, 0x01, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xc7, 0xb7, 0xb0, 0x20, 0x0c, 0x82, 0xcb, 0xb0, 0x48, 0xc5, 0xa4, 0x6c, 0x30, 0x21, 0xab,
+	0xd1, 0x36, 0xbe, 0x9c, 0x3c, 0xb9, 0xb8, 0xab, 0x6e, 0x00, 0xa9, 0x65, 0xf1, 0xed, 0x32, 0x99,
+	0x9d, 0x0e, 0x6d, 0x43, 0xbb, 0x4f, 0x33, 0xd3, 0x85, 0xad, 0x9f, 0xc2, 0xa3, 0x47, 0x3f, 0x84,
+	0x1f, 0x82, 0x9b, 0xc4, 0x93, 0xf1, 0x40, 0x0c, 0x7c, 0x11, 0xd3, 0x4e, 0xa9, 0x1b, 0xbd, 0x71,
+	0x6b, 0x9f, 0xdf, 0x93, 0xdf, 0x3c, 0x9d, 0xfe, 0x1f, 0xa4, 0x4b, 0xc9, 0xac, 0x7e, 0x10, 0x86,
+	0xc1, 0xc0, 0xb3, 0x62, 0x2a, 0x68, 0x24, 0xcd, 0x58, 0x40, 0x02, 0x78, 0x4e, 0x4a, 0x66, 0x16,
+	0x64, 0xb5, 0xee, 0x81, 0x07, 0x79, 0xdd, 0xca, 0x9e, 0x54, 0xcb, 0xea, 0x2d, 0x06, 0x32, 0x02,
+	0x49, 0x14, 0x50, 0x2f, 0x0a, 0xad, 0x7f, 0x9f, 0x42, 0xd3, 0x76, 0xae, 0xc3, 0x4f, 0xd0, 0xcd,
+	0x23, 0x1a, 0x06, 0x2e, 0x4d, 0x40, 0x90, 0x98, 0xa6, 0x30, 0x4c, 0x08, 0x8f, 0x81, 0xf9, 0xba,
+	0xd6, 0xd0, 0x9a, 0xb3, 0x4e, 0xbd, 0xa4, 0x76, 0x0e, 0x3b, 0x19, 0xc3, 0x77, 0xd0, 0x7c, 0x71,
+	0x78, 0xd1, 0x3c, 0x91, 0x37, 0x5f, 0x2f, 0x8a, 0xaa, 0xc9, 0x42, 0x4b, 0x71, 0x48, 0x93, 0x03,
+	0x10, 0x11, 0x29, 0x2d, 0x52, 0x9f, 0x6c, 0x4c, 0x36, 0x67, 0x1d, 0x7c, 0x89, 0xde, 0x96, 0x04,
+	0x9b, 0x68, 0xc9, 0x13, 0x94, 0x71, 0x12, 0x73, 0x11, 0x80, 0xab, 0xd4, 0x52, 0xaf, 0x36, 0xb4,
+	0x66, 0xd5, 0x59, 0xcc, 0x91, 0x9d, 0x93, 0xdc, 0x9f, 0xcf, 0x1e, 0xc2, 0x31, 0xe9, 0xd3, 0x90,
+	0x0e, 0x18, 0x27, 0x89, 0x2f, 0xb8, 0xf4, 0x21, 0x74, 0xa5, 0x3e, 0xd5, 0x98, 0x6c, 0x56, 0x9d,
+	0x7a, 0x08, 0xc7, 0x9b, 0x0a, 0xf6, 0x4a, 0x86, 0x7d, 0xb4, 0x12, 0xd1, 0x11, 0x91, 0x09, 0x65,
+	0x87, 0x44, 0xf0, 0x23, 0x3e, 0x18, 0x72, 0x22, 0x7d, 0x2a, 0xb8, 0x3e, 0x9d, 0x7d, 0xc5, 0xe6,
+	0xc3, 0x93, 0xb3, 0xb5, 0xca, 0xaf, 0xb3, 0xb5, 0xdb, 0xea, 0xce, 0xa4, 0x7b, 0x68, 0x06, 0x60,
+	0x45, 0x34, 0xf1, 0xcd, 0x6d, 0xee, 0x51, 0x96, 0xb6, 0x39, 0xfb, 0xf1, 0xed, 0x01, 0x2a, 0xae,
+	0xb4, 0xcd, 0x99, 0x53, 0x8f, 0xe8, 0x68, 0x2f, 0x13, 0x3a, 0xca, 0xb7, 0x97, 0xe9, 0xf0, 0x7b,
+	0xb4, 0x90, 0x08, 0x4e, 0xe5, 0x50, 0xa4, 0xc5, 0x01, 0xd7, 0xae, 0x7a, 0xc0, 0xfc, 0xa5, 0x48,
+	0x99, 0xef, 0xa2, 0x5a, 0x69, 0xa6, 0xae, 0x2b, 0xb8, 0x94, 0xfa, 0x4c, 0xfe, 0x0b, 0x6e, 0x5c,
+	0xd6, 0x5b, 0xaa, 0x8c, 0x19, 0xaa, 0x33, 0x88, 0xa2, 0xe1, 0x20, 0x48, 0x52, 0x12, 0x03, 0x84,
+	0xc5, 0x28, 0xb3, 0x57, 0x1d, 0x05, 0x97, 0x3a, 0x1b, 0x20, 0x54, 0xf3, 0x6c, 0xa1, 0xe5, 0xff,
+	0x52, 0x14, 0x81, 0xcb, 0x75, 0xd4, 0xd0, 0x9a, 0x0b, 0x8f, 0x56, 0xcc, 0xb1, 0xb8, 0x9a, 0x2a,
+	0x48, 0x3b, 0xe0, 0x72, 0x67, 0xe9, 0x9f, 0x74, 0x65, 0x45, 0x7c, 0x1f, 0xe1, 0x58, 0x80, 0xa0,
+	0x09, 0x57, 0x09, 0x20, 0x07, 0x9c, 0x4b, 0x7d, 0xae, 0xa1, 0x35, 0x67, 0x9c, 0x5a, 0x41, 0xf2,
+	0x04, 0xbc, 0xe0, 0x5c, 0x3e, 0xad, 0x7e, 0xf9, 0xba, 0x56, 0xb9, 0x77, 0x88, 0xd0, 0x98, 0x61,
+	0x19, 0x2d, 0xda, 0xad, 0x0f, 0xbb, 0xfb, 0x3d, 0xb2, 0xb3, 0xdb, 0xee, 0x90, 0xce, 0x9b, 0xfd,
+	0xd6, 0x76, 0xad, 0x82, 0x0d, 0xb4, 0x3a, 0x5e, 0xde, 0xeb, 0xb5, 0xb6, 0x3a, 0xe4, 0x5d, 0xa7,
+	0xfb, 0xf2, 0x55, 0xaf, 0xd3, 0xae, 0x69, 0x78, 0x03, 0xad, 0x8f, 0x73, 0xbb, 0xe5, 0xf4, 0xba,
+	0xcf, 0xbb, 0x76, 0xab, 0xd7, 0xdd, 0x7d, 0xfd, 0xb7, 0x6f, 0x62, 0xf3, 0xd9, 0xc9, 0xb9, 0xa1,
+	0x9d, 0x9e, 0x1b, 0xda, 0xef, 0x73, 0x43, 0xfb, 0x7c, 0x61, 0x54, 0x4e, 0x2f, 0x8c, 0xca, 0xcf,
+	0x0b, 0xa3, 0xf2, 0x71, 0xc3, 0x0b, 0x12, 0x7f, 0xd8, 0x37, 0x19, 0x44, 0x96, 0xa4, 0x1e, 0x1d,
+	0xa5, 0x9f, 0xac, 0x6c, 0x87, 0x47, 0xe5, 0x16, 0x27, 0x69, 0xcc, 0x65, 0x7f, 0x3a, 0xdf, 0xc3,
+	0xc7, 0x7f, 0x06, 0x00, 0x79, 0x34, 0x72, 0xe0, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -128,6 +243,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProrateEpochFees {
+		i--
+		if m.ProrateEpochFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ValidatorPayoutMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorPayoutMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.CommunityPoolShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.TreasuryShare.Size()
+		i -= size
+		if _, err := m.TreasuryShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxStackRevenueShare.Size()
+		i -= size
+		if _, err := m.MaxStackRevenueShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LowBalanceThresholds) > 0 {
+		dAtA2 := make([]byte, len(m.LowBalanceThresholds)*10)
+		var j1 int
+		for _, num := range m.LowBalanceThresholds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GracePeriodEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GracePeriodEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.PlatformValidators) > 0 {
 		for iNdEx := len(m.PlatformValidators) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PlatformValidators[iNdEx])
@@ -185,6 +375,32 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.GracePeriodEpochs != 0 {
+		n += 1 + sovParams(uint64(m.GracePeriodEpochs))
+	}
+	if len(m.LowBalanceThresholds) > 0 {
+		l = 0
+		for _, e := range m.LowBalanceThresholds {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	l = m.MaxStackRevenueShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TreasuryShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.CommunityPoolShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ValidatorPayoutMode != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorPayoutMode))
+	}
+	if m.ProrateEpochFees {
+		n += 2
+	}
 	return n
 }
 
@@ -319,6 +535,274 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.PlatformValidators = append(m.PlatformValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodEpochs", wireType)
+			}
+			m.GracePeriodEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LowBalanceThresholds = append(m.LowBalanceThresholds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LowBalanceThresholds) == 0 {
+					m.LowBalanceThresholds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LowBalanceThresholds = append(m.LowBalanceThresholds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalanceThresholds", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackRevenueShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStackRevenueShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPayoutMode", wireType)
+			}
+			m.ValidatorPayoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPayoutMode |= PayoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProrateEpochFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProrateEpochFees = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -30,6 +32,69 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ResultFilter selects history records by outcome
+type ResultFilter int32
+
+const (
+	ResultFilter_RESULT_FILTER_ANY     ResultFilter = 0
+	ResultFilter_RESULT_FILTER_SUCCESS ResultFilter = 1
+	ResultFilter_RESULT_FILTER_FAILURE ResultFilter = 2
+)
+
+var ResultFilter_name = map[int32]string{
+	0: "RESULT_FILTER_ANY",
+	1: "RESULT_FILTER_SUCCESS",
+	2: "RESULT_FILTER_FAILURE",
+}
+
+var ResultFilter_value = map[string]int32{
+	"RESULT_FILTER_ANY":     0,
+	"RESULT_FILTER_SUCCESS": 1,
+	"RESULT_FILTER_FAILURE": 2,
+}
+
+func (x ResultFilter) String() string {
+	return proto.EnumName(ResultFilter_name, int32(x))
+}
+
+func (ResultFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{0}
+}
+
+type BillingOutcome int32
+
+const (
+	// Not billed: service chainlet, inactive chainlet or unknown stack
+	BillingOutcome_BILLING_OUTCOME_SKIPPED BillingOutcome = 0
+	BillingOutcome_BILLING_OUTCOME_BILLED  BillingOutcome = 1
+	// Not billed, kept running within the grace period
+	BillingOutcome_BILLING_OUTCOME_GRACE_PERIOD BillingOutcome = 2
+	// Not billed and stopped
+	BillingOutcome_BILLING_OUTCOME_STOPPED BillingOutcome = 3
+)
+
+var BillingOutcome_name = map[int32]string{
+	0: "BILLING_OUTCOME_SKIPPED",
+	1: "BILLING_OUTCOME_BILLED",
+	2: "BILLING_OUTCOME_GRACE_PERIOD",
+	3: "BILLING_OUTCOME_STOPPED",
+}
+
+var BillingOutcome_value = map[string]int32{
+	"BILLING_OUTCOME_SKIPPED":      0,
+	"BILLING_OUTCOME_BILLED":       1,
+	"BILLING_OUTCOME_GRACE_PERIOD": 2,
+	"BILLING_OUTCOME_STOPPED":      3,
+}
+
+func (x BillingOutcome) String() string {
+	return proto.EnumName(BillingOutcome_name, int32(x))
+}
+
+func (BillingOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{1}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -116,6 +181,12 @@ func (m *QueryParamsResponse) GetParams() Params {
 type QueryGetBillingHistoryRequest struct {
 	ChainId    string             `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional filters
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epochIdentifier,proto3" json:"epochIdentifier,omitempty"`
+	// Inclusive epoch number range, zero values are ignored
+	FromEpoch int64        `protobuf:"varint,4,opt,name=fromEpoch,proto3" json:"fromEpoch,omitempty"`
+	ToEpoch   int64        `protobuf:"varint,5,opt,name=toEpoch,proto3" json:"toEpoch,omitempty"`
+	Result    ResultFilter `protobuf:"varint,6,opt,name=result,proto3,enum=ssc.billing.ResultFilter" json:"result,omitempty"`
 }
 
 func (m *QueryGetBillingHistoryRequest) Reset()         { *m = QueryGetBillingHistoryRequest{} }
@@ -165,6 +236,34 @@ func (m *QueryGetBillingHistoryRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGetBillingHistoryRequest) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *QueryGetBillingHistoryRequest) GetFromEpoch() int64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryGetBillingHistoryRequest) GetToEpoch() int64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *QueryGetBillingHistoryRequest) GetResult() ResultFilter {
+	if m != nil {
+		return m.Result
+	}
+	return ResultFilter_RESULT_FILTER_ANY
+}
+
 type QueryGetBillingHistoryResponse struct {
 	Billhistory []*BillingHistory   `protobuf:"bytes,1,rep,name=billhistory,proto3" json:"billhistory,omitempty"`
 	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -220,6 +319,12 @@ func (m *QueryGetBillingHistoryResponse) GetPagination() *query.PageResponse {
 type QueryGetValidatorPayoutHistoryRequest struct {
 	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
 	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional filters
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epochIdentifier,proto3" json:"epochIdentifier,omitempty"`
+	// Inclusive epoch number range, zero values are ignored
+	FromEpoch int64        `protobuf:"varint,4,opt,name=fromEpoch,proto3" json:"fromEpoch,omitempty"`
+	ToEpoch   int64        `protobuf:"varint,5,opt,name=toEpoch,proto3" json:"toEpoch,omitempty"`
+	Result    ResultFilter `protobuf:"varint,6,opt,name=result,proto3,enum=ssc.billing.ResultFilter" json:"result,omitempty"`
 }
 
 func (m *QueryGetValidatorPayoutHistoryRequest) Reset()         { *m = QueryGetValidatorPayoutHistoryRequest{} }
@@ -269,6 +374,34 @@ func (m *QueryGetValidatorPayoutHistoryRequest) GetPagination() *query.PageReque
 	return nil
 }
 
+func (m *QueryGetValidatorPayoutHistoryRequest) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) GetFromEpoch() int64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) GetToEpoch() int64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) GetResult() ResultFilter {
+	if m != nil {
+		return m.Result
+	}
+	return ResultFilter_RESULT_FILTER_ANY
+}
+
 type QueryGetValidatorPayoutHistoryResponse struct {
 	Validatorpayouthistory []*ValidatorPayoutHistory `protobuf:"bytes,1,rep,name=validatorpayouthistory,proto3" json:"validatorpayouthistory,omitempty"`
 	Pagination             *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -323,564 +456,4601 @@ func (m *QueryGetValidatorPayoutHistoryResponse) GetPagination() *query.PageResp
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ssc.billing.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ssc.billing.QueryParamsResponse")
-	proto.RegisterType((*QueryGetBillingHistoryRequest)(nil), "ssc.billing.QueryGetBillingHistoryRequest")
-	proto.RegisterType((*QueryGetBillingHistoryResponse)(nil), "ssc.billing.QueryGetBillingHistoryResponse")
-	proto.RegisterType((*QueryGetValidatorPayoutHistoryRequest)(nil), "ssc.billing.QueryGetValidatorPayoutHistoryRequest")
-	proto.RegisterType((*QueryGetValidatorPayoutHistoryResponse)(nil), "ssc.billing.QueryGetValidatorPayoutHistoryResponse")
+type QueryGetChainletDebtRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func init() { proto.RegisterFile("ssc/billing/query.proto", fileDescriptor_62690ae595c5572e) }
-
-var fileDescriptor_62690ae595c5572e = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x0d, 0x8a, 0x70, 0x2f, 0xe0, 0x4d, 0x50, 0x32, 0x96, 0x95, 0x4e, 0x94, 0xa9,
-	0x48, 0xb1, 0xda, 0x71, 0x42, 0x42, 0x82, 0x0a, 0x6d, 0x43, 0xe2, 0x50, 0x7a, 0xe0, 0x00, 0x87,
-	0xca, 0x49, 0x2d, 0x37, 0x52, 0x1b, 0x67, 0xb1, 0x3b, 0xad, 0x4c, 0x3b, 0xc0, 0x27, 0x40, 0xe2,
-	0xc8, 0x17, 0xd8, 0x47, 0xd9, 0x09, 0x4d, 0x70, 0xe1, 0x84, 0x50, 0xcb, 0x97, 0xe0, 0x86, 0x6a,
-	0xbb, 0x25, 0x69, 0x1a, 0xc6, 0xa4, 0x9d, 0xda, 0xd8, 0x7f, 0xbf, 0xf7, 0x7b, 0xff, 0xe7, 0x67,
-	0x78, 0x5b, 0x08, 0x0f, 0xbb, 0x7e, 0xaf, 0xe7, 0x07, 0x0c, 0xef, 0x0f, 0x68, 0x34, 0x74, 0xc2,
-	0x88, 0x4b, 0x8e, 0x0a, 0x42, 0x78, 0x8e, 0xd9, 0xb0, 0x56, 0x19, 0x67, 0x5c, 0xad, 0xe3, 0xc9,
-	0x3f, 0x2d, 0xb1, 0xee, 0x32, 0xce, 0x59, 0x8f, 0x62, 0x12, 0xfa, 0x98, 0x04, 0x01, 0x97, 0x44,
-	0xfa, 0x3c, 0x10, 0x66, 0xb7, 0xea, 0x71, 0xd1, 0xe7, 0x02, 0xbb, 0x44, 0x50, 0x1d, 0x19, 0x1f,
-	0xd4, 0x5c, 0x2a, 0x49, 0x0d, 0x87, 0x84, 0xf9, 0x81, 0x12, 0x1b, 0x6d, 0x31, 0x4e, 0x11, 0x92,
-	0x88, 0xf4, 0x67, 0x51, 0xe2, 0x3b, 0x07, 0xa4, 0xe7, 0x77, 0x88, 0xe4, 0x51, 0x3b, 0x24, 0x43,
-	0x3e, 0x90, 0xed, 0xae, 0x2f, 0x24, 0x9f, 0x22, 0x5b, 0xf7, 0xe2, 0x5a, 0xf3, 0x9b, 0x94, 0x94,
-	0x57, 0x21, 0x7a, 0x35, 0x41, 0x69, 0xaa, 0x1c, 0x2d, 0xba, 0x3f, 0xa0, 0x42, 0x96, 0xf7, 0xe0,
-	0x4a, 0x62, 0x55, 0x84, 0x3c, 0x10, 0x14, 0xd5, 0x60, 0x5e, 0xb3, 0x14, 0x41, 0x09, 0x6c, 0x15,
-	0xea, 0x2b, 0x4e, 0xcc, 0x13, 0x47, 0x8b, 0x1b, 0x57, 0x4e, 0x7f, 0x6c, 0xe4, 0x5a, 0x46, 0x58,
-	0x7e, 0x0f, 0xe0, 0xba, 0x0a, 0xb5, 0x4b, 0x65, 0x43, 0x0b, 0xf7, 0x34, 0x80, 0xc9, 0x85, 0x8a,
-	0xf0, 0x9a, 0xd7, 0x25, 0x7e, 0xf0, 0xa2, 0xa3, 0xa2, 0x5e, 0x6f, 0x4d, 0x3f, 0xd1, 0x0e, 0x84,
-	0x7f, 0x8d, 0x29, 0x2e, 0xa9, 0x94, 0x15, 0x47, 0xbb, 0xe8, 0x4c, 0x5c, 0x74, 0x74, 0x7f, 0x8c,
-	0x8b, 0x4e, 0x93, 0x30, 0x6a, 0xa2, 0xb6, 0x62, 0x27, 0xcb, 0x27, 0x00, 0xda, 0x59, 0x0c, 0xa6,
-	0xb2, 0x27, 0xb0, 0x30, 0x29, 0xc3, 0x78, 0x53, 0x04, 0xa5, 0xe5, 0xad, 0x42, 0x7d, 0x2d, 0x51,
-	0xde, 0xdc, 0xc9, 0xb8, 0x1e, 0xed, 0x2e, 0x20, 0x7d, 0x70, 0x2e, 0xa9, 0xce, 0x9d, 0x40, 0xfd,
-	0x0c, 0xe0, 0xfd, 0x29, 0xea, 0xeb, 0x69, 0x73, 0x9b, 0xaa, 0xb7, 0x73, 0xb6, 0x55, 0xe1, 0x8d,
-	0x59, 0xf7, 0x9f, 0x75, 0x3a, 0x11, 0x15, 0xc2, 0xf8, 0x97, 0x5a, 0xbf, 0x34, 0x23, 0xbf, 0x00,
-	0x58, 0x39, 0x8f, 0xce, 0x18, 0xfa, 0x16, 0xde, 0x9a, 0x61, 0xe8, 0xbb, 0x99, 0xf4, 0x76, 0x33,
-	0xe1, 0x6d, 0x46, 0xb0, 0x8c, 0x10, 0x97, 0x66, 0x77, 0xfd, 0xf7, 0x32, 0xbc, 0xaa, 0x0a, 0x42,
-	0x21, 0xcc, 0xeb, 0xfb, 0x8b, 0x36, 0x12, 0x64, 0xe9, 0xe1, 0xb0, 0x4a, 0xd9, 0x02, 0x9d, 0xa2,
-	0xbc, 0xf9, 0xe1, 0xdb, 0xaf, 0x4f, 0x4b, 0xeb, 0x68, 0x0d, 0x0b, 0xc2, 0xc8, 0xe1, 0xf0, 0x1d,
-	0x4e, 0x8f, 0x33, 0x3a, 0x01, 0xf0, 0x66, 0xea, 0x42, 0xa2, 0x6a, 0x3a, 0x78, 0xd6, 0xe4, 0x58,
-	0x0f, 0xff, 0x4b, 0x6b, 0x98, 0x1e, 0x2b, 0xa6, 0x47, 0xa8, 0xbe, 0x90, 0x89, 0x51, 0xd9, 0x9e,
-	0x7b, 0x20, 0xf0, 0x91, 0x99, 0xc3, 0x63, 0xf4, 0x15, 0xc0, 0x3b, 0x99, 0x2d, 0x47, 0xf5, 0x85,
-	0x18, 0xff, 0xbc, 0xbd, 0xd6, 0xf6, 0x85, 0xce, 0x98, 0x12, 0x5e, 0xaa, 0x12, 0x76, 0xd0, 0xf3,
-	0xcc, 0x12, 0xb2, 0xde, 0x43, 0x7c, 0x34, 0x3f, 0x13, 0xc7, 0x8d, 0xa7, 0xa7, 0x23, 0x1b, 0x9c,
-	0x8d, 0x6c, 0xf0, 0x73, 0x64, 0x83, 0x8f, 0x63, 0x3b, 0x77, 0x36, 0xb6, 0x73, 0xdf, 0xc7, 0x76,
-	0xee, 0x4d, 0x85, 0xf9, 0xb2, 0x3b, 0x70, 0x1d, 0x8f, 0xf7, 0x13, 0x99, 0x0e, 0x67, 0xb9, 0xe4,
-	0x30, 0xa4, 0xc2, 0xcd, 0xab, 0x27, 0x74, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x65,
-	0x57, 0x1f, 0x33, 0x06, 0x00, 0x00,
+func (m *QueryGetChainletDebtRequest) Reset()         { *m = QueryGetChainletDebtRequest{} }
+func (m *QueryGetChainletDebtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainletDebtRequest) ProtoMessage()    {}
+func (*QueryGetChainletDebtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{6}
+}
+func (m *QueryGetChainletDebtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainletDebtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainletDebtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChainletDebtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainletDebtRequest.Merge(m, src)
+}
+func (m *QueryGetChainletDebtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainletDebtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainletDebtRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetChainletDebtRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of GetBillingHistory items.
-	GetBillingHistory(ctx context.Context, in *QueryGetBillingHistoryRequest, opts ...grpc.CallOption) (*QueryGetBillingHistoryResponse, error)
-	// Queries a list of GetValidatorPayoutHistory items.
-	GetValidatorPayoutHistory(ctx context.Context, in *QueryGetValidatorPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryGetValidatorPayoutHistoryResponse, error)
+func (m *QueryGetChainletDebtRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetChainletDebtResponse struct {
+	Debt ChainletDebt `protobuf:"bytes,1,opt,name=debt,proto3" json:"debt"`
+	// Number of further failed epochs before the chainlet is stopped
+	GraceEpochsLeft uint64 `protobuf:"varint,2,opt,name=graceEpochsLeft,proto3" json:"graceEpochsLeft,omitempty"`
+	// Amount settling the debt for each fee option of the chainlet stack
+	AmountsDue []string `protobuf:"bytes,3,rep,name=amountsDue,proto3" json:"amountsDue,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetChainletDebtResponse) Reset()         { *m = QueryGetChainletDebtResponse{} }
+func (m *QueryGetChainletDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainletDebtResponse) ProtoMessage()    {}
+func (*QueryGetChainletDebtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ssc.billing.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainletDebtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainletDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainletDebtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetChainletDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainletDebtResponse.Merge(m, src)
+}
+func (m *QueryGetChainletDebtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainletDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainletDebtResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetBillingHistory(ctx context.Context, in *QueryGetBillingHistoryRequest, opts ...grpc.CallOption) (*QueryGetBillingHistoryResponse, error) {
-	out := new(QueryGetBillingHistoryResponse)
-	err := c.cc.Invoke(ctx, "/ssc.billing.Query/GetBillingHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetChainletDebtResponse proto.InternalMessageInfo
+
+func (m *QueryGetChainletDebtResponse) GetDebt() ChainletDebt {
+	if m != nil {
+		return m.Debt
 	}
-	return out, nil
+	return ChainletDebt{}
 }
 
-func (c *queryClient) GetValidatorPayoutHistory(ctx context.Context, in *QueryGetValidatorPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryGetValidatorPayoutHistoryResponse, error) {
-	out := new(QueryGetValidatorPayoutHistoryResponse)
-	err := c.cc.Invoke(ctx, "/ssc.billing.Query/GetValidatorPayoutHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainletDebtResponse) GetGraceEpochsLeft() uint64 {
+	if m != nil {
+		return m.GraceEpochsLeft
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of GetBillingHistory items.
-	GetBillingHistory(context.Context, *QueryGetBillingHistoryRequest) (*QueryGetBillingHistoryResponse, error)
-	// Queries a list of GetValidatorPayoutHistory items.
-	GetValidatorPayoutHistory(context.Context, *QueryGetValidatorPayoutHistoryRequest) (*QueryGetValidatorPayoutHistoryResponse, error)
+func (m *QueryGetChainletDebtResponse) GetAmountsDue() []string {
+	if m != nil {
+		return m.AmountsDue
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryRunwayRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRunwayRequest) Reset()         { *m = QueryRunwayRequest{} }
+func (m *QueryRunwayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRunwayRequest) ProtoMessage()    {}
+func (*QueryRunwayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{8}
 }
-func (*UnimplementedQueryServer) GetBillingHistory(ctx context.Context, req *QueryGetBillingHistoryRequest) (*QueryGetBillingHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBillingHistory not implemented")
+func (m *QueryRunwayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetValidatorPayoutHistory(ctx context.Context, req *QueryGetValidatorPayoutHistoryRequest) (*QueryGetValidatorPayoutHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPayoutHistory not implemented")
+func (m *QueryRunwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunwayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRunwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunwayRequest.Merge(m, src)
+}
+func (m *QueryRunwayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunwayRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.billing.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryRunwayRequest proto.InternalMessageInfo
+
+func (m *QueryRunwayRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetBillingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBillingHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBillingHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.billing.Query/GetBillingHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBillingHistory(ctx, req.(*QueryGetBillingHistoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// RunwayOption is the runway of a single fee option of the chainlet stack
+type RunwayOption struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Escrow balance in this denom
+	Balance  string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	EpochFee string `protobuf:"bytes,3,opt,name=epochFee,proto3" json:"epochFee,omitempty"`
+	// Epochs the balance and billing credits in this denom pay for after
+	// settling any outstanding debt, the
+	// maximum value if the fee is zero
+	EpochsLeft uint64 `protobuf:"varint,4,opt,name=epochsLeft,proto3" json:"epochsLeft,omitempty"`
+	// RFC3339 time at which the chainlet would be stopped if billed with this
+	// fee option only. Empty if the chainlet is not billed.
+	ProjectedStopTime string `protobuf:"bytes,5,opt,name=projectedStopTime,proto3" json:"projectedStopTime,omitempty"`
 }
 
-func _Query_GetValidatorPayoutHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetValidatorPayoutHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RunwayOption) Reset()         { *m = RunwayOption{} }
+func (m *RunwayOption) String() string { return proto.CompactTextString(m) }
+func (*RunwayOption) ProtoMessage()    {}
+func (*RunwayOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{9}
+}
+func (m *RunwayOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunwayOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunwayOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetValidatorPayoutHistory(ctx, in)
+}
+func (m *RunwayOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunwayOption.Merge(m, src)
+}
+func (m *RunwayOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunwayOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunwayOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunwayOption proto.InternalMessageInfo
+
+func (m *RunwayOption) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.billing.Query/GetValidatorPayoutHistory",
+	return ""
+}
+
+func (m *RunwayOption) GetBalance() string {
+	if m != nil {
+		return m.Balance
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetValidatorPayoutHistory(ctx, req.(*QueryGetValidatorPayoutHistoryRequest))
+	return ""
+}
+
+func (m *RunwayOption) GetEpochFee() string {
+	if m != nil {
+		return m.EpochFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ssc.billing.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetBillingHistory",
-			Handler:    _Query_GetBillingHistory_Handler,
-		},
-		{
-			MethodName: "GetValidatorPayoutHistory",
-			Handler:    _Query_GetValidatorPayoutHistory_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ssc/billing/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RunwayOption) GetEpochsLeft() uint64 {
+	if m != nil {
+		return m.EpochsLeft
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RunwayOption) GetProjectedStopTime() string {
+	if m != nil {
+		return m.ProjectedStopTime
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryRunwayResponse struct {
+	// Fee options in the order billing tries them
+	Options []RunwayOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options"`
+	// First fee option covered by the escrow and billing credits, which the
+	// next billing charges.
+	// Empty if no fee option is covered.
+	NextFeeOption string `protobuf:"bytes,2,opt,name=nextFeeOption,proto3" json:"nextFeeOption,omitempty"`
+	// Epochs paid for when billing falls back through all fee options
+	TotalEpochsLeft uint64 `protobuf:"varint,3,opt,name=totalEpochsLeft,proto3" json:"totalEpochsLeft,omitempty"`
+	// RFC3339 time at which the chainlet is projected to be stopped, including
+	// its grace period. Empty if the chainlet is not billed.
+	ProjectedStopTime string `protobuf:"bytes,4,opt,name=projectedStopTime,proto3" json:"projectedStopTime,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRunwayResponse) Reset()         { *m = QueryRunwayResponse{} }
+func (m *QueryRunwayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRunwayResponse) ProtoMessage()    {}
+func (*QueryRunwayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{10}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRunwayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRunwayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunwayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRunwayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunwayResponse.Merge(m, src)
+}
+func (m *QueryRunwayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunwayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunwayResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBillingHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRunwayResponse proto.InternalMessageInfo
+
+func (m *QueryRunwayResponse) GetOptions() []RunwayOption {
+	if m != nil {
+		return m.Options
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetBillingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRunwayResponse) GetNextFeeOption() string {
+	if m != nil {
+		return m.NextFeeOption
+	}
+	return ""
 }
 
-func (m *QueryGetBillingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRunwayResponse) GetTotalEpochsLeft() uint64 {
+	if m != nil {
+		return m.TotalEpochsLeft
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetBillingHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRunwayResponse) GetProjectedStopTime() string {
+	if m != nil {
+		return m.ProjectedStopTime
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetBillingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStackRevenueRequest struct {
+	StackName string `protobuf:"bytes,1,opt,name=stackName,proto3" json:"stackName,omitempty"`
 }
 
-func (m *QueryGetBillingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Billhistory) > 0 {
-		for iNdEx := len(m.Billhistory) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Billhistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryStackRevenueRequest) Reset()         { *m = QueryStackRevenueRequest{} }
+func (m *QueryStackRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStackRevenueRequest) ProtoMessage()    {}
+func (*QueryStackRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{11}
+}
+func (m *QueryStackRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStackRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStackRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStackRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStackRevenueRequest.Merge(m, src)
+}
+func (m *QueryStackRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStackRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStackRevenueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorPayoutHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStackRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryStackRevenueRequest) GetStackName() string {
+	if m != nil {
+		return m.StackName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetValidatorPayoutHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStackRevenueResponse struct {
+	Share StackRevenueShare `protobuf:"bytes,1,opt,name=share,proto3" json:"share"`
+	// Share applied when billing, the share capped by the params
+	EffectiveShare string `protobuf:"bytes,2,opt,name=effectiveShare,proto3" json:"effectiveShare,omitempty"`
+	// Revenue paid out so far
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (m *QueryGetValidatorPayoutHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryStackRevenueResponse) Reset()         { *m = QueryStackRevenueResponse{} }
+func (m *QueryStackRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStackRevenueResponse) ProtoMessage()    {}
+func (*QueryStackRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{12}
+}
+func (m *QueryStackRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStackRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStackRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStackRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStackRevenueResponse.Merge(m, src)
+}
+func (m *QueryStackRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStackRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStackRevenueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorPayoutHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStackRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryStackRevenueResponse) GetShare() StackRevenueShare {
+	if m != nil {
+		return m.Share
 	}
-	return dAtA[:n], nil
+	return StackRevenueShare{}
 }
 
-func (m *QueryGetValidatorPayoutHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStackRevenueResponse) GetEffectiveShare() string {
+	if m != nil {
+		return m.EffectiveShare
+	}
+	return ""
 }
 
-func (m *QueryGetValidatorPayoutHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryStackRevenueResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
+	return nil
+}
+
+type QueryPendingRewardsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+}
+
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{13}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type QueryPendingRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Address the rewards are claimed to
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdrawAddress,proto3" json:"withdrawAddress,omitempty"`
+}
+
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{14}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *QueryPendingRewardsResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+type QueryBillingCreditsRequest struct {
+	// Optional filters, credits issued to the chainlet or to the launcher
+	ChainId    string             `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Launcher   string             `protobuf:"bytes,2,opt,name=launcher,proto3" json:"launcher,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBillingCreditsRequest) Reset()         { *m = QueryBillingCreditsRequest{} }
+func (m *QueryBillingCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingCreditsRequest) ProtoMessage()    {}
+func (*QueryBillingCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{15}
+}
+func (m *QueryBillingCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBillingCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBillingCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingCreditsRequest.Merge(m, src)
+}
+func (m *QueryBillingCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBillingCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingCreditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBillingCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryBillingCreditsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryBillingCreditsRequest) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+func (m *QueryBillingCreditsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBillingCreditsResponse struct {
+	Credits    []BillingCredit     `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBillingCreditsResponse) Reset()         { *m = QueryBillingCreditsResponse{} }
+func (m *QueryBillingCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingCreditsResponse) ProtoMessage()    {}
+func (*QueryBillingCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{16}
+}
+func (m *QueryBillingCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBillingCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBillingCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingCreditsResponse.Merge(m, src)
+}
+func (m *QueryBillingCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBillingCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBillingCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryBillingCreditsResponse) GetCredits() []BillingCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+func (m *QueryBillingCreditsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ChainletBillingReport describes the billing of a chainlet at the start of an
+// epoch.
+type ChainletBillingReport struct {
+	ChainId  string         `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Launcher string         `protobuf:"bytes,2,opt,name=launcher,proto3" json:"launcher,omitempty"`
+	Outcome  BillingOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=ssc.billing.BillingOutcome" json:"outcome,omitempty"`
+	// Index of the fee option of the stack charged, if billed
+	FeeOption uint32 `protobuf:"varint,4,opt,name=feeOption,proto3" json:"feeOption,omitempty"`
+	// Epoch fee of the fee option charged
+	EpochFee string `protobuf:"bytes,5,opt,name=epochFee,proto3" json:"epochFee,omitempty"`
+	// Part of the epoch fee paid from the escrow
+	BilledAmount string `protobuf:"bytes,6,opt,name=billedAmount,proto3" json:"billedAmount,omitempty"`
+	// Part of the epoch fee paid with billing credits
+	CreditUsed string `protobuf:"bytes,7,opt,name=creditUsed,proto3" json:"creditUsed,omitempty"`
+	// Whether the escrow covered the epoch fee left after credits
+	SufficientFunds bool `protobuf:"varint,8,opt,name=sufficientFunds,proto3" json:"sufficientFunds,omitempty"`
+	// Why the chainlet was skipped or why each fee option failed
+	Reasons []string `protobuf:"bytes,9,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *ChainletBillingReport) Reset()         { *m = ChainletBillingReport{} }
+func (m *ChainletBillingReport) String() string { return proto.CompactTextString(m) }
+func (*ChainletBillingReport) ProtoMessage()    {}
+func (*ChainletBillingReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{17}
+}
+func (m *ChainletBillingReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainletBillingReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainletBillingReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainletBillingReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainletBillingReport.Merge(m, src)
+}
+func (m *ChainletBillingReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainletBillingReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainletBillingReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainletBillingReport proto.InternalMessageInfo
+
+func (m *ChainletBillingReport) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainletBillingReport) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+func (m *ChainletBillingReport) GetOutcome() BillingOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return BillingOutcome_BILLING_OUTCOME_SKIPPED
+}
+
+func (m *ChainletBillingReport) GetFeeOption() uint32 {
+	if m != nil {
+		return m.FeeOption
+	}
+	return 0
+}
+
+func (m *ChainletBillingReport) GetEpochFee() string {
+	if m != nil {
+		return m.EpochFee
+	}
+	return ""
+}
+
+func (m *ChainletBillingReport) GetBilledAmount() string {
+	if m != nil {
+		return m.BilledAmount
+	}
+	return ""
+}
+
+func (m *ChainletBillingReport) GetCreditUsed() string {
+	if m != nil {
+		return m.CreditUsed
+	}
+	return ""
+}
+
+func (m *ChainletBillingReport) GetSufficientFunds() bool {
+	if m != nil {
+		return m.SufficientFunds
+	}
+	return false
+}
+
+func (m *ChainletBillingReport) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type QuerySimulateNextBillingRequest struct {
+	// Optional filters
+	ChainId  string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Launcher string `protobuf:"bytes,2,opt,name=launcher,proto3" json:"launcher,omitempty"`
+}
+
+func (m *QuerySimulateNextBillingRequest) Reset()         { *m = QuerySimulateNextBillingRequest{} }
+func (m *QuerySimulateNextBillingRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateNextBillingRequest) ProtoMessage()    {}
+func (*QuerySimulateNextBillingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{18}
+}
+func (m *QuerySimulateNextBillingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateNextBillingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateNextBillingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateNextBillingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateNextBillingRequest.Merge(m, src)
+}
+func (m *QuerySimulateNextBillingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateNextBillingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateNextBillingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateNextBillingRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateNextBillingRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QuerySimulateNextBillingRequest) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+type QuerySimulateNextBillingResponse struct {
+	// Epoch number the billing was simulated for
+	EpochNumber int64                   `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	Reports     []ChainletBillingReport `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports"`
+}
+
+func (m *QuerySimulateNextBillingResponse) Reset()         { *m = QuerySimulateNextBillingResponse{} }
+func (m *QuerySimulateNextBillingResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateNextBillingResponse) ProtoMessage()    {}
+func (*QuerySimulateNextBillingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62690ae595c5572e, []int{19}
+}
+func (m *QuerySimulateNextBillingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateNextBillingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateNextBillingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateNextBillingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateNextBillingResponse.Merge(m, src)
+}
+func (m *QuerySimulateNextBillingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateNextBillingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateNextBillingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateNextBillingResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateNextBillingResponse) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *QuerySimulateNextBillingResponse) GetReports() []ChainletBillingReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ssc.billing.ResultFilter", ResultFilter_name, ResultFilter_value)
+	proto.RegisterEnum("ssc.billing.BillingOutcome", BillingOutcome_name, BillingOutcome_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "ssc.billing.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ssc.billing.QueryParamsResponse")
+	proto.RegisterType((*QueryGetBillingHistoryRequest)(nil), "ssc.billing.QueryGetBillingHistoryRequest")
+	proto.RegisterType((*QueryGetBillingHistoryResponse)(nil), "ssc.billing.QueryGetBillingHistoryResponse")
+	proto.RegisterType((*QueryGetValidatorPayoutHistoryRequest)(nil), "ssc.billing.QueryGetValidatorPayoutHistoryRequest")
+	proto.RegisterType((*QueryGetValidatorPayoutHistoryResponse)(nil), "ssc.billing.QueryGetValidatorPayoutHistoryResponse")
+	proto.RegisterType((*QueryGetChainletDebtRequest)(nil), "ssc.billing.QueryGetChainletDebtRequest")
+	proto.RegisterType((*QueryGetChainletDebtResponse)(nil), "ssc.billing.QueryGetChainletDebtResponse")
+	proto.RegisterType((*QueryRunwayRequest)(nil), "ssc.billing.QueryRunwayRequest")
+	proto.RegisterType((*RunwayOption)(nil), "ssc.billing.RunwayOption")
+	proto.RegisterType((*QueryRunwayResponse)(nil), "ssc.billing.QueryRunwayResponse")
+	proto.RegisterType((*QueryStackRevenueRequest)(nil), "ssc.billing.QueryStackRevenueRequest")
+	proto.RegisterType((*QueryStackRevenueResponse)(nil), "ssc.billing.QueryStackRevenueResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "ssc.billing.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "ssc.billing.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryBillingCreditsRequest)(nil), "ssc.billing.QueryBillingCreditsRequest")
+	proto.RegisterType((*QueryBillingCreditsResponse)(nil), "ssc.billing.QueryBillingCreditsResponse")
+	proto.RegisterType((*ChainletBillingReport)(nil), "ssc.billing.ChainletBillingReport")
+	proto.RegisterType((*QuerySimulateNextBillingRequest)(nil), "ssc.billing.QuerySimulateNextBillingRequest")
+	proto.RegisterType((*QuerySimulateNextBillingResponse)(nil), "ssc.billing.QuerySimulateNextBillingResponse")
+}
+
+func init() { proto.RegisterFile("ssc/billing/query.proto", fileDescriptor_62690ae595c5572e) }
+
+var fileDescriptor_62690ae595c5572e = []byte{
+	// 1657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x5b, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0xb9, 0x4e, 0xd2, 0x34, 0x9d, 0xf4, 0xe2, 0x6c, 0x52, 0xc7, 0xff, 0x6d, 0x9b,
+	0xfa, 0x1f, 0x52, 0xbb, 0x75, 0x81, 0x5e, 0x24, 0x04, 0xb9, 0xd8, 0xa9, 0x45, 0x48, 0xc2, 0x3a,
+	0x01, 0x41, 0x1f, 0xac, 0xf1, 0xee, 0xc4, 0x59, 0x6a, 0xef, 0xba, 0x3b, 0xb3, 0xb9, 0xb4, 0xea,
+	0x0b, 0x4f, 0x7d, 0x04, 0x71, 0x79, 0x40, 0x42, 0x42, 0x08, 0xa1, 0x0a, 0xf1, 0xc0, 0xa7, 0x40,
+	0x7d, 0x42, 0x15, 0xbc, 0xf0, 0x04, 0x28, 0xe5, 0x83, 0xa0, 0xb9, 0xac, 0xb3, 0xbb, 0x5e, 0xe3,
+	0x52, 0xfa, 0xc4, 0x53, 0xb2, 0x67, 0xce, 0x9c, 0xf9, 0x9d, 0xdf, 0x39, 0x73, 0xce, 0x19, 0x83,
+	0x33, 0x84, 0x18, 0xb9, 0xaa, 0x55, 0xaf, 0x5b, 0x76, 0x2d, 0x77, 0xd7, 0xc3, 0xee, 0x41, 0xb6,
+	0xe9, 0x3a, 0xd4, 0x81, 0x23, 0x84, 0x18, 0x59, 0xb9, 0xa0, 0x9e, 0xac, 0x39, 0x35, 0x87, 0xcb,
+	0x73, 0xec, 0x3f, 0xa1, 0xa2, 0x4e, 0xd7, 0x1c, 0xa7, 0x56, 0xc7, 0x39, 0xd4, 0xb4, 0x72, 0xc8,
+	0xb6, 0x1d, 0x8a, 0xa8, 0xe5, 0xd8, 0x44, 0xae, 0xce, 0x19, 0x0e, 0x69, 0x38, 0x24, 0x57, 0x45,
+	0x04, 0x0b, 0xcb, 0xb9, 0xdd, 0x2b, 0x55, 0x4c, 0xd1, 0x95, 0x5c, 0x13, 0xd5, 0x2c, 0x9b, 0x2b,
+	0x4b, 0xdd, 0x64, 0x10, 0x45, 0x13, 0xb9, 0xa8, 0xd1, 0xb2, 0x12, 0x5c, 0xd9, 0x45, 0x75, 0xcb,
+	0x44, 0xd4, 0x71, 0x2b, 0x4d, 0x74, 0xe0, 0x78, 0xb4, 0xb2, 0x63, 0x11, 0xea, 0xf8, 0x90, 0xd5,
+	0xff, 0x05, 0x75, 0xe5, 0xdf, 0x88, 0xca, 0x4c, 0x50, 0xc5, 0xd8, 0x41, 0x96, 0x5d, 0xc7, 0xb4,
+	0x62, 0xe2, 0x2a, 0x8d, 0x53, 0x20, 0x14, 0x19, 0x77, 0x2a, 0x2e, 0xde, 0xc5, 0xb6, 0x87, 0xa5,
+	0x42, 0x3a, 0xee, 0x10, 0xc3, 0xc5, 0xa6, 0xe5, 0x9b, 0x48, 0x05, 0x1d, 0xf7, 0x5d, 0x36, 0x1c,
+	0x4b, 0x3a, 0xab, 0x9d, 0x04, 0xf0, 0x6d, 0x46, 0xc7, 0x06, 0xf7, 0x53, 0xc7, 0x77, 0x3d, 0x4c,
+	0xa8, 0x76, 0x0b, 0x4c, 0x84, 0xa4, 0xa4, 0xe9, 0xd8, 0x04, 0xc3, 0x2b, 0x60, 0x40, 0xf0, 0x91,
+	0x54, 0xd2, 0x4a, 0x66, 0x24, 0x3f, 0x91, 0x0d, 0xc4, 0x25, 0x2b, 0x94, 0x17, 0xfb, 0x1e, 0xff,
+	0x36, 0xd3, 0xa3, 0x4b, 0x45, 0xed, 0xf3, 0x5e, 0x70, 0x96, 0x9b, 0x5a, 0xc1, 0x74, 0x51, 0x28,
+	0xde, 0x12, 0x24, 0xc8, 0xb3, 0x60, 0x12, 0x0c, 0x72, 0xdf, 0x4b, 0x26, 0xb7, 0x3a, 0xac, 0xfb,
+	0x9f, 0xb0, 0x08, 0xc0, 0x51, 0x70, 0x92, 0xbd, 0xfc, 0xc8, 0xd9, 0xac, 0x70, 0x28, 0xcb, 0x1c,
+	0xca, 0x8a, 0x1c, 0x91, 0x6e, 0x65, 0x37, 0x50, 0x0d, 0x4b, 0xab, 0x7a, 0x60, 0x27, 0xcc, 0x80,
+	0xe3, 0xb8, 0xe9, 0x18, 0x3b, 0x25, 0x13, 0xdb, 0xd4, 0xda, 0xb6, 0xb0, 0x9b, 0x4c, 0xf0, 0x93,
+	0xa2, 0x62, 0x38, 0x0d, 0x86, 0xb7, 0x5d, 0xa7, 0x51, 0x60, 0xe2, 0x64, 0x5f, 0x5a, 0xc9, 0x24,
+	0xf4, 0x23, 0x01, 0x43, 0x4a, 0x1d, 0xb1, 0xd6, 0xcf, 0xd7, 0xfc, 0x4f, 0x46, 0x8c, 0x8b, 0x89,
+	0x57, 0xa7, 0xc9, 0x81, 0xb4, 0x92, 0x19, 0xcb, 0x4f, 0x86, 0x88, 0xd1, 0xf9, 0x52, 0xd1, 0xaa,
+	0x53, 0xec, 0xea, 0x52, 0x51, 0x7b, 0xa4, 0x80, 0x54, 0x27, 0x62, 0x24, 0xdd, 0xaf, 0x81, 0x11,
+	0x66, 0x42, 0x26, 0x4d, 0x52, 0x49, 0x27, 0x32, 0x23, 0xf9, 0xa9, 0x90, 0xe9, 0xc8, 0xce, 0xa0,
+	0x3e, 0x5c, 0x89, 0xa1, 0xef, 0x62, 0x57, 0xfa, 0xc4, 0xd9, 0x41, 0xfe, 0xb4, 0x1f, 0x7a, 0xc1,
+	0x05, 0x1f, 0xea, 0x3b, 0x7e, 0xd6, 0x6f, 0xf0, 0xa4, 0x8f, 0xc4, 0x72, 0x0e, 0x8c, 0xb7, 0xae,
+	0xc5, 0x82, 0x69, 0xba, 0x98, 0x10, 0x19, 0xd4, 0x36, 0xf9, 0x7f, 0x3b, 0xba, 0x3f, 0x29, 0x60,
+	0xb6, 0x1b, 0x65, 0x32, 0xca, 0xb7, 0xc1, 0xe9, 0x16, 0x37, 0xa2, 0x92, 0x84, 0x03, 0x7e, 0x2e,
+	0x74, 0x5a, 0x07, 0x63, 0x1d, 0x4c, 0xbc, 0xb8, 0x1c, 0xb8, 0x06, 0xa6, 0x7c, 0x7f, 0x96, 0x64,
+	0xa5, 0x5a, 0xc6, 0x55, 0xda, 0xf5, 0x12, 0x6b, 0x5f, 0x2a, 0x60, 0x3a, 0x7e, 0xa7, 0xf4, 0xff,
+	0x2a, 0xe8, 0x63, 0x25, 0x4f, 0x96, 0x94, 0x30, 0xb7, 0xc1, 0x0d, 0xb2, 0xb0, 0x70, 0x65, 0x16,
+	0xf4, 0x9a, 0x8b, 0x0c, 0xcc, 0x03, 0x44, 0x56, 0xf1, 0x36, 0xe5, 0xce, 0xf5, 0xe9, 0x51, 0x31,
+	0x4c, 0x01, 0x80, 0x1a, 0x8e, 0x67, 0x53, 0xb2, 0xec, 0xe1, 0x64, 0x22, 0x9d, 0xc8, 0x0c, 0xeb,
+	0x01, 0x89, 0x96, 0x95, 0x05, 0x50, 0xf7, 0xec, 0x3d, 0xd4, 0xbd, 0x28, 0xb1, 0x7b, 0x3b, 0x2a,
+	0x74, 0xd7, 0x9b, 0x3c, 0xff, 0x4e, 0x82, 0x7e, 0x13, 0xdb, 0x4e, 0x43, 0x2a, 0x8a, 0x0f, 0x66,
+	0xa0, 0x8a, 0xea, 0xc8, 0x36, 0x30, 0x07, 0x36, 0xac, 0xfb, 0x9f, 0x50, 0x05, 0x43, 0x3c, 0x31,
+	0x8b, 0x18, 0xcb, 0x44, 0x6d, 0x7d, 0x33, 0xb0, 0xf8, 0xc8, 0xa3, 0x3e, 0xee, 0x51, 0x40, 0x02,
+	0xe7, 0xc1, 0x89, 0xa6, 0xeb, 0x7c, 0x80, 0x0d, 0x8a, 0xcd, 0x32, 0x75, 0x9a, 0x9b, 0x56, 0x03,
+	0xf3, 0x6c, 0x1d, 0xd6, 0xdb, 0x17, 0x58, 0x12, 0x4e, 0x84, 0x7c, 0x93, 0x8c, 0xdf, 0x00, 0x83,
+	0x0e, 0xc7, 0x4e, 0x64, 0x8a, 0x45, 0x12, 0x3a, 0xe0, 0x9d, 0x24, 0xdd, 0xd7, 0x87, 0xe7, 0xc1,
+	0x31, 0x1b, 0xef, 0xd3, 0x22, 0xc6, 0x62, 0x5d, 0x3a, 0x17, 0x16, 0xb2, 0xe8, 0x50, 0x87, 0xa2,
+	0x7a, 0x20, 0x3a, 0x09, 0x11, 0x9d, 0x88, 0x38, 0xde, 0xa1, 0xbe, 0x4e, 0x0e, 0x5d, 0x07, 0x49,
+	0xee, 0x4f, 0x99, 0xb5, 0x42, 0x5d, 0x74, 0x42, 0x3f, 0x62, 0xd3, 0x60, 0x98, 0x77, 0xc8, 0x35,
+	0xd4, 0xc0, 0x32, 0x14, 0x47, 0x02, 0xed, 0x50, 0x01, 0x93, 0x31, 0x5b, 0x25, 0x21, 0x37, 0x41,
+	0x3f, 0xd9, 0x41, 0x2e, 0x96, 0x39, 0x98, 0x0a, 0xd1, 0x11, 0xdc, 0x51, 0x66, 0x5a, 0x92, 0x13,
+	0xb1, 0x05, 0xce, 0x82, 0x31, 0xbc, 0xbd, 0x8d, 0x0d, 0x6a, 0xed, 0x8a, 0x65, 0x49, 0x49, 0x44,
+	0x0a, 0x11, 0xe8, 0xe7, 0xce, 0x27, 0x13, 0x92, 0xf2, 0xe0, 0x25, 0xf4, 0xaf, 0xdf, 0x92, 0x63,
+	0xd9, 0x8b, 0x97, 0x99, 0xf9, 0xef, 0x7e, 0x9f, 0xc9, 0xd4, 0x2c, 0xba, 0xe3, 0x55, 0xb3, 0x86,
+	0xd3, 0xc8, 0xc9, 0x2e, 0x2e, 0xfe, 0x5c, 0x22, 0xe6, 0x9d, 0x1c, 0x3d, 0x68, 0x62, 0xc2, 0x37,
+	0x10, 0x5d, 0x58, 0xd6, 0x6e, 0x01, 0x55, 0x74, 0x6d, 0x6c, 0x9b, 0x96, 0x5d, 0xd3, 0xf1, 0x1e,
+	0x72, 0x4d, 0xf2, 0x1c, 0xb5, 0x59, 0xfb, 0x5e, 0x01, 0x53, 0xb1, 0xa6, 0x24, 0x61, 0x06, 0x18,
+	0x10, 0x57, 0xa8, 0x95, 0x40, 0x2f, 0xd0, 0x1b, 0x69, 0x9a, 0x65, 0xd1, 0x9e, 0x45, 0x77, 0x4c,
+	0x17, 0xed, 0xf9, 0x78, 0x05, 0xb5, 0x51, 0xb1, 0xf6, 0x85, 0x22, 0x3d, 0x97, 0xed, 0x70, 0x89,
+	0x4f, 0x40, 0xa4, 0xfb, 0x84, 0xa1, 0x82, 0xa1, 0x3a, 0xf2, 0x6c, 0x63, 0x07, 0xbb, 0xd2, 0x76,
+	0xeb, 0x3b, 0xd2, 0x9f, 0x12, 0xcf, 0xdb, 0x9f, 0xb4, 0xaf, 0x7d, 0x2e, 0xa3, 0xe0, 0x5a, 0xc9,
+	0x37, 0x28, 0x26, 0x36, 0xff, 0x36, 0xaa, 0x71, 0x1d, 0x5e, 0xec, 0xf2, 0xaf, 0xa3, 0xdc, 0xf0,
+	0xe2, 0xca, 0xfb, 0x8f, 0xbd, 0xe0, 0x94, 0x5f, 0x6c, 0xe5, 0x89, 0x3a, 0x6e, 0x3a, 0xee, 0xf3,
+	0x92, 0xf7, 0x0a, 0x18, 0x74, 0x3c, 0x6a, 0x38, 0x0d, 0x51, 0xe3, 0xc6, 0xe2, 0xc7, 0x96, 0x75,
+	0xa1, 0xa2, 0xfb, 0xba, 0xbc, 0x43, 0xb7, 0x4a, 0x0b, 0x2b, 0x03, 0xc7, 0xf4, 0x23, 0x41, 0xa8,
+	0x72, 0xf6, 0x47, 0x2a, 0xa7, 0x06, 0x46, 0x99, 0x71, 0x6c, 0x2e, 0x88, 0xbc, 0x1c, 0xe0, 0xeb,
+	0x21, 0x19, 0xab, 0xae, 0x82, 0xb8, 0x2d, 0x82, 0xcd, 0xe4, 0x20, 0xd7, 0x08, 0x48, 0x58, 0xc2,
+	0x11, 0x6f, 0x7b, 0xdb, 0x32, 0x2c, 0x6c, 0xd3, 0xa2, 0x67, 0x9b, 0x24, 0x39, 0x94, 0x56, 0x32,
+	0x43, 0x7a, 0x54, 0xcc, 0x48, 0x71, 0x31, 0x22, 0xac, 0x82, 0x0e, 0xf3, 0x8e, 0xe2, 0x7f, 0x6a,
+	0xef, 0x82, 0x19, 0x51, 0x67, 0xac, 0x86, 0x57, 0x47, 0x14, 0xaf, 0xe1, 0xfd, 0x23, 0x42, 0xff,
+	0x45, 0x3a, 0x6a, 0x0f, 0x15, 0x90, 0xee, 0x6c, 0x59, 0xe6, 0x52, 0x1a, 0x8c, 0x70, 0x46, 0xd6,
+	0xbc, 0x46, 0x15, 0xbb, 0xdc, 0x7c, 0x42, 0x0f, 0x8a, 0xe0, 0x22, 0x43, 0xce, 0x02, 0xcb, 0x2e,
+	0x13, 0xcb, 0x36, 0x2d, 0xb6, 0xe1, 0x86, 0x72, 0xc0, 0xcf, 0x3a, 0xb9, 0x71, 0xee, 0x36, 0x18,
+	0x0d, 0x0e, 0x3d, 0xf0, 0x14, 0x38, 0xa1, 0x17, 0xca, 0x5b, 0xab, 0x9b, 0x95, 0x62, 0x69, 0x75,
+	0xb3, 0xa0, 0x57, 0x16, 0xd6, 0xde, 0x1b, 0xef, 0x81, 0x93, 0xe0, 0x54, 0x58, 0x5c, 0xde, 0x5a,
+	0x5a, 0x2a, 0x94, 0xcb, 0xe3, 0x4a, 0xfb, 0x52, 0x71, 0xa1, 0xb4, 0xba, 0xa5, 0x17, 0xc6, 0x7b,
+	0xe7, 0x1e, 0x2a, 0x60, 0x2c, 0x9c, 0x1e, 0x70, 0x0a, 0x9c, 0x59, 0x2c, 0xad, 0xae, 0x96, 0xd6,
+	0x56, 0x2a, 0xeb, 0x5b, 0x9b, 0x4b, 0xeb, 0x6f, 0x15, 0x2a, 0xe5, 0x37, 0x4b, 0x1b, 0x1b, 0x85,
+	0xe5, 0xf1, 0x1e, 0xa8, 0x82, 0xd3, 0xd1, 0x45, 0xf6, 0x5d, 0x58, 0x1e, 0x57, 0x60, 0x1a, 0x4c,
+	0x47, 0xd7, 0x56, 0xf4, 0x85, 0xa5, 0x42, 0x65, 0xa3, 0xa0, 0x97, 0xd6, 0x97, 0xc7, 0x7b, 0x63,
+	0x4d, 0x6f, 0xae, 0x73, 0xd3, 0x89, 0xfc, 0xa7, 0x23, 0xa0, 0x9f, 0x53, 0x0e, 0x9b, 0x60, 0x40,
+	0xbc, 0x6e, 0xe0, 0x4c, 0x88, 0xae, 0xf6, 0xa7, 0x93, 0x9a, 0xee, 0xac, 0x20, 0x82, 0xa4, 0x9d,
+	0xfb, 0xf0, 0x97, 0x3f, 0x3f, 0xe9, 0x3d, 0x0b, 0xa7, 0x72, 0x04, 0xd5, 0xd0, 0xfe, 0xc1, 0xbd,
+	0x5c, 0xfb, 0x83, 0x13, 0x3e, 0x52, 0xc0, 0x89, 0xb6, 0x97, 0x01, 0x9c, 0x6b, 0x37, 0xde, 0xe9,
+	0x5d, 0xa5, 0xbe, 0xf4, 0x4c, 0xba, 0x12, 0xd3, 0x4d, 0x8e, 0xe9, 0x65, 0x98, 0x8f, 0xc5, 0x54,
+	0xc3, 0xb4, 0x12, 0x79, 0xc2, 0xe6, 0xee, 0xcb, 0xa4, 0x7d, 0x00, 0x7f, 0x56, 0xc0, 0x64, 0xc7,
+	0x31, 0x17, 0xe6, 0x63, 0x61, 0xfc, 0xed, 0x33, 0x42, 0xbd, 0xfa, 0x8f, 0xf6, 0x48, 0x17, 0x56,
+	0xb9, 0x0b, 0x45, 0xb8, 0xdc, 0xd1, 0x85, 0x4e, 0x2f, 0xf6, 0xdc, 0xfd, 0x68, 0x03, 0x7c, 0x00,
+	0xbf, 0x52, 0xc0, 0xf1, 0xc8, 0xc4, 0x0a, 0x33, 0xb1, 0xb0, 0x62, 0xc6, 0x61, 0xf5, 0xff, 0xcf,
+	0xa0, 0x29, 0x61, 0x5f, 0xe7, 0xb0, 0xf3, 0xf0, 0x72, 0x47, 0xd8, 0xa1, 0x5f, 0x06, 0x02, 0xbc,
+	0xdf, 0x03, 0x03, 0x62, 0x54, 0x8b, 0x4b, 0xca, 0xd0, 0x38, 0xab, 0xa6, 0x3b, 0x2b, 0x48, 0x18,
+	0x97, 0x38, 0x8c, 0x8b, 0xf0, 0x42, 0x2c, 0x0c, 0x97, 0x2b, 0x07, 0xce, 0xfe, 0x4c, 0x01, 0xa3,
+	0xc1, 0xc1, 0x08, 0x5e, 0x68, 0x3f, 0x21, 0x66, 0x4a, 0x53, 0x67, 0xbb, 0xa9, 0x49, 0x38, 0xaf,
+	0x72, 0x38, 0x97, 0x61, 0x36, 0x16, 0x4e, 0xe8, 0xa7, 0x90, 0xdc, 0xfd, 0xd6, 0x98, 0xf7, 0x00,
+	0x7e, 0xa3, 0x80, 0xb1, 0xf0, 0xcc, 0x02, 0x2f, 0xc6, 0x5c, 0xc8, 0xb8, 0x01, 0x49, 0xcd, 0x74,
+	0x57, 0x94, 0xe8, 0x5e, 0xe7, 0xe8, 0x6e, 0xc0, 0x6b, 0xf1, 0x37, 0x58, 0x6c, 0xaa, 0xb8, 0x62,
+	0x57, 0x5c, 0x76, 0x7d, 0x7c, 0x54, 0xe4, 0xe4, 0x38, 0x10, 0x07, 0x33, 0x76, 0x9a, 0x51, 0x33,
+	0xdd, 0x15, 0x25, 0xcc, 0x79, 0x0e, 0x73, 0x16, 0x9e, 0x8f, 0x85, 0x19, 0xfe, 0xb9, 0x88, 0xc0,
+	0x6f, 0x15, 0x30, 0x11, 0xd3, 0x5b, 0xe0, 0x7c, 0x4c, 0xc8, 0x3a, 0x36, 0x37, 0xf5, 0xd2, 0x33,
+	0x6a, 0x4b, 0x88, 0x79, 0x0e, 0x71, 0x1e, 0xce, 0xc5, 0xc7, 0x59, 0xee, 0xac, 0xb0, 0xe7, 0x85,
+	0x5f, 0x81, 0x16, 0xdf, 0x78, 0x7c, 0x98, 0x52, 0x9e, 0x1c, 0xa6, 0x94, 0x3f, 0x0e, 0x53, 0xca,
+	0x47, 0x4f, 0x53, 0x3d, 0x4f, 0x9e, 0xa6, 0x7a, 0x7e, 0x7d, 0x9a, 0xea, 0x79, 0x7f, 0x36, 0x30,
+	0x63, 0x06, 0xed, 0xed, 0xb7, 0x2c, 0xf2, 0x39, 0xb3, 0x3a, 0xc0, 0x7f, 0xfb, 0xba, 0xfa, 0xd7,
+	0x00, 0xa8, 0xa8, 0xe5, 0xf0, 0x70, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of GetBillingHistory items.
+	GetBillingHistory(ctx context.Context, in *QueryGetBillingHistoryRequest, opts ...grpc.CallOption) (*QueryGetBillingHistoryResponse, error)
+	// Queries a list of GetValidatorPayoutHistory items.
+	GetValidatorPayoutHistory(ctx context.Context, in *QueryGetValidatorPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryGetValidatorPayoutHistoryResponse, error)
+	// Queries the debt and grace period state of a chainlet.
+	GetChainletDebt(ctx context.Context, in *QueryGetChainletDebtRequest, opts ...grpc.CallOption) (*QueryGetChainletDebtResponse, error)
+	// Queries how many epochs the escrow and billing credits of a chainlet still
+	// pay for.
+	Runway(ctx context.Context, in *QueryRunwayRequest, opts ...grpc.CallOption) (*QueryRunwayResponse, error)
+	// Queries the revenue share of a chainlet stack and the revenue paid out.
+	StackRevenue(ctx context.Context, in *QueryStackRevenueRequest, opts ...grpc.CallOption) (*QueryStackRevenueResponse, error)
+	// Queries the rewards a validator can claim.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// Queries the billing credits not used up yet, optionally of a chainlet or
+	// a launcher only.
+	BillingCredits(ctx context.Context, in *QueryBillingCreditsRequest, opts ...grpc.CallOption) (*QueryBillingCreditsResponse, error)
+	// Simulates the billing of chainlets at the start of the next billing
+	// epoch, optionally of a chainlet or a launcher only.
+	SimulateNextBilling(ctx context.Context, in *QuerySimulateNextBillingRequest, opts ...grpc.CallOption) (*QuerySimulateNextBillingResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBillingHistory(ctx context.Context, in *QueryGetBillingHistoryRequest, opts ...grpc.CallOption) (*QueryGetBillingHistoryResponse, error) {
+	out := new(QueryGetBillingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/GetBillingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetValidatorPayoutHistory(ctx context.Context, in *QueryGetValidatorPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryGetValidatorPayoutHistoryResponse, error) {
+	out := new(QueryGetValidatorPayoutHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/GetValidatorPayoutHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetChainletDebt(ctx context.Context, in *QueryGetChainletDebtRequest, opts ...grpc.CallOption) (*QueryGetChainletDebtResponse, error) {
+	out := new(QueryGetChainletDebtResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/GetChainletDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Runway(ctx context.Context, in *QueryRunwayRequest, opts ...grpc.CallOption) (*QueryRunwayResponse, error) {
+	out := new(QueryRunwayResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/Runway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StackRevenue(ctx context.Context, in *QueryStackRevenueRequest, opts ...grpc.CallOption) (*QueryStackRevenueResponse, error) {
+	out := new(QueryStackRevenueResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/StackRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BillingCredits(ctx context.Context, in *QueryBillingCreditsRequest, opts ...grpc.CallOption) (*QueryBillingCreditsResponse, error) {
+	out := new(QueryBillingCreditsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/BillingCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateNextBilling(ctx context.Context, in *QuerySimulateNextBillingRequest, opts ...grpc.CallOption) (*QuerySimulateNextBillingResponse, error) {
+	out := new(QuerySimulateNextBillingResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Query/SimulateNextBilling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of GetBillingHistory items.
+	GetBillingHistory(context.Context, *QueryGetBillingHistoryRequest) (*QueryGetBillingHistoryResponse, error)
+	// Queries a list of GetValidatorPayoutHistory items.
+	GetValidatorPayoutHistory(context.Context, *QueryGetValidatorPayoutHistoryRequest) (*QueryGetValidatorPayoutHistoryResponse, error)
+	// Queries the debt and grace period state of a chainlet.
+	GetChainletDebt(context.Context, *QueryGetChainletDebtRequest) (*QueryGetChainletDebtResponse, error)
+	// Queries how many epochs the escrow and billing credits of a chainlet still
+	// pay for.
+	Runway(context.Context, *QueryRunwayRequest) (*QueryRunwayResponse, error)
+	// Queries the revenue share of a chainlet stack and the revenue paid out.
+	StackRevenue(context.Context, *QueryStackRevenueRequest) (*QueryStackRevenueResponse, error)
+	// Queries the rewards a validator can claim.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// Queries the billing credits not used up yet, optionally of a chainlet or
+	// a launcher only.
+	BillingCredits(context.Context, *QueryBillingCreditsRequest) (*QueryBillingCreditsResponse, error)
+	// Simulates the billing of chainlets at the start of the next billing
+	// epoch, optionally of a chainlet or a launcher only.
+	SimulateNextBilling(context.Context, *QuerySimulateNextBillingRequest) (*QuerySimulateNextBillingResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetBillingHistory(ctx context.Context, req *QueryGetBillingHistoryRequest) (*QueryGetBillingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBillingHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetValidatorPayoutHistory(ctx context.Context, req *QueryGetValidatorPayoutHistoryRequest) (*QueryGetValidatorPayoutHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPayoutHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetChainletDebt(ctx context.Context, req *QueryGetChainletDebtRequest) (*QueryGetChainletDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainletDebt not implemented")
+}
+func (*UnimplementedQueryServer) Runway(ctx context.Context, req *QueryRunwayRequest) (*QueryRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Runway not implemented")
+}
+func (*UnimplementedQueryServer) StackRevenue(ctx context.Context, req *QueryStackRevenueRequest) (*QueryStackRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StackRevenue not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) BillingCredits(ctx context.Context, req *QueryBillingCreditsRequest) (*QueryBillingCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillingCredits not implemented")
+}
+func (*UnimplementedQueryServer) SimulateNextBilling(ctx context.Context, req *QuerySimulateNextBillingRequest) (*QuerySimulateNextBillingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateNextBilling not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBillingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBillingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBillingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/GetBillingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBillingHistory(ctx, req.(*QueryGetBillingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetValidatorPayoutHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorPayoutHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetValidatorPayoutHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/GetValidatorPayoutHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValidatorPayoutHistory(ctx, req.(*QueryGetValidatorPayoutHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetChainletDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainletDebtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainletDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/GetChainletDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainletDebt(ctx, req.(*QueryGetChainletDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Runway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Runway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/Runway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Runway(ctx, req.(*QueryRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StackRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStackRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StackRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/StackRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StackRevenue(ctx, req.(*QueryStackRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BillingCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBillingCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BillingCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/BillingCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BillingCredits(ctx, req.(*QueryBillingCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateNextBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateNextBillingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateNextBilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Query/SimulateNextBilling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateNextBilling(ctx, req.(*QuerySimulateNextBillingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ssc.billing.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetBillingHistory",
+			Handler:    _Query_GetBillingHistory_Handler,
+		},
+		{
+			MethodName: "GetValidatorPayoutHistory",
+			Handler:    _Query_GetValidatorPayoutHistory_Handler,
+		},
+		{
+			MethodName: "GetChainletDebt",
+			Handler:    _Query_GetChainletDebt_Handler,
+		},
+		{
+			MethodName: "Runway",
+			Handler:    _Query_Runway_Handler,
+		},
+		{
+			MethodName: "StackRevenue",
+			Handler:    _Query_StackRevenue_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "BillingCredits",
+			Handler:    _Query_BillingCredits_Handler,
+		},
+		{
+			MethodName: "SimulateNextBilling",
+			Handler:    _Query_SimulateNextBilling_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ssc/billing/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBillingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBillingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBillingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBillingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBillingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBillingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Billhistory) > 0 {
+		for iNdEx := len(m.Billhistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Billhistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorPayoutHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorPayoutHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorPayoutHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validatorpayouthistory) > 0 {
+		for iNdEx := len(m.Validatorpayouthistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validatorpayouthistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainletDebtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainletDebtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainletDebtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainletDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainletDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainletDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountsDue) > 0 {
+		for iNdEx := len(m.AmountsDue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AmountsDue[iNdEx])
+			copy(dAtA[i:], m.AmountsDue[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountsDue[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GraceEpochsLeft != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GraceEpochsLeft))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Debt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunwayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunwayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunwayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunwayOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunwayOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunwayOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedStopTime) > 0 {
+		i -= len(m.ProjectedStopTime)
+		copy(dAtA[i:], m.ProjectedStopTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectedStopTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EpochsLeft != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochsLeft))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochFee) > 0 {
+		i -= len(m.EpochFee)
+		copy(dAtA[i:], m.EpochFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunwayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunwayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunwayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedStopTime) > 0 {
+		i -= len(m.ProjectedStopTime)
+		copy(dAtA[i:], m.ProjectedStopTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectedStopTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalEpochsLeft != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalEpochsLeft))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextFeeOption) > 0 {
+		i -= len(m.NextFeeOption)
+		copy(dAtA[i:], m.NextFeeOption)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextFeeOption)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStackRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStackRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStackRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StackName) > 0 {
+		i -= len(m.StackName)
+		copy(dAtA[i:], m.StackName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StackName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStackRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStackRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStackRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EffectiveShare) > 0 {
+		i -= len(m.EffectiveShare)
+		copy(dAtA[i:], m.EffectiveShare)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EffectiveShare)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Share.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainletBillingReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainletBillingReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainletBillingReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.SufficientFunds {
+		i--
+		if m.SufficientFunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CreditUsed) > 0 {
+		i -= len(m.CreditUsed)
+		copy(dAtA[i:], m.CreditUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreditUsed)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BilledAmount) > 0 {
+		i -= len(m.BilledAmount)
+		copy(dAtA[i:], m.BilledAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BilledAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EpochFee) > 0 {
+		i -= len(m.EpochFee)
+		copy(dAtA[i:], m.EpochFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FeeOption != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeOption))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateNextBillingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateNextBillingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateNextBillingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateNextBillingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateNextBillingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateNextBillingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBillingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	if m.Result != 0 {
+		n += 1 + sovQuery(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *QueryGetBillingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Billhistory) > 0 {
+		for _, e := range m.Billhistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorPayoutHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	if m.Result != 0 {
+		n += 1 + sovQuery(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorPayoutHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Validatorpayouthistory) > 0 {
-		for iNdEx := len(m.Validatorpayouthistory) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validatorpayouthistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+		for _, e := range m.Validatorpayouthistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainletDebtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainletDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Debt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GraceEpochsLeft != 0 {
+		n += 1 + sovQuery(uint64(m.GraceEpochsLeft))
+	}
+	if len(m.AmountsDue) > 0 {
+		for _, s := range m.AmountsDue {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRunwayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RunwayOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EpochFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochsLeft != 0 {
+		n += 1 + sovQuery(uint64(m.EpochsLeft))
+	}
+	l = len(m.ProjectedStopTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRunwayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.NextFeeOption)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalEpochsLeft != 0 {
+		n += 1 + sovQuery(uint64(m.TotalEpochsLeft))
+	}
+	l = len(m.ProjectedStopTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStackRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StackName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStackRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Share.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.EffectiveShare)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBillingCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBillingCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainletBillingReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovQuery(uint64(m.Outcome))
+	}
+	if m.FeeOption != 0 {
+		n += 1 + sovQuery(uint64(m.FeeOption))
+	}
+	l = len(m.EpochFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BilledAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CreditUsed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SufficientFunds {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateNextBillingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateNextBillingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBillingHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBillingHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBillingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResultFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBillingHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBillingHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBillingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Billhistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Billhistory = append(m.Billhistory, &BillingHistory{})
+			if err := m.Billhistory[len(m.Billhistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResultFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validatorpayouthistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validatorpayouthistory = append(m.Validatorpayouthistory, &ValidatorPayoutHistory{})
+			if err := m.Validatorpayouthistory[len(m.Validatorpayouthistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainletDebtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainletDebtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainletDebtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainletDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainletDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainletDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Debt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceEpochsLeft", wireType)
+			}
+			m.GraceEpochsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GraceEpochsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsDue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountsDue = append(m.AmountsDue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRunwayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRunwayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRunwayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunwayOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunwayOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunwayOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsLeft", wireType)
+			}
+			m.EpochsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedStopTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedStopTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRunwayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRunwayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRunwayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, RunwayOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFeeOption", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextFeeOption = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEpochsLeft", wireType)
+			}
+			m.TotalEpochsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEpochsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedStopTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedStopTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStackRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStackRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStackRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStackRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStackRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStackRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetBillingHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetBillingHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Billhistory) > 0 {
-		for _, e := range m.Billhistory {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetValidatorPayoutHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGetValidatorPayoutHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validatorpayouthistory) > 0 {
-		for _, e := range m.Validatorpayouthistory {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingCreditsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -903,12 +5073,112 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBillingCreditsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBillingCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -930,7 +5200,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -949,19 +5219,53 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBillingCreditsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBillingCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, BillingCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -988,7 +5292,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1013,7 +5320,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBillingHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainletBillingReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,10 +5343,10 @@ func (m *QueryGetBillingHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBillingHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainletBillingReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBillingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainletBillingReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1076,9 +5383,9 @@ func (m *QueryGetBillingHistoryRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1088,83 +5395,99 @@ func (m *QueryGetBillingHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= BillingOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeOption", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.FeeOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeOption |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFee", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetBillingHistoryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBillingHistoryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBillingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Billhistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BilledAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1174,31 +5497,29 @@ func (m *QueryGetBillingHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Billhistory = append(m.Billhistory, &BillingHistory{})
-			if err := m.Billhistory[len(m.Billhistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BilledAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditUsed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1208,27 +5529,75 @@ func (m *QueryGetBillingHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.CreditUsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SufficientFunds", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SufficientFunds = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1251,7 +5620,7 @@ func (m *QueryGetBillingHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateNextBillingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,15 +5643,15 @@ func (m *QueryGetValidatorPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateNextBillingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateNextBillingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,13 +5679,13 @@ func (m *QueryGetValidatorPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1326,27 +5695,23 @@ func (m *QueryGetValidatorPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1369,7 +5734,7 @@ func (m *QueryGetValidatorPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateNextBillingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,17 +5757,17 @@ func (m *QueryGetValidatorPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateNextBillingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorPayoutHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateNextBillingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validatorpayouthistory", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var msglen int
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1412,29 +5777,14 @@ func (m *QueryGetValidatorPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validatorpayouthistory = append(m.Validatorpayouthistory, &ValidatorPayoutHistory{})
-			if err := m.Validatorpayouthistory[len(m.Validatorpayouthistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1461,10 +5811,8 @@ func (m *QueryGetValidatorPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reports = append(m.Reports, ChainletBillingReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ssc/billing/stack_revenue.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StackRevenueShare is the part of the epoch fees of a chainlet stack paid to
+// its creator, set by the creator
+type StackRevenueShare struct {
+	StackName string `protobuf:"bytes,1,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	// Fraction of the billed fees, capped by the max_stack_revenue_share param
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+	// Address receiving the share, the stack creator if empty
+	PayoutAddress string `protobuf:"bytes,3,opt,name=payoutAddress,proto3" json:"payoutAddress,omitempty"`
+}
+
+func (m *StackRevenueShare) Reset()         { *m = StackRevenueShare{} }
+func (m *StackRevenueShare) String() string { return proto.CompactTextString(m) }
+func (*StackRevenueShare) ProtoMessage()    {}
+func (*StackRevenueShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94a31c64bb4aaed9, []int{0}
+}
+func (m *StackRevenueShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StackRevenueShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StackRevenueShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StackRevenueShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackRevenueShare.Merge(m, src)
+}
+func (m *StackRevenueShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *StackRevenueShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackRevenueShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackRevenueShare proto.InternalMessageInfo
+
+func (m *StackRevenueShare) GetStackName() string {
+	if m != nil {
+		return m.StackName
+	}
+	return ""
+}
+
+func (m *StackRevenueShare) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+// StackRevenue is the revenue paid out for a chainlet stack so far
+type StackRevenue struct {
+	StackName string                                   `protobuf:"bytes,1,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	Total     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *StackRevenue) Reset()         { *m = StackRevenue{} }
+func (m *StackRevenue) String() string { return proto.CompactTextString(m) }
+func (*StackRevenue) ProtoMessage()    {}
+func (*StackRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94a31c64bb4aaed9, []int{1}
+}
+func (m *StackRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StackRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StackRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StackRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackRevenue.Merge(m, src)
+}
+func (m *StackRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *StackRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackRevenue proto.InternalMessageInfo
+
+func (m *StackRevenue) GetStackName() string {
+	if m != nil {
+		return m.StackName
+	}
+	return ""
+}
+
+func (m *StackRevenue) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StackRevenueShare)(nil), "ssc.billing.StackRevenueShare")
+	proto.RegisterType((*StackRevenue)(nil), "ssc.billing.StackRevenue")
+}
+
+func init() { proto.RegisterFile("ssc/billing/stack_revenue.proto", fileDescriptor_94a31c64bb4aaed9) }
+
+var fileDescriptor_94a31c64bb4aaed9 = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x6d, 0x21, 0xbc, 0x84, 0xe1, 0xb9, 0xb0, 0x71, 0x51, 0xd0, 0xb4, 0x84, 0x18, 0xc3, 0x86,
+	0x19, 0xd1, 0x1f, 0x50, 0x24, 0x71, 0x63, 0x5c, 0x94, 0x9d, 0x1b, 0x32, 0x9d, 0x4e, 0x4a, 0x03,
+	0xed, 0x90, 0xde, 0x81, 0x50, 0x7f, 0x42, 0x3f, 0xc1, 0xb5, 0x6b, 0x3f, 0x82, 0x25, 0x71, 0x65,
+	0x5c, 0xa0, 0x81, 0x1f, 0x31, 0xed, 0x4c, 0x14, 0x57, 0xae, 0x66, 0xee, 0x3d, 0xf7, 0x9e, 0x73,
+	0x72, 0x2e, 0x72, 0x01, 0x18, 0xf1, 0xa3, 0xc9, 0x24, 0x4a, 0x42, 0x02, 0x92, 0xb2, 0xf1, 0x30,
+	0xe5, 0x73, 0x9e, 0xcc, 0x38, 0x9e, 0xa6, 0x42, 0x0a, 0xab, 0x06, 0xc0, 0xb0, 0x1e, 0x68, 0x1c,
+	0x84, 0x22, 0x14, 0x45, 0x9f, 0xe4, 0x3f, 0x35, 0xd2, 0xa8, 0x33, 0x01, 0xb1, 0x80, 0xa1, 0x02,
+	0x54, 0xa1, 0x21, 0x47, 0x55, 0xc4, 0xa7, 0xc0, 0xc9, 0xbc, 0xeb, 0x73, 0x49, 0xbb, 0x84, 0x89,
+	0x28, 0x51, 0x78, 0xeb, 0xc9, 0x44, 0xfb, 0x83, 0x5c, 0xd5, 0x53, 0xa2, 0x83, 0x11, 0x4d, 0xb9,
+	0x75, 0x84, 0xaa, 0x85, 0x95, 0x5b, 0x1a, 0x73, 0xdb, 0x6c, 0x9a, 0xed, 0xaa, 0xf7, 0xd3, 0xb0,
+	0xae, 0x51, 0x05, 0xf2, 0x31, 0xbb, 0x94, 0x23, 0xbd, 0xee, 0x72, 0xed, 0x1a, 0xef, 0x6b, 0xf7,
+	0x50, 0x49, 0x41, 0x30, 0xc6, 0x91, 0x20, 0x31, 0x95, 0x23, 0x7c, 0xc3, 0x43, 0xca, 0xb2, 0x3e,
+	0x67, 0xaf, 0x2f, 0x1d, 0xa4, 0x7d, 0xf5, 0x39, 0xf3, 0xd4, 0xbe, 0x75, 0x8c, 0xf6, 0xa6, 0x34,
+	0x13, 0x33, 0x79, 0x19, 0x04, 0x29, 0x07, 0xb0, 0xcb, 0x85, 0xd4, 0xef, 0x66, 0xeb, 0xc1, 0x44,
+	0xff, 0x77, 0x2d, 0xfe, 0xe1, 0x8e, 0xa2, 0x8a, 0x14, 0x92, 0x4e, 0xec, 0x52, 0xb3, 0xdc, 0xae,
+	0x9d, 0xd5, 0xb1, 0xd6, 0xcd, 0x13, 0xc0, 0x3a, 0x01, 0x7c, 0x25, 0xa2, 0xa4, 0x77, 0x9a, 0x1b,
+	0x7f, 0xfe, 0x70, 0xdb, 0x61, 0x24, 0x47, 0x33, 0x1f, 0x33, 0x11, 0xeb, 0xf0, 0xf4, 0xd3, 0x81,
+	0x60, 0x4c, 0x64, 0x36, 0xe5, 0x50, 0x2c, 0x80, 0xa7, 0x98, 0x7b, 0x17, 0xcb, 0x8d, 0x63, 0xae,
+	0x36, 0x8e, 0xf9, 0xb9, 0x71, 0xcc, 0xc7, 0xad, 0x63, 0xac, 0xb6, 0x8e, 0xf1, 0xb6, 0x75, 0x8c,
+	0xbb, 0x93, 0x1d, 0x2a, 0xa0, 0x21, 0x5d, 0x64, 0xf7, 0x24, 0x3f, 0xf0, 0xe2, 0xfb, 0xc4, 0x05,
+	0x9d, 0xff, 0xaf, 0x48, 0xff, 0xfc, 0x6b, 0x00, 0x8e, 0x5a, 0xfb, 0x2d, 0xfe, 0x01, 0x00, 0x00,
+}
+
+func (m *StackRevenueShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StackRevenueShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StackRevenueShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintStackRevenue(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStackRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StackName) > 0 {
+		i -= len(m.StackName)
+		copy(dAtA[i:], m.StackName)
+		i = encodeVarintStackRevenue(dAtA, i, uint64(len(m.StackName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StackRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StackRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StackRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStackRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StackName) > 0 {
+		i -= len(m.StackName)
+		copy(dAtA[i:], m.StackName)
+		i = encodeVarintStackRevenue(dAtA, i, uint64(len(m.StackName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStackRevenue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStackRevenue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StackRevenueShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StackName)
+	if l > 0 {
+		n += 1 + l + sovStackRevenue(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovStackRevenue(uint64(l))
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovStackRevenue(uint64(l))
+	}
+	return n
+}
+
+func (m *StackRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StackName)
+	if l > 0 {
+		n += 1 + l + sovStackRevenue(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovStackRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStackRevenue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStackRevenue(x uint64) (n int) {
+	return sovStackRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StackRevenueShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStackRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StackRevenueShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StackRevenueShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStackRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StackRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStackRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StackRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StackRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStackRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStackRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStackRevenue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStackRevenue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStackRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStackRevenue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStackRevenue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStackRevenue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStackRevenue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStackRevenue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStackRevenue = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,21 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -116,224 +123,3297 @@ func (m *MsgSetPlatformValidatorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetPlatformValidatorsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetPlatformValidators)(nil), "ssc.billing.MsgSetPlatformValidators")
-	proto.RegisterType((*MsgSetPlatformValidatorsResponse)(nil), "ssc.billing.MsgSetPlatformValidatorsResponse")
+type MsgSetLowBalanceThreshold struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// Runway in epochs, zero removes the threshold
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func init() { proto.RegisterFile("ssc/billing/tx.proto", fileDescriptor_5648eef8735b4c01) }
+func (m *MsgSetLowBalanceThreshold) Reset()         { *m = MsgSetLowBalanceThreshold{} }
+func (m *MsgSetLowBalanceThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLowBalanceThreshold) ProtoMessage()    {}
+func (*MsgSetLowBalanceThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{2}
+}
+func (m *MsgSetLowBalanceThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLowBalanceThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLowBalanceThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLowBalanceThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLowBalanceThreshold.Merge(m, src)
+}
+func (m *MsgSetLowBalanceThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLowBalanceThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLowBalanceThreshold.DiscardUnknown(m)
+}
 
-var fileDescriptor_5648eef8735b4c01 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x2e, 0x4e, 0xd6,
-	0x4f, 0xca, 0xcc, 0xc9, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x2e, 0x2e, 0x4e, 0xd6, 0x83, 0x8a, 0x4a, 0x89, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17,
-	0xeb, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82, 0x28, 0x88, 0x2a, 0xa5, 0x62, 0x2e, 0x09, 0xdf,
-	0xe2, 0xf4, 0xe0, 0xd4, 0x92, 0x80, 0x9c, 0xc4, 0x92, 0xb4, 0xfc, 0xa2, 0xdc, 0xb0, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0x62, 0x21, 0x09, 0x2e, 0xf6, 0xe4, 0xa2, 0x54, 0x10, 0x5b,
-	0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15, 0xd2, 0xe7, 0x12, 0x2e, 0x80, 0xaa, 0x8f,
-	0x2f, 0x83, 0x6b, 0x90, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x0c, 0x12, 0x2a, 0xc0, 0x30, 0xca, 0x8a,
-	0xa7, 0xe9, 0xf9, 0x06, 0x2d, 0x98, 0x76, 0x25, 0x25, 0x2e, 0x05, 0x5c, 0x96, 0x06, 0xa5, 0x16,
-	0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x55, 0x73, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0xe5, 0x72, 0x89,
-	0x62, 0x77, 0x9c, 0xaa, 0x1e, 0x92, 0xff, 0xf4, 0x70, 0x19, 0x27, 0xa5, 0x4b, 0x94, 0x32, 0x98,
-	0xad, 0x52, 0xac, 0x0d, 0xcf, 0x37, 0x68, 0x31, 0x3a, 0x39, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x7e, 0x71, 0x62, 0x7a, 0x62, 0x45, 0x65, 0x95, 0x3e, 0x28, 0xf8, 0x2b, 0x10, 0x11, 0x50, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0xf5,
-	0x99, 0x5e, 0x9c, 0x01, 0x00, 0x00,
+var xxx_messageInfo_MsgSetLowBalanceThreshold proto.InternalMessageInfo
+
+func (m *MsgSetLowBalanceThreshold) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetLowBalanceThreshold) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetLowBalanceThreshold) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// this line is used by starport scaffolding # proto/tx/rpc
-	SetPlatformValidators(ctx context.Context, in *MsgSetPlatformValidators, opts ...grpc.CallOption) (*MsgSetPlatformValidatorsResponse, error)
+type MsgSetLowBalanceThresholdResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetLowBalanceThresholdResponse) Reset()         { *m = MsgSetLowBalanceThresholdResponse{} }
+func (m *MsgSetLowBalanceThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLowBalanceThresholdResponse) ProtoMessage()    {}
+func (*MsgSetLowBalanceThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{3}
+}
+func (m *MsgSetLowBalanceThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLowBalanceThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLowBalanceThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLowBalanceThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLowBalanceThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetLowBalanceThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLowBalanceThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLowBalanceThresholdResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetLowBalanceThresholdResponse proto.InternalMessageInfo
+
+type MsgSetStackRevenueShare struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StackName string `protobuf:"bytes,2,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	// Fraction of the billed fees, zero removes the share
+	Share string `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`
+	// Address receiving the share, the stack creator if empty
+	PayoutAddress string `protobuf:"bytes,4,opt,name=payoutAddress,proto3" json:"payoutAddress,omitempty"`
 }
 
-func (c *msgClient) SetPlatformValidators(ctx context.Context, in *MsgSetPlatformValidators, opts ...grpc.CallOption) (*MsgSetPlatformValidatorsResponse, error) {
-	out := new(MsgSetPlatformValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/SetPlatformValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetStackRevenueShare) Reset()         { *m = MsgSetStackRevenueShare{} }
+func (m *MsgSetStackRevenueShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSetStackRevenueShare) ProtoMessage()    {}
+func (*MsgSetStackRevenueShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{4}
+}
+func (m *MsgSetStackRevenueShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetStackRevenueShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetStackRevenueShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetStackRevenueShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetStackRevenueShare.Merge(m, src)
+}
+func (m *MsgSetStackRevenueShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetStackRevenueShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetStackRevenueShare.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// this line is used by starport scaffolding # proto/tx/rpc
-	SetPlatformValidators(context.Context, *MsgSetPlatformValidators) (*MsgSetPlatformValidatorsResponse, error)
+var xxx_messageInfo_MsgSetStackRevenueShare proto.InternalMessageInfo
+
+func (m *MsgSetStackRevenueShare) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetStackRevenueShare) GetStackName() string {
+	if m != nil {
+		return m.StackName
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) SetPlatformValidators(ctx context.Context, req *MsgSetPlatformValidators) (*MsgSetPlatformValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformValidators not implemented")
+func (m *MsgSetStackRevenueShare) GetShare() string {
+	if m != nil {
+		return m.Share
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetStackRevenueShare) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
 }
 
-func _Msg_SetPlatformValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetPlatformValidators)
-	if err := dec(in); err != nil {
-		return nil, err
+type MsgSetStackRevenueShareResponse struct {
+}
+
+func (m *MsgSetStackRevenueShareResponse) Reset()         { *m = MsgSetStackRevenueShareResponse{} }
+func (m *MsgSetStackRevenueShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetStackRevenueShareResponse) ProtoMessage()    {}
+func (*MsgSetStackRevenueShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{5}
+}
+func (m *MsgSetStackRevenueShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetStackRevenueShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetStackRevenueShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetPlatformValidators(ctx, in)
+}
+func (m *MsgSetStackRevenueShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetStackRevenueShareResponse.Merge(m, src)
+}
+func (m *MsgSetStackRevenueShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetStackRevenueShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetStackRevenueShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetStackRevenueShareResponse proto.InternalMessageInfo
+
+type MsgUpdateParams struct {
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.billing.Msg/SetPlatformValidators",
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetPlatformValidators(ctx, req.(*MsgSetPlatformValidators))
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ssc.billing.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetPlatformValidators",
-			Handler:    _Msg_SetPlatformValidators_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ssc/billing/tx.proto",
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgSetPlatformValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetPlatformValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+type MsgClaimBillingRewards struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *MsgSetPlatformValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlatformValidators) > 0 {
-		for iNdEx := len(m.PlatformValidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PlatformValidators[iNdEx])
-			copy(dAtA[i:], m.PlatformValidators[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.PlatformValidators[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgClaimBillingRewards) Reset()         { *m = MsgClaimBillingRewards{} }
+func (m *MsgClaimBillingRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBillingRewards) ProtoMessage()    {}
+func (*MsgClaimBillingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{8}
+}
+func (m *MsgClaimBillingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBillingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBillingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimBillingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBillingRewards.Merge(m, src)
+}
+func (m *MsgClaimBillingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBillingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBillingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimBillingRewards proto.InternalMessageInfo
+
+func (m *MsgClaimBillingRewards) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgClaimBillingRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimBillingRewardsResponse) Reset()         { *m = MsgClaimBillingRewardsResponse{} }
+func (m *MsgClaimBillingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBillingRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimBillingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{9}
+}
+func (m *MsgClaimBillingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBillingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBillingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimBillingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBillingRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimBillingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBillingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBillingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimBillingRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimBillingRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type MsgSetRewardWithdrawAddress struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Address the rewards are claimed to, the creator if empty
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdrawAddress,proto3" json:"withdrawAddress,omitempty"`
+}
+
+func (m *MsgSetRewardWithdrawAddress) Reset()         { *m = MsgSetRewardWithdrawAddress{} }
+func (m *MsgSetRewardWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{10}
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetRewardWithdrawAddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetRewardWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+type MsgSetRewardWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Reset()         { *m = MsgSetRewardWithdrawAddressResponse{} }
+func (m *MsgSetRewardWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{11}
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardWithdrawAddressResponse proto.InternalMessageInfo
+
+type MsgIssueBillingCredit struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Chainlet the credit is issued to, or
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// launcher whose chainlets the credit is issued to
+	Launcher string     `protobuf:"bytes,3,opt,name=launcher,proto3" json:"launcher,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Expiry   time.Time  `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	Memo     string     `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgIssueBillingCredit) Reset()         { *m = MsgIssueBillingCredit{} }
+func (m *MsgIssueBillingCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueBillingCredit) ProtoMessage()    {}
+func (*MsgIssueBillingCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{12}
+}
+func (m *MsgIssueBillingCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueBillingCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueBillingCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueBillingCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueBillingCredit.Merge(m, src)
+}
+func (m *MsgIssueBillingCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueBillingCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueBillingCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueBillingCredit proto.InternalMessageInfo
+
+func (m *MsgIssueBillingCredit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgIssueBillingCredit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgIssueBillingCredit) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+func (m *MsgIssueBillingCredit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgIssueBillingCredit) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *MsgIssueBillingCredit) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type MsgIssueBillingCreditResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgIssueBillingCreditResponse) Reset()         { *m = MsgIssueBillingCreditResponse{} }
+func (m *MsgIssueBillingCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueBillingCreditResponse) ProtoMessage()    {}
+func (*MsgIssueBillingCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{13}
+}
+func (m *MsgIssueBillingCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueBillingCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueBillingCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueBillingCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueBillingCreditResponse.Merge(m, src)
+}
+func (m *MsgIssueBillingCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueBillingCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueBillingCreditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueBillingCreditResponse proto.InternalMessageInfo
+
+func (m *MsgIssueBillingCreditResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgRevokeBillingCredit struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRevokeBillingCredit) Reset()         { *m = MsgRevokeBillingCredit{} }
+func (m *MsgRevokeBillingCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBillingCredit) ProtoMessage()    {}
+func (*MsgRevokeBillingCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{14}
+}
+func (m *MsgRevokeBillingCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBillingCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBillingCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeBillingCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBillingCredit.Merge(m, src)
+}
+func (m *MsgRevokeBillingCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBillingCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBillingCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeBillingCredit proto.InternalMessageInfo
+
+func (m *MsgRevokeBillingCredit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRevokeBillingCredit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgRevokeBillingCreditResponse struct {
+}
+
+func (m *MsgRevokeBillingCreditResponse) Reset()         { *m = MsgRevokeBillingCreditResponse{} }
+func (m *MsgRevokeBillingCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBillingCreditResponse) ProtoMessage()    {}
+func (*MsgRevokeBillingCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5648eef8735b4c01, []int{15}
+}
+func (m *MsgRevokeBillingCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBillingCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBillingCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeBillingCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBillingCreditResponse.Merge(m, src)
+}
+func (m *MsgRevokeBillingCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBillingCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBillingCreditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeBillingCreditResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetPlatformValidators)(nil), "ssc.billing.MsgSetPlatformValidators")
+	proto.RegisterType((*MsgSetPlatformValidatorsResponse)(nil), "ssc.billing.MsgSetPlatformValidatorsResponse")
+	proto.RegisterType((*MsgSetLowBalanceThreshold)(nil), "ssc.billing.MsgSetLowBalanceThreshold")
+	proto.RegisterType((*MsgSetLowBalanceThresholdResponse)(nil), "ssc.billing.MsgSetLowBalanceThresholdResponse")
+	proto.RegisterType((*MsgSetStackRevenueShare)(nil), "ssc.billing.MsgSetStackRevenueShare")
+	proto.RegisterType((*MsgSetStackRevenueShareResponse)(nil), "ssc.billing.MsgSetStackRevenueShareResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ssc.billing.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ssc.billing.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgClaimBillingRewards)(nil), "ssc.billing.MsgClaimBillingRewards")
+	proto.RegisterType((*MsgClaimBillingRewardsResponse)(nil), "ssc.billing.MsgClaimBillingRewardsResponse")
+	proto.RegisterType((*MsgSetRewardWithdrawAddress)(nil), "ssc.billing.MsgSetRewardWithdrawAddress")
+	proto.RegisterType((*MsgSetRewardWithdrawAddressResponse)(nil), "ssc.billing.MsgSetRewardWithdrawAddressResponse")
+	proto.RegisterType((*MsgIssueBillingCredit)(nil), "ssc.billing.MsgIssueBillingCredit")
+	proto.RegisterType((*MsgIssueBillingCreditResponse)(nil), "ssc.billing.MsgIssueBillingCreditResponse")
+	proto.RegisterType((*MsgRevokeBillingCredit)(nil), "ssc.billing.MsgRevokeBillingCredit")
+	proto.RegisterType((*MsgRevokeBillingCreditResponse)(nil), "ssc.billing.MsgRevokeBillingCreditResponse")
+}
+
+func init() { proto.RegisterFile("ssc/billing/tx.proto", fileDescriptor_5648eef8735b4c01) }
+
+var fileDescriptor_5648eef8735b4c01 = []byte{
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x25, 0x59, 0x8d, 0xc6, 0x69, 0x02, 0xd0, 0x4a, 0x4c, 0xb3, 0x29, 0xa5, 0xd0, 0x4e,
+	0x20, 0xd8, 0x0d, 0x19, 0xbb, 0x87, 0x02, 0x41, 0x0f, 0xa9, 0x7c, 0x0a, 0x50, 0x15, 0x01, 0x9d,
+	0xa6, 0x40, 0x2f, 0xc5, 0x8a, 0xdc, 0x90, 0xac, 0x49, 0x2e, 0xc1, 0x5d, 0xea, 0xa3, 0xbd, 0x14,
+	0x05, 0xda, 0x73, 0xee, 0xfd, 0x07, 0x3d, 0xe5, 0x67, 0xe4, 0x98, 0x63, 0x2f, 0x6d, 0x0a, 0xfb,
+	0x90, 0xbf, 0x51, 0x90, 0x5c, 0x52, 0x12, 0x45, 0xc9, 0xea, 0x49, 0xfb, 0xf1, 0xe6, 0xcd, 0xdb,
+	0xd1, 0xcc, 0x03, 0xa1, 0x4d, 0xa9, 0xa9, 0x0f, 0x5d, 0xcf, 0x73, 0x03, 0x5b, 0x67, 0x13, 0x2d,
+	0x8c, 0x08, 0x23, 0xe2, 0x0e, 0xa5, 0xa6, 0xc6, 0x4f, 0xe5, 0x3d, 0x93, 0x50, 0x9f, 0x50, 0xdd,
+	0xa7, 0xb6, 0x3e, 0x3a, 0x49, 0x7e, 0x32, 0x94, 0x2c, 0xcd, 0xc7, 0x86, 0x28, 0x42, 0x3e, 0xe5,
+	0x37, 0x6d, 0x9b, 0xd8, 0x24, 0x5d, 0xea, 0xc9, 0x8a, 0x9f, 0x2a, 0x9c, 0x68, 0x88, 0x28, 0xd6,
+	0x47, 0x27, 0x43, 0xcc, 0xd0, 0x89, 0x6e, 0x12, 0x37, 0xe0, 0xf7, 0x1d, 0x9b, 0x10, 0xdb, 0xc3,
+	0x7a, 0xba, 0x1b, 0xc6, 0xaf, 0x74, 0xe6, 0xfa, 0x98, 0x32, 0xe4, 0x87, 0x19, 0x40, 0xa5, 0x20,
+	0x0d, 0xa8, 0x7d, 0x8e, 0xd9, 0x73, 0x0f, 0xb1, 0x57, 0x24, 0xf2, 0x5f, 0x22, 0xcf, 0xb5, 0x10,
+	0x23, 0x11, 0x15, 0x25, 0xf8, 0xc8, 0x8c, 0x70, 0xb2, 0x96, 0x84, 0xae, 0xd0, 0x6b, 0x19, 0xf9,
+	0x56, 0xd4, 0x61, 0x37, 0xe4, 0xf8, 0x1f, 0x46, 0x45, 0x80, 0x54, 0xeb, 0xd6, 0x7b, 0x2d, 0x43,
+	0x0c, 0x97, 0xa8, 0x9e, 0xdc, 0xfc, 0xf5, 0xc3, 0x9b, 0xa3, 0x3c, 0x5c, 0x55, 0xa1, 0xbb, 0x2a,
+	0xa9, 0x81, 0x69, 0x48, 0x02, 0x8a, 0xd5, 0x9f, 0x61, 0x3f, 0xc3, 0x7c, 0x4d, 0xc6, 0x7d, 0xe4,
+	0xa1, 0xc0, 0xc4, 0x2f, 0x9c, 0x08, 0x53, 0x87, 0x78, 0xd6, 0x1a, 0x65, 0xc9, 0x8d, 0x83, 0xdc,
+	0xe0, 0x99, 0x25, 0xd5, 0xf8, 0x4d, 0xb6, 0x15, 0xef, 0x41, 0x8b, 0xe5, 0x04, 0x52, 0xbd, 0x2b,
+	0xf4, 0x1a, 0xc6, 0xec, 0xa0, 0x24, 0xf0, 0x00, 0xee, 0xaf, 0x4c, 0x5e, 0x28, 0xfc, 0x43, 0x80,
+	0xbd, 0x0c, 0x75, 0xce, 0x90, 0x79, 0x61, 0xe0, 0x11, 0x0e, 0x62, 0x7c, 0xee, 0xa0, 0x08, 0xaf,
+	0x11, 0x78, 0x0f, 0x5a, 0x34, 0x81, 0x7f, 0x83, 0x7c, 0xcc, 0x25, 0xce, 0x0e, 0xc4, 0x36, 0x6c,
+	0xd3, 0x84, 0x20, 0x15, 0xd8, 0x32, 0xb2, 0x8d, 0x78, 0x08, 0x1f, 0x87, 0x68, 0x4a, 0x62, 0xf6,
+	0x95, 0x65, 0x45, 0x98, 0x52, 0xa9, 0x91, 0xde, 0x2e, 0x1e, 0x96, 0x9e, 0x70, 0x1f, 0x3a, 0x2b,
+	0xc4, 0x15, 0x0f, 0xf0, 0xe0, 0xf6, 0x80, 0xda, 0xdf, 0x86, 0x16, 0x62, 0xf8, 0x79, 0xda, 0x6b,
+	0x89, 0x3a, 0x14, 0x33, 0x87, 0x44, 0x2e, 0x9b, 0x72, 0xe5, 0xb3, 0x03, 0xf1, 0x18, 0x9a, 0x59,
+	0x4f, 0xa6, 0xc2, 0x77, 0x4e, 0x77, 0xb5, 0xb9, 0xa6, 0xd6, 0x32, 0x0a, 0x83, 0x43, 0x9e, 0xdc,
+	0x4a, 0xe4, 0xcc, 0x82, 0xd5, 0x7d, 0xd8, 0x2b, 0x65, 0x2b, 0x84, 0x3c, 0x85, 0xbb, 0x03, 0x6a,
+	0x9f, 0x79, 0xc8, 0xf5, 0xfb, 0x19, 0x99, 0x81, 0xc7, 0x28, 0xb2, 0xd6, 0xb4, 0x60, 0xe9, 0xb5,
+	0xbf, 0x09, 0xa0, 0x54, 0x53, 0xe4, 0x49, 0x44, 0x13, 0x9a, 0xc8, 0x27, 0x71, 0xc0, 0x24, 0xa1,
+	0x5b, 0xef, 0xed, 0x9c, 0xee, 0x6b, 0xd9, 0xec, 0x68, 0xc9, 0xec, 0x68, 0x7c, 0x76, 0xb4, 0x33,
+	0xe2, 0x06, 0xfd, 0xc7, 0x6f, 0xff, 0xe9, 0x6c, 0xfd, 0xf9, 0xbe, 0xd3, 0xb3, 0x5d, 0xe6, 0xc4,
+	0x43, 0xcd, 0x24, 0xbe, 0xce, 0x07, 0x2d, 0xfb, 0x79, 0x44, 0xad, 0x0b, 0x9d, 0x4d, 0x43, 0x4c,
+	0xd3, 0x00, 0x6a, 0x70, 0x6a, 0x95, 0xc0, 0x27, 0x59, 0xd5, 0xb3, 0xec, 0xdf, 0xb9, 0xcc, 0xb1,
+	0x22, 0x34, 0xe6, 0x7f, 0xd1, 0x9a, 0xb6, 0xe8, 0xc1, 0xed, 0xf1, 0x22, 0x98, 0x37, 0x47, 0xf9,
+	0xb8, 0xf4, 0xf0, 0x07, 0x70, 0xb0, 0x26, 0x61, 0x51, 0xe1, 0xdf, 0x6b, 0x70, 0x67, 0x40, 0xed,
+	0x67, 0x94, 0xc6, 0x98, 0xd7, 0xe7, 0x2c, 0xc2, 0x96, 0xcb, 0xae, 0xf9, 0xc7, 0x57, 0x8f, 0x93,
+	0x0c, 0x37, 0x3c, 0x14, 0x07, 0xa6, 0x83, 0x23, 0xde, 0xac, 0xc5, 0x5e, 0xfc, 0xa2, 0x28, 0x75,
+	0xa3, 0x2b, 0xac, 0x2f, 0x75, 0x23, 0x29, 0x75, 0x5e, 0x3e, 0xf1, 0x4b, 0x68, 0xe2, 0x49, 0xe8,
+	0x46, 0x53, 0x69, 0x3b, 0x0d, 0x94, 0xb5, 0xcc, 0xbf, 0xb4, 0xdc, 0xbf, 0xb4, 0x17, 0xb9, 0x7f,
+	0xf5, 0x6f, 0x24, 0x91, 0xaf, 0xdf, 0x77, 0x04, 0x83, 0xc7, 0x88, 0x22, 0x34, 0x7c, 0xec, 0x13,
+	0xa9, 0x99, 0xca, 0x49, 0xd7, 0x4b, 0x5d, 0xa8, 0xc3, 0xa7, 0x95, 0x75, 0x28, 0xda, 0xe4, 0x16,
+	0xd4, 0x5c, 0x2b, 0x2d, 0x44, 0xc3, 0xa8, 0xb9, 0x96, 0xfa, 0x32, 0xed, 0x4d, 0x03, 0x8f, 0xc8,
+	0xc5, 0xff, 0xaa, 0x5c, 0xc6, 0x53, 0xcb, 0x79, 0x96, 0x84, 0x74, 0x41, 0xa9, 0xe6, 0xcd, 0x95,
+	0x9c, 0xfe, 0xdd, 0x84, 0xfa, 0x80, 0xda, 0xa2, 0x0f, 0x77, 0xaa, 0xfd, 0xf9, 0xc1, 0xc2, 0xf8,
+	0xad, 0x72, 0x54, 0xf9, 0xd1, 0x46, 0xb0, 0xa2, 0x00, 0x21, 0xdc, 0x5d, 0xe1, 0xba, 0x0f, 0x2b,
+	0x88, 0x2a, 0x70, 0xb2, 0xb6, 0x19, 0xae, 0xc8, 0xf8, 0x23, 0xb4, 0x2b, 0x4d, 0xf4, 0xb0, 0x82,
+	0x67, 0x09, 0x25, 0x7f, 0xb6, 0x09, 0xaa, 0xc8, 0x65, 0xc0, 0xcd, 0x45, 0xc3, 0x2b, 0x47, 0xcf,
+	0xdf, 0xca, 0x87, 0xeb, 0x6e, 0x0b, 0x4e, 0x1b, 0x76, 0xab, 0xbc, 0xeb, 0xa0, 0x1c, 0x5c, 0x01,
+	0x92, 0x8f, 0x37, 0x00, 0x15, 0x89, 0x46, 0x20, 0xad, 0xb4, 0x96, 0x5e, 0x45, 0x19, 0x2a, 0x91,
+	0xf2, 0xe3, 0x4d, 0x91, 0x45, 0x5e, 0x0b, 0xc4, 0x0a, 0xe7, 0x50, 0xcb, 0x3c, 0xcb, 0x18, 0xf9,
+	0xe8, 0x7a, 0xcc, 0x7c, 0x19, 0xab, 0xc6, 0x6c, 0xa9, 0x8c, 0x15, 0x20, 0xf9, 0x78, 0x03, 0x50,
+	0x9e, 0x48, 0xde, 0xfe, 0xe5, 0xc3, 0x9b, 0x23, 0xa1, 0xff, 0xf4, 0xed, 0xa5, 0x22, 0xbc, 0xbb,
+	0x54, 0x84, 0x7f, 0x2f, 0x15, 0xe1, 0xf5, 0x95, 0xb2, 0xf5, 0xee, 0x4a, 0xd9, 0xfa, 0xeb, 0x4a,
+	0xd9, 0xfa, 0xfe, 0xe1, 0x9c, 0xef, 0x53, 0x64, 0xa3, 0xc9, 0xf4, 0x27, 0x3d, 0xf9, 0x30, 0x9b,
+	0xcc, 0x3e, 0xeb, 0x12, 0xef, 0x1f, 0x36, 0x53, 0x5b, 0xfa, 0xfc, 0xbf, 0x01, 0x00, 0xb3, 0x7b,
+	0xae, 0xc7, 0xf2, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// this line is used by starport scaffolding # proto/tx/rpc
+	SetPlatformValidators(ctx context.Context, in *MsgSetPlatformValidators, opts ...grpc.CallOption) (*MsgSetPlatformValidatorsResponse, error)
+	SetLowBalanceThreshold(ctx context.Context, in *MsgSetLowBalanceThreshold, opts ...grpc.CallOption) (*MsgSetLowBalanceThresholdResponse, error)
+	SetStackRevenueShare(ctx context.Context, in *MsgSetStackRevenueShare, opts ...grpc.CallOption) (*MsgSetStackRevenueShareResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ClaimBillingRewards(ctx context.Context, in *MsgClaimBillingRewards, opts ...grpc.CallOption) (*MsgClaimBillingRewardsResponse, error)
+	SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error)
+	IssueBillingCredit(ctx context.Context, in *MsgIssueBillingCredit, opts ...grpc.CallOption) (*MsgIssueBillingCreditResponse, error)
+	RevokeBillingCredit(ctx context.Context, in *MsgRevokeBillingCredit, opts ...grpc.CallOption) (*MsgRevokeBillingCreditResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetPlatformValidators(ctx context.Context, in *MsgSetPlatformValidators, opts ...grpc.CallOption) (*MsgSetPlatformValidatorsResponse, error) {
+	out := new(MsgSetPlatformValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/SetPlatformValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLowBalanceThreshold(ctx context.Context, in *MsgSetLowBalanceThreshold, opts ...grpc.CallOption) (*MsgSetLowBalanceThresholdResponse, error) {
+	out := new(MsgSetLowBalanceThresholdResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/SetLowBalanceThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStackRevenueShare(ctx context.Context, in *MsgSetStackRevenueShare, opts ...grpc.CallOption) (*MsgSetStackRevenueShareResponse, error) {
+	out := new(MsgSetStackRevenueShareResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/SetStackRevenueShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimBillingRewards(ctx context.Context, in *MsgClaimBillingRewards, opts ...grpc.CallOption) (*MsgClaimBillingRewardsResponse, error) {
+	out := new(MsgClaimBillingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/ClaimBillingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/SetRewardWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IssueBillingCredit(ctx context.Context, in *MsgIssueBillingCredit, opts ...grpc.CallOption) (*MsgIssueBillingCreditResponse, error) {
+	out := new(MsgIssueBillingCreditResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/IssueBillingCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeBillingCredit(ctx context.Context, in *MsgRevokeBillingCredit, opts ...grpc.CallOption) (*MsgRevokeBillingCreditResponse, error) {
+	out := new(MsgRevokeBillingCreditResponse)
+	err := c.cc.Invoke(ctx, "/ssc.billing.Msg/RevokeBillingCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// this line is used by starport scaffolding # proto/tx/rpc
+	SetPlatformValidators(context.Context, *MsgSetPlatformValidators) (*MsgSetPlatformValidatorsResponse, error)
+	SetLowBalanceThreshold(context.Context, *MsgSetLowBalanceThreshold) (*MsgSetLowBalanceThresholdResponse, error)
+	SetStackRevenueShare(context.Context, *MsgSetStackRevenueShare) (*MsgSetStackRevenueShareResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ClaimBillingRewards(context.Context, *MsgClaimBillingRewards) (*MsgClaimBillingRewardsResponse, error)
+	SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error)
+	IssueBillingCredit(context.Context, *MsgIssueBillingCredit) (*MsgIssueBillingCreditResponse, error)
+	RevokeBillingCredit(context.Context, *MsgRevokeBillingCredit) (*MsgRevokeBillingCreditResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetPlatformValidators(ctx context.Context, req *MsgSetPlatformValidators) (*MsgSetPlatformValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformValidators not implemented")
+}
+func (*UnimplementedMsgServer) SetLowBalanceThreshold(ctx context.Context, req *MsgSetLowBalanceThreshold) (*MsgSetLowBalanceThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLowBalanceThreshold not implemented")
+}
+func (*UnimplementedMsgServer) SetStackRevenueShare(ctx context.Context, req *MsgSetStackRevenueShare) (*MsgSetStackRevenueShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStackRevenueShare not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ClaimBillingRewards(ctx context.Context, req *MsgClaimBillingRewards) (*MsgClaimBillingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBillingRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardWithdrawAddress(ctx context.Context, req *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) IssueBillingCredit(ctx context.Context, req *MsgIssueBillingCredit) (*MsgIssueBillingCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueBillingCredit not implemented")
+}
+func (*UnimplementedMsgServer) RevokeBillingCredit(ctx context.Context, req *MsgRevokeBillingCredit) (*MsgRevokeBillingCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBillingCredit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetPlatformValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/SetPlatformValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformValidators(ctx, req.(*MsgSetPlatformValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLowBalanceThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLowBalanceThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLowBalanceThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/SetLowBalanceThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLowBalanceThreshold(ctx, req.(*MsgSetLowBalanceThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStackRevenueShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStackRevenueShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStackRevenueShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/SetStackRevenueShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStackRevenueShare(ctx, req.(*MsgSetStackRevenueShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimBillingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimBillingRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimBillingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/ClaimBillingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimBillingRewards(ctx, req.(*MsgClaimBillingRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/SetRewardWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, req.(*MsgSetRewardWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IssueBillingCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueBillingCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueBillingCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/IssueBillingCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueBillingCredit(ctx, req.(*MsgIssueBillingCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBillingCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBillingCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBillingCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.billing.Msg/RevokeBillingCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBillingCredit(ctx, req.(*MsgRevokeBillingCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ssc.billing.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPlatformValidators",
+			Handler:    _Msg_SetPlatformValidators_Handler,
+		},
+		{
+			MethodName: "SetLowBalanceThreshold",
+			Handler:    _Msg_SetLowBalanceThreshold_Handler,
+		},
+		{
+			MethodName: "SetStackRevenueShare",
+			Handler:    _Msg_SetStackRevenueShare_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ClaimBillingRewards",
+			Handler:    _Msg_ClaimBillingRewards_Handler,
+		},
+		{
+			MethodName: "SetRewardWithdrawAddress",
+			Handler:    _Msg_SetRewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "IssueBillingCredit",
+			Handler:    _Msg_IssueBillingCredit_Handler,
+		},
+		{
+			MethodName: "RevokeBillingCredit",
+			Handler:    _Msg_RevokeBillingCredit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ssc/billing/tx.proto",
+}
+
+func (m *MsgSetPlatformValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlatformValidators) > 0 {
+		for iNdEx := len(m.PlatformValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlatformValidators[iNdEx])
+			copy(dAtA[i:], m.PlatformValidators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PlatformValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLowBalanceThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLowBalanceThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLowBalanceThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLowBalanceThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLowBalanceThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLowBalanceThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetStackRevenueShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetStackRevenueShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetStackRevenueShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StackName) > 0 {
+		i -= len(m.StackName)
+		copy(dAtA[i:], m.StackName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StackName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetStackRevenueShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetStackRevenueShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetStackRevenueShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBillingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBillingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBillingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBillingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBillingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBillingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueBillingCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueBillingCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueBillingCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueBillingCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueBillingCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueBillingCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBillingCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBillingCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBillingCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBillingCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBillingCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBillingCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetPlatformValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PlatformValidators) > 0 {
+		for _, s := range m.PlatformValidators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPlatformValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLowBalanceThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetLowBalanceThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetStackRevenueShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StackName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetStackRevenueShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimBillingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimBillingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIssueBillingCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIssueBillingCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRevokeBillingCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRevokeBillingCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetPlatformValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformValidators = append(m.PlatformValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLowBalanceThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLowBalanceThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLowBalanceThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLowBalanceThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLowBalanceThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLowBalanceThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetStackRevenueShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetStackRevenueShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetStackRevenueShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetStackRevenueShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetStackRevenueShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetStackRevenueShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimBillingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimBillingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimBillingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimBillingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimBillingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimBillingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueBillingCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueBillingCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueBillingCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSetPlatformValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetPlatformValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetPlatformValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgSetPlatformValidators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PlatformValidators) > 0 {
-		for _, s := range m.PlatformValidators {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgIssueBillingCreditResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueBillingCreditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueBillingCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSetPlatformValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetPlatformValidators) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeBillingCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -356,15 +3436,15 @@ func (m *MsgSetPlatformValidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformValidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeBillingCredit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeBillingCredit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -392,13 +3472,13 @@ func (m *MsgSetPlatformValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformValidators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -408,24 +3488,11 @@ func (m *MsgSetPlatformValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PlatformValidators = append(m.PlatformValidators, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -447,7 +3514,7 @@ func (m *MsgSetPlatformValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeBillingCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -470,10 +3537,10 @@ func (m *MsgSetPlatformValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeBillingCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeBillingCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,12 +25,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Recipient of a part of the epoch fees
+type PayoutDestination int32
+
+const (
+	PayoutDestination_PAYOUT_DESTINATION_VALIDATOR      PayoutDestination = 0
+	PayoutDestination_PAYOUT_DESTINATION_TREASURY       PayoutDestination = 1
+	PayoutDestination_PAYOUT_DESTINATION_COMMUNITY_POOL PayoutDestination = 2
+)
+
+var PayoutDestination_name = map[int32]string{
+	0: "PAYOUT_DESTINATION_VALIDATOR",
+	1: "PAYOUT_DESTINATION_TREASURY",
+	2: "PAYOUT_DESTINATION_COMMUNITY_POOL",
+}
+
+var PayoutDestination_value = map[string]int32{
+	"PAYOUT_DESTINATION_VALIDATOR":      0,
+	"PAYOUT_DESTINATION_TREASURY":       1,
+	"PAYOUT_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x PayoutDestination) String() string {
+	return proto.EnumName(PayoutDestination_name, int32(x))
+}
+
+func (PayoutDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_45af6150ff54cc1a, []int{0}
+}
+
 type ValidatorPayoutHistory struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
 	EpochIdentifier  string `protobuf:"bytes,2,opt,name=epochIdentifier,proto3" json:"epochIdentifier,omitempty"`
 	EpochNumber      int64  `protobuf:"varint,3,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
 	EpochStartTime   string `protobuf:"bytes,4,opt,name=epochStartTime,proto3" json:"epochStartTime,omitempty"`
 	RewardAmount     string `protobuf:"bytes,5,opt,name=rewardAmount,proto3" json:"rewardAmount,omitempty"`
+	// The payout failed and nothing was paid
+	Failed bool `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Recipient type, the validatorAddress is the treasury address or the
+	// distribution module account for the other destinations
+	Destination PayoutDestination `protobuf:"varint,7,opt,name=destination,proto3,enum=ssc.billing.PayoutDestination" json:"destination,omitempty"`
+	// Part of the reward paid out of the revenue of CCV consumer chainlets the
+	// validator opted in to, the rest comes from the other chainlets
+	ChainletRevenues []ChainletRevenue `protobuf:"bytes,8,rep,name=chainletRevenues,proto3" json:"chainletRevenues"`
 }
 
 func (m *ValidatorPayoutHistory) Reset()         { *m = ValidatorPayoutHistory{} }
@@ -98,8 +138,84 @@ func (m *ValidatorPayoutHistory) GetRewardAmount() string {
 	return ""
 }
 
+func (m *ValidatorPayoutHistory) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *ValidatorPayoutHistory) GetDestination() PayoutDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return PayoutDestination_PAYOUT_DESTINATION_VALIDATOR
+}
+
+func (m *ValidatorPayoutHistory) GetChainletRevenues() []ChainletRevenue {
+	if m != nil {
+		return m.ChainletRevenues
+	}
+	return nil
+}
+
+// ChainletRevenue is an amount of epoch fees attributed to a chainlet
+type ChainletRevenue struct {
+	ChainId string                                   `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ChainletRevenue) Reset()         { *m = ChainletRevenue{} }
+func (m *ChainletRevenue) String() string { return proto.CompactTextString(m) }
+func (*ChainletRevenue) ProtoMessage()    {}
+func (*ChainletRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45af6150ff54cc1a, []int{1}
+}
+func (m *ChainletRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainletRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainletRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainletRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainletRevenue.Merge(m, src)
+}
+func (m *ChainletRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainletRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainletRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainletRevenue proto.InternalMessageInfo
+
+func (m *ChainletRevenue) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainletRevenue) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ssc.billing.PayoutDestination", PayoutDestination_name, PayoutDestination_value)
 	proto.RegisterType((*ValidatorPayoutHistory)(nil), "ssc.billing.ValidatorPayoutHistory")
+	proto.RegisterType((*ChainletRevenue)(nil), "ssc.billing.ChainletRevenue")
 }
 
 func init() {
@@ -107,24 +223,40 @@ func init() {
 }
 
 var fileDescriptor_45af6150ff54cc1a = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0xc6, 0xf1, 0x9a, 0x02, 0x12, 0x2e, 0x02, 0xe4, 0x01, 0x79, 0xb2, 0xa2, 0x0e, 0x55, 0xd4,
-	0x21, 0x19, 0xb8, 0x00, 0x65, 0x82, 0x05, 0xa1, 0x82, 0x18, 0x58, 0x2a, 0x27, 0x36, 0x89, 0xa5,
-	0x24, 0x8e, 0xfc, 0x5e, 0xa0, 0xe1, 0x14, 0x1c, 0x8b, 0xb1, 0x23, 0x03, 0x03, 0x4a, 0x2e, 0x82,
-	0x30, 0x50, 0x41, 0x19, 0xdf, 0x5f, 0x3f, 0xbd, 0xe1, 0xa3, 0x53, 0x80, 0x34, 0x4e, 0x4c, 0x51,
-	0x98, 0x2a, 0x8b, 0x1f, 0x64, 0x61, 0x94, 0x44, 0xeb, 0x16, 0xb5, 0x6c, 0x6d, 0x83, 0x8b, 0xdc,
-	0x00, 0x5a, 0xd7, 0x46, 0xb5, 0xb3, 0x68, 0xd9, 0x08, 0x20, 0x8d, 0xbe, 0xed, 0xf8, 0x8d, 0xd0,
-	0xe3, 0xdb, 0x1f, 0x7f, 0xe5, 0xf9, 0xf9, 0x97, 0x66, 0x53, 0x7a, 0xb4, 0xfe, 0x34, 0x53, 0xca,
-	0x69, 0x00, 0x4e, 0x02, 0x12, 0xee, 0xcd, 0xff, 0x75, 0x16, 0xd2, 0x43, 0x5d, 0xdb, 0x34, 0xbf,
-	0x50, 0xba, 0x42, 0x73, 0x6f, 0xb4, 0xe3, 0x5b, 0x9e, 0x6e, 0x66, 0x16, 0xd0, 0x91, 0x4f, 0x97,
-	0x4d, 0x99, 0x68, 0xc7, 0x87, 0x01, 0x09, 0x87, 0xf3, 0xdf, 0x89, 0x4d, 0xe8, 0x81, 0x3f, 0xaf,
-	0x51, 0x3a, 0xbc, 0x31, 0xa5, 0xe6, 0xdb, 0xfe, 0xd5, 0x46, 0x65, 0x63, 0xba, 0xef, 0xf4, 0xa3,
-	0x74, 0x6a, 0x56, 0xda, 0xa6, 0x42, 0xbe, 0xe3, 0xd5, 0x9f, 0x76, 0x76, 0xfa, 0xd2, 0x09, 0xb2,
-	0xea, 0x04, 0x79, 0xef, 0x04, 0x79, 0xee, 0xc5, 0x60, 0xd5, 0x8b, 0xc1, 0x6b, 0x2f, 0x06, 0x77,
-	0x93, 0xcc, 0x60, 0xde, 0x24, 0x51, 0x6a, 0xcb, 0x18, 0x64, 0x26, 0x97, 0xed, 0x53, 0xfc, 0x39,
-	0xe2, 0x72, 0x3d, 0x23, 0xb6, 0xb5, 0x86, 0x64, 0xd7, 0x8f, 0x76, 0xf2, 0x11, 0x00, 0x00, 0xff,
-	0xff, 0x52, 0x25, 0x23, 0xa3, 0x62, 0x01, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xdf, 0x6e, 0xda, 0x30,
+	0x14, 0xc6, 0x49, 0xe9, 0x68, 0x67, 0xa6, 0x96, 0x59, 0x53, 0x95, 0x75, 0x55, 0xc8, 0x90, 0x56,
+	0x45, 0x48, 0x4b, 0xd6, 0xee, 0x05, 0x1a, 0xa0, 0xd2, 0x22, 0xb5, 0x04, 0x99, 0x50, 0x89, 0xdd,
+	0x20, 0x27, 0x71, 0xc1, 0x1a, 0xc4, 0x28, 0x76, 0x58, 0x99, 0xb4, 0xdb, 0x5d, 0xf7, 0x39, 0xf6,
+	0x24, 0xbd, 0xec, 0xe5, 0xae, 0xb6, 0x09, 0x5e, 0x64, 0xc2, 0x49, 0x3b, 0xfe, 0xf4, 0x2a, 0x39,
+	0x9f, 0x7f, 0xe7, 0x7c, 0x3a, 0xe7, 0xd8, 0xa0, 0xca, 0x79, 0x60, 0xf9, 0x74, 0x38, 0xa4, 0x51,
+	0xdf, 0x9a, 0xe0, 0x21, 0x0d, 0xb1, 0x60, 0x71, 0x6f, 0x8c, 0xa7, 0x2c, 0x11, 0xbd, 0x01, 0xe5,
+	0x82, 0xc5, 0x53, 0x73, 0x1c, 0x33, 0xc1, 0x60, 0x91, 0xf3, 0xc0, 0xcc, 0xd8, 0xc3, 0x57, 0x7d,
+	0xd6, 0x67, 0x52, 0xb7, 0x16, 0x7f, 0x29, 0x72, 0xa8, 0x05, 0x8c, 0x8f, 0x18, 0xb7, 0x7c, 0xcc,
+	0x89, 0x35, 0x39, 0xf1, 0x89, 0xc0, 0x27, 0x56, 0xc0, 0x68, 0x94, 0x9e, 0x57, 0x7e, 0xe4, 0xc1,
+	0xc1, 0xd5, 0x83, 0x4b, 0x4b, 0x9a, 0x7c, 0x4a, 0x3d, 0x60, 0x15, 0x94, 0x1e, 0xfd, 0xed, 0x30,
+	0x8c, 0x09, 0xe7, 0xaa, 0xa2, 0x2b, 0xc6, 0x73, 0xb4, 0xa1, 0x43, 0x03, 0xec, 0x93, 0x31, 0x0b,
+	0x06, 0x4e, 0x48, 0x22, 0x41, 0xaf, 0x29, 0x89, 0xd5, 0x2d, 0x89, 0xae, 0xcb, 0x50, 0x07, 0x45,
+	0x29, 0x35, 0x93, 0x91, 0x4f, 0x62, 0x35, 0xaf, 0x2b, 0x46, 0x1e, 0x2d, 0x4b, 0xf0, 0x18, 0xec,
+	0xc9, 0xb0, 0x2d, 0x70, 0x2c, 0x3c, 0x3a, 0x22, 0xea, 0xb6, 0x2c, 0xb5, 0xa6, 0xc2, 0x0a, 0x78,
+	0x11, 0x93, 0xaf, 0x38, 0x0e, 0xed, 0x11, 0x4b, 0x22, 0xa1, 0x3e, 0x93, 0xd4, 0x8a, 0x06, 0x0f,
+	0x40, 0xe1, 0x1a, 0xd3, 0x21, 0x09, 0xd5, 0x82, 0xae, 0x18, 0xbb, 0x28, 0x8b, 0xe0, 0x19, 0x28,
+	0x86, 0x84, 0x0b, 0x1a, 0x61, 0x41, 0x59, 0xa4, 0xee, 0xe8, 0x8a, 0xb1, 0x77, 0xaa, 0x99, 0x4b,
+	0xf3, 0x34, 0xd3, 0x61, 0x34, 0xfe, 0x53, 0x68, 0x39, 0x05, 0x36, 0x41, 0x29, 0x18, 0x60, 0x1a,
+	0x0d, 0x89, 0x40, 0x64, 0x42, 0xa2, 0x84, 0x70, 0x75, 0x57, 0xcf, 0x1b, 0xc5, 0xd3, 0xa3, 0x95,
+	0x32, 0xf5, 0x55, 0xa8, 0xb6, 0x7d, 0xf7, 0xbb, 0x9c, 0x43, 0x1b, 0xb9, 0x95, 0x5b, 0x05, 0xec,
+	0xaf, 0xb1, 0x50, 0x05, 0x3b, 0x92, 0x73, 0xc2, 0x6c, 0xf0, 0x0f, 0x21, 0x0c, 0x40, 0x01, 0xa7,
+	0x5d, 0x6f, 0x49, 0xcf, 0xd7, 0x66, 0xba, 0x67, 0x73, 0xb1, 0x67, 0x33, 0xdb, 0xb3, 0x59, 0x67,
+	0x34, 0xaa, 0x7d, 0x58, 0x18, 0xfe, 0xfc, 0x53, 0x36, 0xfa, 0x54, 0x0c, 0x12, 0xdf, 0x0c, 0xd8,
+	0xc8, 0xca, 0x2e, 0x45, 0xfa, 0x79, 0xcf, 0xc3, 0x2f, 0x96, 0x98, 0x8e, 0x09, 0x97, 0x09, 0x1c,
+	0x65, 0xa5, 0xab, 0xdf, 0xc1, 0xcb, 0x8d, 0x21, 0x40, 0x1d, 0x1c, 0xb5, 0xec, 0xae, 0xdb, 0xf1,
+	0x7a, 0x8d, 0xf3, 0xb6, 0xe7, 0x34, 0x6d, 0xcf, 0x71, 0x9b, 0xbd, 0x2b, 0xfb, 0xc2, 0x69, 0xd8,
+	0x9e, 0x8b, 0x4a, 0x39, 0x58, 0x06, 0x6f, 0x9e, 0x20, 0x3c, 0x74, 0x6e, 0xb7, 0x3b, 0xa8, 0x5b,
+	0x52, 0xe0, 0x3b, 0xf0, 0xf6, 0x09, 0xa0, 0xee, 0x5e, 0x5e, 0x76, 0x9a, 0x8e, 0xd7, 0xed, 0xb5,
+	0x5c, 0xf7, 0xa2, 0xb4, 0x55, 0x3b, 0xbb, 0x9b, 0x69, 0xca, 0xfd, 0x4c, 0x53, 0xfe, 0xce, 0x34,
+	0xe5, 0x76, 0xae, 0xe5, 0xee, 0xe7, 0x5a, 0xee, 0xd7, 0x5c, 0xcb, 0x7d, 0x3e, 0x5e, 0x6a, 0x85,
+	0xe3, 0x3e, 0xbe, 0x99, 0x7e, 0xb3, 0x16, 0xcf, 0xe6, 0xe6, 0xf1, 0xe1, 0xc8, 0x76, 0xfc, 0x82,
+	0xbc, 0xe3, 0x1f, 0xff, 0x0d, 0x00, 0x36, 0x97, 0x51, 0xa6, 0x54, 0x03, 0x00, 0x00,
 }
 
 func (m *ValidatorPayoutHistory) Marshal() (dAtA []byte, err error) {
@@ -147,6 +279,35 @@ func (m *ValidatorPayoutHistory) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainletRevenues) > 0 {
+		for iNdEx := len(m.ChainletRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainletRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorPayoutHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Destination != 0 {
+		i = encodeVarintValidatorPayoutHistory(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.RewardAmount) > 0 {
 		i -= len(m.RewardAmount)
 		copy(dAtA[i:], m.RewardAmount)
@@ -183,6 +344,50 @@ func (m *ValidatorPayoutHistory) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainletRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainletRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainletRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorPayoutHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintValidatorPayoutHistory(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintValidatorPayoutHistory(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValidatorPayoutHistory(v)
 	base := offset
@@ -219,6 +424,37 @@ func (m *ValidatorPayoutHistory) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovValidatorPayoutHistory(uint64(l))
 	}
+	if m.Failed {
+		n += 2
+	}
+	if m.Destination != 0 {
+		n += 1 + sovValidatorPayoutHistory(uint64(m.Destination))
+	}
+	if len(m.ChainletRevenues) > 0 {
+		for _, e := range m.ChainletRevenues {
+			l = e.Size()
+			n += 1 + l + sovValidatorPayoutHistory(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainletRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovValidatorPayoutHistory(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovValidatorPayoutHistory(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -404,6 +640,195 @@ func (m *ValidatorPayoutHistory) Unmarshal(dAtA []byte) error {
 			}
 			m.RewardAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPayoutHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPayoutHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= PayoutDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainletRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPayoutHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainletRevenues = append(m.ChainletRevenues, ChainletRevenue{})
+			if err := m.ChainletRevenues[len(m.ChainletRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorPayoutHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainletRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorPayoutHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainletRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainletRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPayoutHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPayoutHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPayoutHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidatorPayoutHistory(dAtA[iNdEx:])
@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ssc/billing/validator_rewards.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingRewards are the epoch fee rewards accrued to a validator and not
+// claimed yet
+type PendingRewards struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PendingRewards) Reset()         { *m = PendingRewards{} }
+func (m *PendingRewards) String() string { return proto.CompactTextString(m) }
+func (*PendingRewards) ProtoMessage()    {}
+func (*PendingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7a4f1f19122572, []int{0}
+}
+func (m *PendingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewards.Merge(m, src)
+}
+func (m *PendingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewards proto.InternalMessageInfo
+
+func (m *PendingRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingRewards) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// RewardWithdrawAddress is the address the rewards of a validator are claimed
+// to instead of the validator address
+type RewardWithdrawAddress struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+	WithdrawAddress  string `protobuf:"bytes,2,opt,name=withdrawAddress,proto3" json:"withdrawAddress,omitempty"`
+}
+
+func (m *RewardWithdrawAddress) Reset()         { *m = RewardWithdrawAddress{} }
+func (m *RewardWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*RewardWithdrawAddress) ProtoMessage()    {}
+func (*RewardWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7a4f1f19122572, []int{1}
+}
+func (m *RewardWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWithdrawAddress.Merge(m, src)
+}
+func (m *RewardWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWithdrawAddress proto.InternalMessageInfo
+
+func (m *RewardWithdrawAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RewardWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PendingRewards)(nil), "ssc.billing.PendingRewards")
+	proto.RegisterType((*RewardWithdrawAddress)(nil), "ssc.billing.RewardWithdrawAddress")
+}
+
+func init() {
+	proto.RegisterFile("ssc/billing/validator_rewards.proto", fileDescriptor_0e7a4f1f19122572)
+}
+
+var fileDescriptor_0e7a4f1f19122572 = []byte{
+	// 301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4d, 0x4a, 0x03, 0x31,
+	0x14, 0xc7, 0x67, 0x2a, 0x14, 0x4c, 0x41, 0xa5, 0x28, 0xd4, 0x2e, 0xd2, 0x52, 0x41, 0x06, 0xc1,
+	0xc4, 0xea, 0x05, 0xb4, 0x5e, 0x40, 0xba, 0x11, 0xdc, 0x48, 0x26, 0x09, 0x69, 0xb0, 0x93, 0x94,
+	0xbc, 0xf4, 0xcb, 0x53, 0x78, 0x05, 0xb7, 0x9e, 0xa4, 0xcb, 0x2e, 0x5d, 0xa9, 0xb4, 0x17, 0x91,
+	0x99, 0x8c, 0xc5, 0x8f, 0x95, 0xab, 0x84, 0x97, 0xff, 0x7b, 0xbf, 0x1f, 0x79, 0xe8, 0x08, 0x80,
+	0xd3, 0x54, 0x0f, 0x87, 0xda, 0x28, 0x3a, 0x61, 0x43, 0x2d, 0x98, 0xb7, 0xee, 0xde, 0xc9, 0x29,
+	0x73, 0x02, 0xc8, 0xc8, 0x59, 0x6f, 0xeb, 0x35, 0x00, 0x4e, 0xca, 0x50, 0x73, 0x5f, 0x59, 0x65,
+	0x8b, 0x3a, 0xcd, 0x6f, 0x21, 0xd2, 0xc4, 0xdc, 0x42, 0x66, 0x81, 0xa6, 0x0c, 0x24, 0x9d, 0x74,
+	0x53, 0xe9, 0x59, 0x97, 0x72, 0xab, 0x4d, 0x78, 0xef, 0x3c, 0xc7, 0x68, 0xe7, 0x46, 0x1a, 0xa1,
+	0x8d, 0xea, 0x87, 0xd9, 0xf5, 0x13, 0xb4, 0xb7, 0x01, 0x5e, 0x09, 0xe1, 0x24, 0x40, 0x23, 0x6e,
+	0xc7, 0xc9, 0x76, 0xff, 0x4f, 0xbd, 0xce, 0x51, 0x95, 0x65, 0x76, 0x6c, 0x7c, 0xa3, 0xd2, 0xde,
+	0x4a, 0x6a, 0xe7, 0x87, 0x24, 0xf0, 0x48, 0xce, 0x23, 0x25, 0x8f, 0x5c, 0x5b, 0x6d, 0x7a, 0x67,
+	0x8b, 0xb7, 0x56, 0xf4, 0xf2, 0xde, 0x4a, 0x94, 0xf6, 0x83, 0x71, 0x4a, 0xb8, 0xcd, 0x68, 0x29,
+	0x17, 0x8e, 0x53, 0x10, 0x0f, 0xd4, 0xcf, 0x47, 0x12, 0x8a, 0x06, 0xe8, 0x97, 0xa3, 0x3b, 0x19,
+	0x3a, 0x08, 0x6e, 0xb7, 0xda, 0x0f, 0x84, 0x63, 0xd3, 0x2f, 0xfa, 0x7f, 0x4c, 0x13, 0xb4, 0x3b,
+	0xfd, 0xd9, 0xde, 0xa8, 0x14, 0xd1, 0xdf, 0xe5, 0xde, 0xe5, 0x62, 0x85, 0xe3, 0xe5, 0x0a, 0xc7,
+	0x1f, 0x2b, 0x1c, 0x3f, 0xad, 0x71, 0xb4, 0x5c, 0xe3, 0xe8, 0x75, 0x8d, 0xa3, 0xbb, 0xe3, 0x6f,
+	0xea, 0xc0, 0x14, 0x9b, 0xcd, 0x1f, 0x69, 0xbe, 0xa7, 0xd9, 0x66, 0x53, 0x85, 0x7e, 0x5a, 0x2d,
+	0xfe, 0xf6, 0xe2, 0x73, 0x00, 0x74, 0x93, 0xe0, 0x42, 0xc5, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintValidatorRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintValidatorRewards(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewards(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovValidatorRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewards(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewards(uint64(l))
+	}
+	return n
+}
+
+func sovValidatorRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorRewards(x uint64) (n int) {
+	return sovValidatorRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorRewards = fmt.Errorf("proto: unexpected end of group")
+)
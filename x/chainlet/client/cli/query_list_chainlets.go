@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/sagaxyz/ssc/x/chainlet/types"
@@ -24,10 +27,44 @@ func CmdListChainlets() *cobra.Command {
 				return err
 			}
 
+			launcher, _ := cmd.Flags().GetString("launcher")
+			maintainer, _ := cmd.Flags().GetString("maintainer")
+			stackName, _ := cmd.Flags().GetString("stack-name")
+			stackVersion, _ := cmd.Flags().GetString("stack-version")
+			tag, _ := cmd.Flags().GetString("tag")
+			statusFlag, _ := cmd.Flags().GetString("status")
+			ccvFlag, _ := cmd.Flags().GetString("ccv")
+
+			var status types.Status
+			if statusFlag != "" {
+				value, ok := types.Status_value["STATUS_"+strings.ToUpper(statusFlag)]
+				if !ok {
+					return fmt.Errorf("invalid status %s", statusFlag)
+				}
+				status = types.Status(value)
+			}
+			var ccv types.CCVFilter
+			switch ccvFlag {
+			case "":
+			case "consumer":
+				ccv = types.CCVFilter_CCV_FILTER_CONSUMER
+			case "standalone":
+				ccv = types.CCVFilter_CCV_FILTER_STANDALONE
+			default:
+				return fmt.Errorf("invalid ccv filter %s", ccvFlag)
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryListChainletsRequest{
-				Pagination: pageReq,
+				Pagination:   pageReq,
+				Launcher:     launcher,
+				Maintainer:   maintainer,
+				StackName:    stackName,
+				StackVersion: stackVersion,
+				Tag:          tag,
+				Status:       status,
+				Ccv:          ccv,
 			}
 
 			res, err := queryClient.ListChainlets(cmd.Context(), params)
@@ -41,5 +78,12 @@ func CmdListChainlets() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "list-chainlets")
+	cmd.Flags().String("launcher", "", "only list chainlets with this launcher")
+	cmd.Flags().String("maintainer", "", "only list chainlets with this maintainer")
+	cmd.Flags().String("stack-name", "", "only list chainlets of this stack")
+	cmd.Flags().String("stack-version", "", "only list chainlets running this stack version")
+	cmd.Flags().String("tag", "", "only list chainlets with this tag")
+	cmd.Flags().String("status", "", "only list chainlets with this status, e.g. online or suspended_billing")
+	cmd.Flags().String("ccv", "", "only list consumer or standalone chainlets (consumer|standalone)")
 	return cmd
 }
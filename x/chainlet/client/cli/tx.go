@@ -30,6 +30,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCancelChainletUpgrade())
 	cmd.AddCommand(CmdDisableChainletStackVersion())
 	cmd.AddCommand(CmdUpdateChainletStackFees())
+	cmd.AddCommand(CmdUpdateChainletParams())
+	cmd.AddCommand(CmdAddChainletMaintainer())
+	cmd.AddCommand(CmdRemoveChainletMaintainer())
+	cmd.AddCommand(CmdTransferChainletOwnership())
+	cmd.AddCommand(CmdAcceptChainletOwnership())
+	cmd.AddCommand(CmdDecommissionChainlet())
+	cmd.AddCommand(CmdSuspendChainlet())
+	cmd.AddCommand(CmdResumeChainlet())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
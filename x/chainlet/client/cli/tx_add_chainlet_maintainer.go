@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdAddChainletMaintainer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-chainlet-maintainer [chain-id] [maintainer]",
+		Short: "Add a maintainer to a chainlet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argMaintainer := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddChainletMaintainer(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				argMaintainer,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdTransferChainletOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-chainlet-ownership [chain-id] [new-launcher]",
+		Short: "Transfer the launcher role of a chainlet. An empty new launcher, or the current one, cancels the pending transfer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argNewLauncher := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requireAccept, _ := cmd.Flags().GetBool("require-accept")
+			msg := types.NewMsgTransferChainletOwnership(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				argNewLauncher,
+				requireAccept,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool("require-accept", false, "the new launcher has to accept the transfer with accept-chainlet-ownership")
+	return cmd
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateChainletParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-chainlet-params [chain-id] [params]",
+		Short: "Update the params of a running chainlet. Params left out of the JSON keep their current value, the ones included are set even to false or empty",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argParams := args[1] // looks like '{"gasLimit":20000000,"fixedBaseFee":"1000",...}'
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			params, updateMask, err := parseParamsUpdate(argParams)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateChainletParams(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				params,
+				updateMask,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// updatableParams are the names of the chainlet params maintainers can update
+var updatableParams = []string{
+	types.ChainletParamGasLimit,
+	types.ChainletParamCreateEmptyBlocks,
+	types.ChainletParamDacEnable,
+	types.ChainletParamFixedBaseFee,
+	types.ChainletParamFeeAccount,
+}
+
+// parseParamsUpdate parses the params of an update, the params present in the JSON are the ones
+// to update. Keys are matched regardless of case and underscores, gas_limit or GasLimit both
+// update gasLimit.
+func parseParamsUpdate(argParams string) (params types.ChainletParams, updateMask []string, err error) {
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal([]byte(argParams), &fields)
+	if err != nil {
+		return
+	}
+	normalized := make(map[string]json.RawMessage, len(fields))
+	for key, value := range fields {
+		name := paramName(key)
+		if _, ok := normalized[name]; ok {
+			err = fmt.Errorf("param %s is set more than once", name)
+			return
+		}
+		normalized[name] = value
+		updateMask = append(updateMask, name)
+	}
+	sort.Strings(updateMask)
+
+	bz, err := json.Marshal(normalized)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(bz, &params)
+	return
+}
+
+// paramName returns the name used in update masks for a JSON key, unknown keys are kept as is.
+func paramName(key string) string {
+	flat := strings.ReplaceAll(key, "_", "")
+	for _, name := range updatableParams {
+		if strings.EqualFold(flat, name) {
+			return name
+		}
+	}
+	return key
+}
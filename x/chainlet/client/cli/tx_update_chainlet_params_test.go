@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func TestParseParamsUpdate(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		json      string
+		expParams types.ChainletParams
+		expMask   []string
+		expErr    bool
+	}{
+		{
+			"proto JSON names",
+			`{"gasLimit":20000000,"fixedBaseFee":"1000"}`,
+			types.ChainletParams{GasLimit: 20000000, FixedBaseFee: "1000"},
+			[]string{types.ChainletParamFixedBaseFee, types.ChainletParamGasLimit},
+			false,
+		},
+		{
+			"Go and snake case names",
+			`{"GasLimit":20000000,"create_empty_blocks":false,"DAC_ENABLE":true,"fee_account":""}`,
+			types.ChainletParams{GasLimit: 20000000, DacEnable: true},
+			[]string{types.ChainletParamCreateEmptyBlocks, types.ChainletParamDacEnable, types.ChainletParamFeeAccount, types.ChainletParamGasLimit},
+			false,
+		},
+		{
+			"unknown names are kept",
+			`{"evmDisable":true}`,
+			types.ChainletParams{EvmDisable: true},
+			[]string{"evmDisable"},
+			false,
+		},
+		{
+			"param set twice",
+			`{"gasLimit":1,"gas_limit":2}`,
+			types.ChainletParams{},
+			nil,
+			true,
+		},
+		{
+			"invalid JSON",
+			`{"gasLimit":`,
+			types.ChainletParams{},
+			nil,
+			true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params, mask, err := parseParamsUpdate(tc.json)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expParams, params)
+			require.Equal(t, tc.expMask, mask)
+		})
+	}
+}
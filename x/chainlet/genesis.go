@@ -29,6 +29,11 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Import tombstones of decommissioned chainlets
+	for _, tombstone := range genState.Tombstones {
+		k.ImportTombstone(ctx, tombstone)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 }
 
@@ -44,6 +49,9 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	// Export all chainlet stacks
 	genesis.ChainletStacks = k.ExportChainletStacks(ctx)
 
+	// Export tombstones of decommissioned chainlets
+	genesis.Tombstones = k.ExportTombstones(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
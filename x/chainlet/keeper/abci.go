@@ -8,6 +8,7 @@ import (
 
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	k.InitConsumers(ctx)
+	k.SpawnChainlets(ctx)
 
 	p := k.GetParams(ctx)
 	if p.AutomaticChainletUpgrades && ctx.BlockHeight()%p.AutomaticChainletUpgradeInterval == 0 {
@@ -24,7 +24,7 @@ func (s *TestSuite) TestConsumerVSC() {
 		Return(nil).
 		AnyTimes()
 	s.billingKeeper.EXPECT().
-		BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.aclKeeper.EXPECT().
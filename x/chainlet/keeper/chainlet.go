@@ -3,7 +3,6 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
-	"reflect"
 	"time"
 
 	cosmossdkerrors "cosmossdk.io/errors"
@@ -38,6 +37,9 @@ func (k *Keeper) NewChainlet(ctx sdk.Context, chainlet types.Chainlet) error {
 	if store.Has(key) {
 		return cosmossdkerrors.Wrapf(types.ErrChainletExists, "chainlet with chainId %s already exists", chainlet.ChainId)
 	}
+	if k.IsTombstoned(ctx, chainlet.ChainId) {
+		return cosmossdkerrors.Wrapf(types.ErrChainletExists, "chainlet with chainId %s was decommissioned", chainlet.ChainId)
+	}
 
 	avail, err := k.chainletStackVersionAvailable(ctx, chainlet.ChainletStackName, chainlet.ChainletStackVersion)
 	if err != nil {
@@ -47,8 +49,7 @@ func (k *Keeper) NewChainlet(ctx sdk.Context, chainlet types.Chainlet) error {
 		return cosmossdkerrors.Wrapf(types.ErrInvalidChainletStack, "stack %s version %s not available", chainlet.ChainletStackName, chainlet.ChainletStackVersion)
 	}
 
-	value := k.cdc.MustMarshal(&chainlet)
-	store.Set(key, value)
+	k.setChainletInfo(ctx, &chainlet)
 	k.incrementChainletCount(ctx)
 	return nil
 }
@@ -71,9 +72,7 @@ func (k *Keeper) EnableConsumer(ctx sdk.Context, chainId string, spawnTime time.
 	}
 	chainlet.ConsumerId = consumerId
 
-	updatedValue := k.cdc.MustMarshal(&chainlet)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
-	store.Set([]byte(chainId), updatedValue)
+	k.setChainletInfo(ctx, &chainlet)
 
 	return nil
 }
@@ -94,53 +93,55 @@ func (k *Keeper) UpgradeChainletStackVersion(ctx sdk.Context, chainId, stackVers
 
 	chainlet.ChainletStackVersion = stackVersion
 
-	updatedValue := k.cdc.MustMarshal(&chainlet)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
-	store.Set([]byte(chainId), updatedValue)
+	k.setChainletInfo(ctx, &chainlet)
 
 	return nil
 }
 
-func updateChainletParams(curParams *types.ChainletParams, params *types.ChainletParams) error { //nolint:unused
-	curElem := reflect.ValueOf(curParams).Elem()
-	newElem := reflect.ValueOf(params).Elem()
-	for i := 0; i < curElem.NumField(); i++ {
-		newValue := newElem.Field(i)
-		// reflection messed up the structs fields order, fast bail out
-		if curElem.Type().Field(i).Name != newElem.Type().Field(i).Name {
-			return fmt.Errorf("cannot update chainlet parameters")
-		}
-		// ensure to prevent unwanted wipes of current state params
-		if !valueIsNullOrBlank(newValue) {
-			curElem.Field(i).Set(newValue)
+// updateChainletParams sets the params named in the update mask, zero values included.
+func updateChainletParams(curParams *types.ChainletParams, params types.ChainletParams, updateMask []string) error {
+	for _, field := range updateMask {
+		switch field {
+		case types.ChainletParamGasLimit:
+			curParams.GasLimit = params.GasLimit
+		case types.ChainletParamCreateEmptyBlocks:
+			curParams.CreateEmptyBlocks = params.CreateEmptyBlocks
+		case types.ChainletParamDacEnable:
+			curParams.DacEnable = params.DacEnable
+		case types.ChainletParamFixedBaseFee:
+			curParams.FixedBaseFee = params.FixedBaseFee
+		case types.ChainletParamFeeAccount:
+			curParams.FeeAccount = params.FeeAccount
+		default:
+			return fmt.Errorf("param %s cannot be updated", field)
 		}
 	}
 	return nil
 }
 
-func valueIsNullOrBlank(val reflect.Value) bool { //nolint:unused
-	return val.Interface() == nil || val.Interface() == "" || val.IsZero()
-}
-
 func (k *Keeper) IsChainletStarted(ctx sdk.Context, chainId string) (bool, error) {
 	c, err := k.GetChainletInfo(ctx, chainId)
 	if err != nil {
 		return false, err
 	}
 
-	if c.Status == types.Status_STATUS_ONLINE {
-		return true, nil
-	}
-	return false, nil
+	return c.Status.IsActive(), nil
 }
 
+// StartExistingChainlet restarts a chainlet that was suspended because it could not be billed.
 func (k *Keeper) StartExistingChainlet(ctx sdk.Context, chainId string) error {
 	c, err := k.GetChainletInfo(ctx, chainId)
 	if err != nil {
 		return fmt.Errorf("cannot start existing chainlet %s: %v", chainId, err)
 	}
 
-	c.Status = types.Status_STATUS_ONLINE
+	if c.Status != types.Status_STATUS_SUSPENDED_BILLING {
+		return fmt.Errorf("cannot start existing chainlet %s: not suspended for billing (%s)", chainId, c.Status)
+	}
+	err = k.transitionStatus(ctx, c, k.resumedStatus(ctx, c))
+	if err != nil {
+		return fmt.Errorf("cannot start existing chainlet %s: %w", chainId, err)
+	}
 	k.setChainletInfo(ctx, c)
 
 	return nil
@@ -166,12 +167,16 @@ func (k *Keeper) GetChainletStackInfo(ctx sdk.Context, chainId string) (*types.C
 	return &stack, nil
 }
 
+// StopChainlet suspends a chainlet that could not be billed.
 func (k *Keeper) StopChainlet(ctx sdk.Context, chainId string) error {
 	c, err := k.GetChainletInfo(ctx, chainId)
 	if err != nil {
 		return fmt.Errorf("cannot stop chainlet %s: %v", chainId, err)
 	}
-	c.Status = types.Status_STATUS_OFFLINE
+	err = k.transitionStatus(ctx, c, types.Status_STATUS_SUSPENDED_BILLING)
+	if err != nil {
+		return fmt.Errorf("cannot stop chainlet %s: %w", chainId, err)
+	}
 	k.setChainletInfo(ctx, c)
 	ctx.Logger().Info(fmt.Sprintf("Successfully stopped chainlet %s", chainId))
 	return nil
@@ -199,11 +204,29 @@ func (k *Keeper) GetChainletInfo(ctx sdk.Context, chainId string) (*types.Chainl
 	return &c, nil
 }
 
+// setChainletInfo stores the chainlet and keeps its secondary indexes up to date. All
+// chainlet writes have to go through this function.
 func (k *Keeper) setChainletInfo(ctx sdk.Context, chainlet *types.Chainlet) {
 	lcStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
 	byteLCKey := []byte(chainlet.ChainId)
+	if lcStore.Has(byteLCKey) {
+		var old types.Chainlet
+		k.cdc.MustUnmarshal(lcStore.Get(byteLCKey), &old)
+		k.removeChainletIndexes(ctx, old)
+	}
 	updatedValue := k.cdc.MustMarshal(chainlet)
 	lcStore.Set(byteLCKey, updatedValue)
+	k.setChainletIndexes(ctx, *chainlet)
+}
+
+func (k *Keeper) deleteChainlet(ctx sdk.Context, chainId string) {
+	chainlet, err := k.Chainlet(ctx, chainId)
+	if err != nil {
+		return
+	}
+	lcStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
+	lcStore.Delete([]byte(chainId))
+	k.removeChainletIndexes(ctx, chainlet)
 }
 
 func (k Keeper) InitializeChainletCount(ctx sdk.Context) {
@@ -234,6 +257,14 @@ func (k Keeper) incrementChainletCount(ctx sdk.Context) {
 	k.SetChainletCount(ctx, count+1)
 }
 
+func (k Keeper) decrementChainletCount(ctx sdk.Context) {
+	count := k.GetChainletCount(ctx)
+	if count == 0 {
+		panic("chainlet count is already zero")
+	}
+	k.SetChainletCount(ctx, count-1)
+}
+
 func (k *Keeper) AutoUpgradeChainlets(ctx sdk.Context) error {
 	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey).Iterator(nil, nil)
 	defer func() {
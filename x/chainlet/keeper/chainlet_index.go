@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k *Keeper) setChainletIndexes(ctx sdk.Context, chainlet types.Chainlet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletIndexKey)
+	for _, key := range types.ChainletIndexKeys(chainlet) {
+		store.Set(key, []byte{})
+	}
+}
+
+func (k *Keeper) removeChainletIndexes(ctx sdk.Context, chainlet types.Chainlet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletIndexKey)
+	for _, key := range types.ChainletIndexKeys(chainlet) {
+		store.Delete(key)
+	}
+}
+
+// chainletFilters returns the index prefixes matching the filters set in the request, the
+// most selective first.
+func chainletFilters(req *types.QueryListChainletsRequest) (filters [][]byte) {
+	if req.Maintainer != "" {
+		filters = append(filters, types.ChainletIndexPrefix(types.MaintainerIndex, []byte(req.Maintainer)))
+	}
+	if req.Launcher != "" {
+		filters = append(filters, types.ChainletIndexPrefix(types.LauncherIndex, []byte(req.Launcher)))
+	}
+	if req.Tag != "" {
+		filters = append(filters, types.ChainletIndexPrefix(types.TagIndex, []byte(req.Tag)))
+	}
+	if req.StackVersion != "" {
+		filters = append(filters, types.ChainletIndexPrefix(types.StackVersionIndex, []byte(req.StackVersion)))
+	}
+	if req.StackName != "" {
+		filters = append(filters, types.ChainletIndexPrefix(types.StackNameIndex, []byte(req.StackName)))
+	}
+	if req.Status != types.Status_STATUS_OFFLINE {
+		filters = append(filters, types.ChainletIndexPrefix(types.StatusIndex, types.StatusIndexValue(req.Status)))
+	}
+	switch req.Ccv {
+	case types.CCVFilter_CCV_FILTER_CONSUMER:
+		filters = append(filters, types.ChainletIndexPrefix(types.CCVIndex, types.CCVIndexValue(true)))
+	case types.CCVFilter_CCV_FILTER_STANDALONE:
+		filters = append(filters, types.ChainletIndexPrefix(types.CCVIndex, types.CCVIndexValue(false)))
+	}
+	return
+}
+
+// chainletMatches checks that the chainlet has an index entry for each of the filters.
+func chainletMatches(chainlet types.Chainlet, filters [][]byte) bool {
+	keys := types.ChainletIndexKeys(chainlet)
+	for _, filter := range filters {
+		entry := append(append([]byte{}, filter...), chainlet.ChainId...)
+		found := false
+		for _, key := range keys {
+			if bytes.Equal(key, entry) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// DecommissionChainlet permanently removes a chainlet: its CCV consumer is removed, its billing
+// state is cleaned up, escrow funders are refunded, peers data is pruned and a tombstone is left
+// so that the chain ID cannot be reused.
+func (k *Keeper) DecommissionChainlet(ctx sdk.Context, chainId string, by string) error {
+	chainlet, err := k.Chainlet(ctx, chainId)
+	if err != nil {
+		return err
+	}
+	if chainlet.Upgrade != nil {
+		return fmt.Errorf("chainlet %s is being upgraded to %s", chainId, chainlet.Upgrade.Version)
+	}
+
+	err = k.transitionStatus(ctx, &chainlet, types.Status_STATUS_DECOMMISSIONED)
+	if err != nil {
+		return err
+	}
+
+	if chainlet.IsCCVConsumer {
+		err = k.removeConsumer(ctx, chainlet.ConsumerId)
+		if err != nil {
+			return cosmossdkerrors.Wrapf(err, "cannot remove consumer %s of chainlet %s", chainlet.ConsumerId, chainId)
+		}
+	}
+
+	err = k.billingKeeper.RefundUnusedEpochFee(ctx, chainlet)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(err, "cannot refund unused epoch fee of chainlet %s", chainId)
+	}
+
+	err = k.billingKeeper.OnChainletDecommissioned(ctx, chainlet)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(err, "cannot clean up billing state of chainlet %s", chainId)
+	}
+
+	err = k.escrowKeeper.RefundChainlet(ctx, chainId)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(err, "cannot refund escrow of chainlet %s", chainId)
+	}
+	k.peersKeeper.DeleteChainletData(ctx, chainId)
+
+	k.deleteChainlet(ctx, chainId)
+	k.deletePendingSpawn(ctx, chainId)
+	k.decrementChainletCount(ctx)
+
+	k.setTombstone(ctx, types.ChainletTombstone{
+		ChainId:          chainId,
+		Launcher:         chainlet.Launcher,
+		DecommissionedBy: by,
+		DecommissionTime: ctx.BlockTime(),
+	})
+
+	ctx.Logger().Info(fmt.Sprintf("decommissioned chainlet %s", chainId))
+	return nil
+}
+
+func (k *Keeper) removeConsumer(ctx sdk.Context, consumerID string) error {
+	// Not initialized yet
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletInit)
+	store.Delete([]byte(consumerID))
+
+	switch phase := k.providerKeeper.GetConsumerPhase(ctx, consumerID); phase {
+	case ccvprovidertypes.CONSUMER_PHASE_LAUNCHED:
+		_, err := k.providerMsgServer.RemoveConsumer(ctx, &ccvprovidertypes.MsgRemoveConsumer{
+			ConsumerId: consumerID,
+			Owner:      authtypes.NewModuleAddress(types.ModuleName).String(),
+		})
+		return err
+	case ccvprovidertypes.CONSUMER_PHASE_STOPPED, ccvprovidertypes.CONSUMER_PHASE_DELETED:
+		return nil
+	default:
+		return fmt.Errorf("consumer cannot be removed in phase %s, retry after it launched", phase)
+	}
+}
+
+func (k *Keeper) setTombstone(ctx sdk.Context, tombstone types.ChainletTombstone) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TombstoneKey)
+	store.Set([]byte(tombstone.ChainId), k.cdc.MustMarshal(&tombstone))
+}
+
+func (k *Keeper) IsTombstoned(ctx sdk.Context, chainId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TombstoneKey)
+	return store.Has([]byte(chainId))
+}
+
+// ExportTombstones exports all chainlet tombstones from the store
+func (k *Keeper) ExportTombstones(ctx sdk.Context) []types.ChainletTombstone {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TombstoneKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var tombstones []types.ChainletTombstone
+	for ; iterator.Valid(); iterator.Next() {
+		var tombstone types.ChainletTombstone
+		k.cdc.MustUnmarshal(iterator.Value(), &tombstone)
+		tombstones = append(tombstones, tombstone)
+	}
+	return tombstones
+}
+
+// ImportTombstone imports a single chainlet tombstone into the store
+func (k *Keeper) ImportTombstone(ctx sdk.Context, tombstone types.ChainletTombstone) {
+	k.setTombstone(ctx, tombstone)
+}
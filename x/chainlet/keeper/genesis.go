@@ -39,10 +39,10 @@ func (k *Keeper) ExportChainletStacks(ctx sdk.Context) []types.ChainletStack {
 
 // ImportChainlet imports a single chainlet into the store (without validation, for genesis import)
 func (k *Keeper) ImportChainlet(ctx sdk.Context, chainlet types.Chainlet) error {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
-	key := []byte(chainlet.ChainId)
-	value := k.cdc.MustMarshal(&chainlet)
-	store.Set(key, value)
+	k.setChainletInfo(ctx, &chainlet)
+	if chainlet.Status == types.Status_STATUS_PENDING_SPAWN {
+		k.setPendingSpawn(ctx, chainlet.ChainId)
+	}
 	return nil
 }
 
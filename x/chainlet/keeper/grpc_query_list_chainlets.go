@@ -16,22 +16,52 @@ func (k *Keeper) ListChainlets(goCtx context.Context, req *types.QueryListChainl
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if _, ok := types.Status_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+	if _, ok := types.CCVFilter_name[int32(req.Ccv)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid ccv filter")
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	var chainlets []*types.Chainlet
 	var err error
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
-		var chainlet types.Chainlet
-		if err := k.cdc.Unmarshal(value, &chainlet); err != nil {
-			return err
+	filters := chainletFilters(req)
+	if len(filters) == 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
+		pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+			var chainlet types.Chainlet
+			if err := k.cdc.Unmarshal(value, &chainlet); err != nil {
+				return err
+			}
+			chainlets = append(chainlets, &chainlet)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-		chainlets = append(chainlets, &chainlet)
-		return nil
-	})
 
+		return &types.QueryListChainletsResponse{Chainlets: chainlets, Pagination: pageRes}, nil
+	}
+
+	// Iterate over the index of the most selective filter and check the others on each chainlet
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletIndexKey)
+	store = prefix.NewStore(store, filters[0])
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		chainlet, err := k.Chainlet(ctx, string(key))
+		if err != nil {
+			return false, err
+		}
+		if !chainletMatches(chainlet, filters[1:]) {
+			return false, nil
+		}
+		if accumulate {
+			chainlets = append(chainlets, &chainlet)
+		}
+		return true, nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
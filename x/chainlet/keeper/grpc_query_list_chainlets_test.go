@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/sagaxyz/ssc/x/chainlet/keeper"
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (s *TestSuite) listChainletIDs(req *types.QueryListChainletsRequest) []string {
+	res, err := s.chainletKeeper.ListChainlets(s.ctx, req)
+	s.Require().NoError(err)
+
+	ids := make([]string, 0, len(res.Chainlets))
+	for _, chainlet := range res.Chainlets {
+		ids = append(ids, chainlet.ChainId)
+	}
+	return ids
+}
+
+func (s *TestSuite) TestListChainletsFilters() {
+	other := sdk.AccAddress("test4")
+
+	s.SetupTest()
+	s.launchTestChainlet("test_1-1", false)
+
+	// Consumer chainlet of another stack
+	s.providerMsgServer.EXPECT().
+		CreateConsumer(gomock.Any(), gomock.Any()).
+		Return(&ccvprovidertypes.MsgCreateConsumerResponse{ConsumerId: "0"}, nil)
+	s.providerKeeper.EXPECT().GetValidatorSetUpdateId(gomock.Any()).Return(uint64(1))
+	s.providerKeeper.EXPECT().AppendPendingVSCPackets(gomock.Any(), gomock.Eq("0"), gomock.Any())
+	s.providerKeeper.EXPECT().IncrementValidatorSetUpdateId(gomock.Any())
+	_, err := s.msgServer.CreateChainletStack(s.ctx, types.NewMsgCreateChainletStack(
+		creator.String(), "ccv", "ccv", "test/ccv:1.0.0", "1.0.0", "abcd", fees, true,
+	))
+	s.Require().NoError(err)
+	_, err = s.msgServer.LaunchChainlet(s.ctx, types.NewMsgLaunchChainlet(
+		creator.String(), []string{maintainer.String()}, "ccv", "1.0.0", "test", "test_10-1", "asaga", types.ChainletParams{}, nil, false, "",
+	))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.LaunchChainlet(s.ctx, types.NewMsgLaunchChainlet(
+		other.String(), []string{other.String()}, "test", "1.2.3", "other", "other_1-1", "asaga", types.ChainletParams{}, nil, false, "",
+	))
+	s.Require().NoError(err)
+	s.Require().NoError(s.chainletKeeper.StopChainlet(s.ctx, "other_1-1"))
+
+	tests := []struct {
+		name     string
+		req      types.QueryListChainletsRequest
+		expected []string
+	}{
+		{"no filter", types.QueryListChainletsRequest{}, []string{"other_1-1", "test_1-1", "test_10-1"}},
+		{"launcher", types.QueryListChainletsRequest{Launcher: creator.String()}, []string{"test_1-1", "test_10-1"}},
+		{"maintainer", types.QueryListChainletsRequest{Maintainer: other.String()}, []string{"other_1-1"}},
+		{"stack name", types.QueryListChainletsRequest{StackName: "test"}, []string{"other_1-1", "test_1-1"}},
+		{"unknown stack name", types.QueryListChainletsRequest{StackName: "tes"}, []string{}},
+		{"stack version", types.QueryListChainletsRequest{StackVersion: "1.0.0"}, []string{"test_10-1"}},
+		{"status", types.QueryListChainletsRequest{Status: types.Status_STATUS_SUSPENDED_BILLING}, []string{"other_1-1"}},
+		{"ccv", types.QueryListChainletsRequest{Ccv: types.CCVFilter_CCV_FILTER_CONSUMER}, []string{"test_10-1"}},
+		{"standalone", types.QueryListChainletsRequest{Ccv: types.CCVFilter_CCV_FILTER_STANDALONE}, []string{"other_1-1", "test_1-1"}},
+		{"combined", types.QueryListChainletsRequest{Launcher: creator.String(), Status: types.Status_STATUS_ONLINE}, []string{"test_1-1"}},
+		{"combined no match", types.QueryListChainletsRequest{Maintainer: other.String(), Ccv: types.CCVFilter_CCV_FILTER_CONSUMER}, []string{}},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Require().Equal(tt.expected, s.listChainletIDs(&tt.req))
+		})
+	}
+
+	// Pagination only counts matching chainlets
+	req := &types.QueryListChainletsRequest{
+		Ccv:        types.CCVFilter_CCV_FILTER_STANDALONE,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	}
+	res, err := s.chainletKeeper.ListChainlets(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(res.Chainlets, 1)
+	s.Require().Equal("other_1-1", res.Chainlets[0].ChainId)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	s.Require().Equal([]string{"test_1-1"}, s.listChainletIDs(req))
+
+	// Indexes follow updates
+	_, err = s.msgServer.AddChainletMaintainer(s.ctx, types.NewMsgAddChainletMaintainer(
+		creator.String(), "test_1-1", other.String(),
+	))
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"other_1-1", "test_1-1"}, s.listChainletIDs(&types.QueryListChainletsRequest{Maintainer: other.String()}))
+	s.Require().NoError(s.chainletKeeper.StartExistingChainlet(s.ctx, "other_1-1"))
+	s.Require().Empty(s.listChainletIDs(&types.QueryListChainletsRequest{Status: types.Status_STATUS_SUSPENDED_BILLING}))
+
+	_, err = s.chainletKeeper.ListChainlets(s.ctx, &types.QueryListChainletsRequest{Status: 42})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestMigrateChainletIndexes() {
+	s.SetupTest()
+
+	chainlet := types.Chainlet{
+		ChainId:              "test_1-1",
+		Launcher:             creator.String(),
+		Maintainers:          []string{maintainer.String()},
+		ChainletStackName:    "test",
+		ChainletStackVersion: "1.2.3",
+		Tags:                 []string{"a"},
+		Status:               types.Status_STATUS_ONLINE,
+	}
+	// Stored without indexes, as before the migration
+	store := prefix.NewStore(s.ctx.KVStore(s.storeKey), types.ChainletKey)
+	bz, err := chainlet.Marshal()
+	s.Require().NoError(err)
+	store.Set([]byte(chainlet.ChainId), bz)
+	s.Require().Empty(s.listChainletIDs(&types.QueryListChainletsRequest{Tag: "a"}))
+
+	err = keeper.NewMigrator(s.chainletKeeper, nil).Migrate4to5(s.ctx)
+	s.Require().NoError(err)
+
+	for _, req := range []types.QueryListChainletsRequest{
+		{Launcher: creator.String()},
+		{Maintainer: maintainer.String()},
+		{StackName: "test"},
+		{StackVersion: "1.2.3"},
+		{Tag: "a"},
+		{Status: types.Status_STATUS_ONLINE},
+		{Ccv: types.CCVFilter_CCV_FILTER_STANDALONE},
+	} {
+		s.Require().Equal([]string{"test_1-1"}, s.listChainletIDs(&req))
+	}
+}
@@ -60,7 +60,10 @@ func (k Keeper) OnAcknowledgementCreateUpgradePacket(ctx sdk.Context, packet cha
 			return err
 		}
 		if data.Name == planName {
-			k.cancelUpgrading(ctx, &chainlet)
+			err = k.cancelUpgrading(ctx, &chainlet)
+			if err != nil {
+				return err
+			}
 			ctx.Logger().Info(fmt.Sprintf("cancelled upgrade %s for chainlet %s: error ack: %s\n", planName, chainlet.ChainId, ack))
 		}
 
@@ -107,7 +110,10 @@ func (k Keeper) OnTimeoutCreateUpgradePacket(ctx sdk.Context, packet channeltype
 		return err
 	}
 	if data.Name == planName {
-		k.cancelUpgrading(ctx, &chainlet)
+		err = k.cancelUpgrading(ctx, &chainlet)
+		if err != nil {
+			return err
+		}
 		ctx.Logger().Info(fmt.Sprintf("cancelled upgrade %s for chainlet %s: timed out\n", planName, chainlet.ChainId))
 	}
 	return nil
@@ -171,7 +177,10 @@ func (k Keeper) OnAcknowledgementCancelUpgradePacket(ctx sdk.Context, packet cha
 			return err
 		}
 		if data.Plan == planName {
-			k.cancelUpgrading(ctx, &chainlet)
+			err = k.cancelUpgrading(ctx, &chainlet)
+			if err != nil {
+				return err
+			}
 			ctx.Logger().Info(fmt.Sprintf("cancelled upgrade %s for chainlet %s\n", planName, chainlet.ChainId))
 		} else {
 			ctx.Logger().Error(fmt.Sprintf("failed to cancel upgrade for chainlet %s: plan does not match (%s != %s)\n", chainlet.ChainId, data.Plan, planName))
@@ -25,7 +25,7 @@ func (s *TestSuite) ibcSetup(chainID, consumerID, channelID string) {
 		Return(nil).
 		AnyTimes()
 	s.billingKeeper.EXPECT().
-		BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.aclKeeper.EXPECT().
@@ -601,6 +601,13 @@ func (s *TestSuite) TestConfirmUpgradePacket() {
 				s.Require().NoError(err)
 				s.Require().Nil(chainlet.Upgrade)                         // not upgrading anymore
 				s.Require().Equal("2.0.0", chainlet.ChainletStackVersion) // new version set
+
+				// Indexed under the new version only
+				for version, n := range map[string]int{"1.2.3": 0, "2.0.0": 1} {
+					res, err := s.chainletKeeper.ListChainlets(s.ctx, &types.QueryListChainletsRequest{StackVersion: version})
+					s.Require().NoError(err)
+					s.Require().Len(res.Chainlets, n)
+				}
 			},
 		}, {
 			name: "incorrect client ID",
@@ -26,6 +26,7 @@ type Keeper struct {
 	providerKeeper    types.ProviderKeeper
 	escrowKeeper      types.EscrowKeeper
 	aclKeeper         types.AclKeeper
+	peersKeeper       types.PeersKeeper
 
 	stackVersions      map[string]*versions.Versions // display name => version tree
 	stackVersionParams map[string]map[string]types.ChainletStackParams
@@ -66,6 +67,13 @@ func NewKeeper(
 	}
 }
 
+func (k *Keeper) UpdateKeeper(newKeeper interface{}) {
+	switch v := newKeeper.(type) {
+	case types.PeersKeeper:
+		k.peersKeeper = v
+	}
+}
+
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -41,6 +41,7 @@ type TestSuite struct {
 	suite.Suite
 
 	chainletKeeper    *keeper.Keeper
+	storeKey          *storetypes.KVStoreKey
 	ctx               sdk.Context
 	msgServer         types.MsgServer
 	providerMsgServer *chainlettestutil.MockProviderMsgServer
@@ -52,6 +53,7 @@ type TestSuite struct {
 	aclKeeper         *chainlettestutil.MockAclKeeper
 	escrowKeeper      *chainlettestutil.MockEscrowKeeper
 	billingKeeper     *chainlettestutil.MockBillingKeeper
+	peersKeeper       *chainlettestutil.MockPeersKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -61,6 +63,7 @@ func TestKeeperTestSuite(t *testing.T) {
 func (s *TestSuite) SetupTest() {
 	encCfg := moduletestutil.MakeTestEncodingConfig(chainlet.AppModuleBasic{})
 	key := storetypes.NewKVStoreKey(types.StoreKey)
+	s.storeKey = key
 	paramsKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
 	paramsTKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 	ctx := testutil.DefaultContextWithKeys(
@@ -85,6 +88,7 @@ func (s *TestSuite) SetupTest() {
 	s.billingKeeper = chainlettestutil.NewMockBillingKeeper(ctrl)
 	s.escrowKeeper = chainlettestutil.NewMockEscrowKeeper(ctrl)
 	s.providerMsgServer = chainlettestutil.NewMockProviderMsgServer(ctrl)
+	s.peersKeeper = chainlettestutil.NewMockPeersKeeper(ctrl)
 
 	// Set up Staking keeper expectations for GetAllValidators since it's used in msg_server_launch_chainlet.go
 	s.stakingKeeper.EXPECT().
@@ -96,6 +100,16 @@ func (s *TestSuite) SetupTest() {
 		GetSupportedDenoms(gomock.Any()).
 		Return([]string{"utsaga", "utagas"}).
 		AnyTimes()
+
+	// Unused epoch fees are refunded when chainlets are suspended or decommissioned
+	s.billingKeeper.EXPECT().
+		RefundUnusedEpochFee(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	s.billingKeeper.EXPECT().
+		OnChainletDecommissioned(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
 	//nolint:staticcheck
 	paramsKeeper := paramskeeper.NewKeeper(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey)
 	paramsKeeper.Subspace(paramstypes.ModuleName)
@@ -114,6 +128,7 @@ func (s *TestSuite) SetupTest() {
 		s.escrowKeeper,
 		s.aclKeeper,
 	)
+	s.chainletKeeper.UpdateKeeper(s.peersKeeper)
 	s.msgServer = keeper.NewMsgServerImpl(s.chainletKeeper)
 
 	s.Require().Equal(s.ctx.Logger().With("module", "x/"+types.ModuleName),
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// canManageChainlet returns true if addr is allowed to change the maintainers of the chainlet.
+// Admins can only manage non-CCV chainlets, the same way they are only allowed to upgrade those.
+func (k Keeper) canManageChainlet(ctx sdk.Context, chainlet types.Chainlet, addr string) bool {
+	if chainlet.Launcher == addr || slices.Contains(chainlet.Maintainers, addr) {
+		return true
+	}
+	if chainlet.IsCCVConsumer {
+		return false
+	}
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return false
+	}
+	return k.aclKeeper.IsAdmin(ctx, acc)
+}
+
+// canTransferChainlet returns true if addr is allowed to transfer the launcher role of the chainlet.
+func (k Keeper) canTransferChainlet(ctx sdk.Context, chainlet types.Chainlet, addr string) bool {
+	if chainlet.Launcher == addr {
+		return true
+	}
+	if chainlet.IsCCVConsumer {
+		return false
+	}
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return false
+	}
+	return k.aclKeeper.IsAdmin(ctx, acc)
+}
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/sagaxyz/ssc/x/chainlet/exported"
 	//v2 "github.com/sagaxyz/ssc/x/chainlet/migrations/v2"
+	v4 "github.com/sagaxyz/ssc/x/chainlet/migrations/v4"
+	v5 "github.com/sagaxyz/ssc/x/chainlet/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -23,3 +27,13 @@ func NewMigrator(keeper *Keeper, ls exported.Subspace) Migrator {
 //func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 //	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 //}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
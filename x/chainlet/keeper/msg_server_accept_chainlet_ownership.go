@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) AcceptChainletOwnership(goCtx context.Context, msg *types.MsgAcceptChainletOwnership) (*types.MsgAcceptChainletOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgAcceptChainletOwnershipResponse{}, err
+	}
+
+	chainlet, err := k.Chainlet(ctx, msg.ChainId)
+	if err != nil {
+		return &types.MsgAcceptChainletOwnershipResponse{}, err
+	}
+	if chainlet.PendingLauncher == "" {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no pending ownership transfer for chainlet %s", chainlet.ChainId)
+	}
+	if chainlet.PendingLauncher != msg.Creator {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the pending launcher of chainlet %s", msg.Creator, chainlet.ChainId)
+	}
+
+	return &types.MsgAcceptChainletOwnershipResponse{}, k.transferOwnership(ctx, &chainlet, msg.Creator)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) AddChainletMaintainer(goCtx context.Context, msg *types.MsgAddChainletMaintainer) (*types.MsgAddChainletMaintainerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgAddChainletMaintainerResponse{}, err
+	}
+
+	chainlet, err := k.Chainlet(ctx, msg.ChainId)
+	if err != nil {
+		return &types.MsgAddChainletMaintainerResponse{}, err
+	}
+	if !k.canManageChainlet(ctx, chainlet, msg.Creator) {
+		return nil, cosmossdkerrors.Wrapf(types.ErrNotMaintainer, "address %s cannot add maintainers to chainlet %s", msg.Creator, chainlet.ChainId)
+	}
+	if slices.Contains(chainlet.Maintainers, msg.Maintainer) {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "address %s is already a maintainer of chainlet %s", msg.Maintainer, chainlet.ChainId)
+	}
+
+	chainlet.Maintainers = append(chainlet.Maintainers, msg.Maintainer)
+	k.setChainletInfo(ctx, &chainlet)
+
+	return &types.MsgAddChainletMaintainerResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainletMaintainerAdded{
+		ChainId:    chainlet.ChainId,
+		Maintainer: msg.Maintainer,
+		By:         msg.Creator,
+	})
+}
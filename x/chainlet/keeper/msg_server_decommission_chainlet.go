@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) DecommissionChainlet(goCtx context.Context, msg *types.MsgDecommissionChainlet) (*types.MsgDecommissionChainletResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgDecommissionChainletResponse{}, err
+	}
+
+	chainlet, err := k.Chainlet(ctx, msg.ChainId)
+	if err != nil {
+		return &types.MsgDecommissionChainletResponse{}, err
+	}
+	isLauncher := chainlet.Launcher == msg.Creator
+	isAdmin := k.aclKeeper.IsAdmin(ctx, msg.GetSigners()[0])
+	if !isLauncher && !isAdmin {
+		return nil, fmt.Errorf("address %s is not allowed to decommission this chainlet (must be launcher or admin)", msg.Creator)
+	}
+
+	err = k.Keeper.DecommissionChainlet(ctx, msg.ChainId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDecommissionChainletResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainletDecommissioned{
+		ChainId: msg.ChainId,
+		By:      msg.Creator,
+	})
+}
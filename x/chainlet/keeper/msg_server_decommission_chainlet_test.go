@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (s *TestSuite) TestDecommissionChainlet() {
+	stranger := sdk.AccAddress("test4")
+	chainID := "test_1-1"
+
+	for _, tc := range []struct {
+		name   string
+		ccv    bool
+		sender sdk.AccAddress
+	}{
+		{"launcher", false, creator},
+		{"admin", false, admin},
+		{"launcher - CCV", true, creator},
+		{"admin - CCV", true, admin},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.launchTestChainlet(chainID, tc.ccv)
+			s.Require().Equal(uint64(1), s.chainletKeeper.GetChainletCount(s.ctx))
+
+			// Maintainers and strangers cannot decommission
+			for _, addr := range []sdk.AccAddress{maintainer, stranger} {
+				_, err := s.msgServer.DecommissionChainlet(s.ctx, types.NewMsgDecommissionChainlet(addr.String(), chainID))
+				s.Require().Error(err)
+			}
+
+			if tc.ccv {
+				gomock.InOrder(
+					s.providerKeeper.EXPECT().
+						GetConsumerPhase(gomock.Any(), "0").
+						Return(ccvprovidertypes.CONSUMER_PHASE_LAUNCHED),
+					s.providerMsgServer.EXPECT().
+						RemoveConsumer(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ sdk.Context, msg *ccvprovidertypes.MsgRemoveConsumer) (*ccvprovidertypes.MsgRemoveConsumerResponse, error) {
+							s.Require().Equal("0", msg.ConsumerId)
+							return &ccvprovidertypes.MsgRemoveConsumerResponse{}, nil
+						}),
+				)
+			}
+			s.escrowKeeper.EXPECT().
+				RefundChainlet(gomock.Any(), chainID).
+				Return(nil)
+			s.peersKeeper.EXPECT().
+				DeleteChainletData(gomock.Any(), chainID)
+
+			_, err := s.msgServer.DecommissionChainlet(s.ctx, types.NewMsgDecommissionChainlet(tc.sender.String(), chainID))
+			s.Require().NoError(err)
+
+			s.Require().False(s.chainletKeeper.ChainletExists(s.ctx, chainID))
+			s.Require().True(s.chainletKeeper.IsTombstoned(s.ctx, chainID))
+			s.Require().Equal(uint64(0), s.chainletKeeper.GetChainletCount(s.ctx))
+
+			// The chain ID cannot be reused
+			_, err = s.msgServer.LaunchChainlet(s.ctx, types.NewMsgLaunchChainlet(
+				creator.String(), []string{maintainer.String()}, "test", "1.2.3", "test_chainlet", chainID, "asaga", types.ChainletParams{}, nil, false, "",
+			))
+			s.Require().ErrorIs(err, types.ErrChainletExists)
+		})
+	}
+}
+
+func (s *TestSuite) TestDecommissionChainletNotLaunchedConsumer() {
+	chainID := "test_1-1"
+
+	s.SetupTest()
+	s.launchTestChainlet(chainID, true)
+
+	s.providerKeeper.EXPECT().
+		GetConsumerPhase(gomock.Any(), "0").
+		Return(ccvprovidertypes.CONSUMER_PHASE_INITIALIZED)
+
+	_, err := s.msgServer.DecommissionChainlet(s.ctx, types.NewMsgDecommissionChainlet(creator.String(), chainID))
+	s.Require().Error(err)
+	s.Require().True(s.chainletKeeper.ChainletExists(s.ctx, chainID))
+	s.Require().False(s.chainletKeeper.IsTombstoned(s.ctx, chainID))
+}
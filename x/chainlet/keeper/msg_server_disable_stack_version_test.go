@@ -17,7 +17,7 @@ func (s *TestSuite) TestDisabledVersionsLaunch() {
 		Return(nil).
 		AnyTimes()
 	s.billingKeeper.EXPECT().
-		BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 	s.aclKeeper.EXPECT().
@@ -76,7 +76,7 @@ func (s *TestSuite) TestDisabledVersionsUpgrade() {
 		NewChainletAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil)
 	s.billingKeeper.EXPECT().
-		BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil)
 	s.aclKeeper.EXPECT().
 		IsAdmin(gomock.Any(), gomock.Any()).
@@ -170,7 +170,7 @@ func (s *TestSuite) TestDisabledVersionAutoUpgrade() {
 				NewChainletAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil)
 			s.billingKeeper.EXPECT().
-				BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil)
 			s.aclKeeper.EXPECT().
 				IsAdmin(gomock.Any(), gomock.Any()).
@@ -43,6 +43,9 @@ func (k msgServer) LaunchChainlet(goCtx context.Context, msg *types.MsgLaunchCha
 	if k.ChainletExists(ctx, msg.ChainId) {
 		return &types.MsgLaunchChainletResponse{}, types.ErrChainletExists
 	}
+	if k.IsTombstoned(ctx, msg.ChainId) {
+		return &types.MsgLaunchChainletResponse{}, cosmossdkerrors.Wrapf(types.ErrChainletExists, "chain id %s belongs to a decommissioned chainlet", msg.ChainId)
+	}
 
 	// pad genesis balances
 	for idx, bal := range msg.Params.GenAcctBalances.List {
@@ -140,8 +143,7 @@ func (k msgServer) LaunchChainlet(goCtx context.Context, msg *types.MsgLaunchCha
 			}
 
 			// Bill for the chainlet just after it is launched
-			totalFee := epochfee.Add(setupfee)
-			err = k.billingKeeper.BillAccount(ctx, totalFee, chainlet, "launching chainlet")
+			err = k.billingKeeper.BillEpochFee(ctx, epochfee, setupfee, chainlet, "launching chainlet")
 			if err == nil {
 				billed = true
 				break
@@ -155,6 +157,7 @@ func (k msgServer) LaunchChainlet(goCtx context.Context, msg *types.MsgLaunchCha
 	// Add as a CCV consumer if enabled
 	if chainlet.IsCCVConsumer {
 		chainlet.SpawnTime = ctx.BlockTime().Add(p.LaunchDelay)
+		chainlet.Status = types.Status_STATUS_PENDING_SPAWN
 		consumerId, err := k.addConsumer(ctx, chainlet.ChainId, chainlet.SpawnTime, ccvtypes.DefaultConsumerUnbondingPeriod)
 		if err != nil {
 			return nil, err
@@ -166,6 +169,9 @@ func (k msgServer) LaunchChainlet(goCtx context.Context, msg *types.MsgLaunchCha
 	if err != nil {
 		return nil, err
 	}
+	if chainlet.Status == types.Status_STATUS_PENDING_SPAWN {
+		k.setPendingSpawn(ctx, chainlet.ChainId)
+	}
 
 	return &types.MsgLaunchChainletResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventLaunchChainlet{
 		ChainName:    msg.ChainletName,
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ccvprovidertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// launchTestChainlet creates a stack and launches a chainlet with a single maintainer
+func (s *TestSuite) launchTestChainlet(chainID string, ccv bool) {
+	s.escrowKeeper.EXPECT().
+		NewChainletAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	s.billingKeeper.EXPECT().
+		BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	s.aclKeeper.EXPECT().
+		IsAdmin(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, addr sdk.AccAddress) bool {
+			return addr.Equals(admin)
+		}).
+		AnyTimes()
+	if ccv {
+		s.providerMsgServer.EXPECT().
+			CreateConsumer(gomock.Any(), gomock.Any()).
+			Return(&ccvprovidertypes.MsgCreateConsumerResponse{
+				ConsumerId: "0",
+			}, nil)
+		s.providerKeeper.EXPECT().
+			GetValidatorSetUpdateId(gomock.Any()).
+			Return(uint64(1))
+		s.providerKeeper.EXPECT().
+			AppendPendingVSCPackets(gomock.Any(), gomock.Eq("0"), gomock.Any())
+		s.providerKeeper.EXPECT().
+			IncrementValidatorSetUpdateId(gomock.Any())
+	}
+
+	ver := "1.2.3"
+	_, err := s.msgServer.CreateChainletStack(s.ctx, types.NewMsgCreateChainletStack(
+		creator.String(), "test", "test", "test/test:"+ver, ver, "abcd"+ver, fees, ccv,
+	))
+	s.Require().NoError(err)
+	_, err = s.msgServer.LaunchChainlet(s.ctx, types.NewMsgLaunchChainlet(
+		creator.String(), []string{maintainer.String()}, "test", ver, "test_chainlet", chainID, "asaga", types.ChainletParams{}, nil, false, "",
+	))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestAddRemoveChainletMaintainer() {
+	stranger := sdk.AccAddress("test4")
+	newMaintainer := sdk.AccAddress("test5")
+	chainID := "test_1-1"
+
+	for _, ccv := range []bool{false, true} {
+		s.SetupTest()
+		s.launchTestChainlet(chainID, ccv)
+
+		// Stranger cannot add or remove maintainers
+		_, err := s.msgServer.AddChainletMaintainer(s.ctx, types.NewMsgAddChainletMaintainer(
+			stranger.String(), chainID, stranger.String(),
+		))
+		s.Require().ErrorIs(err, types.ErrNotMaintainer)
+		_, err = s.msgServer.RemoveChainletMaintainer(s.ctx, types.NewMsgRemoveChainletMaintainer(
+			stranger.String(), chainID, maintainer.String(),
+		))
+		s.Require().ErrorIs(err, types.ErrNotMaintainer)
+
+		// Admin can only manage non-CCV chainlets
+		_, err = s.msgServer.AddChainletMaintainer(s.ctx, types.NewMsgAddChainletMaintainer(
+			admin.String(), chainID, admin.String(),
+		))
+		if ccv {
+			s.Require().ErrorIs(err, types.ErrNotMaintainer)
+		} else {
+			s.Require().NoError(err)
+			_, err = s.msgServer.RemoveChainletMaintainer(s.ctx, types.NewMsgRemoveChainletMaintainer(
+				admin.String(), chainID, admin.String(),
+			))
+			s.Require().NoError(err)
+		}
+
+		// Launcher adds a maintainer
+		_, err = s.msgServer.AddChainletMaintainer(s.ctx, types.NewMsgAddChainletMaintainer(
+			creator.String(), chainID, newMaintainer.String(),
+		))
+		s.Require().NoError(err)
+		_, err = s.msgServer.AddChainletMaintainer(s.ctx, types.NewMsgAddChainletMaintainer(
+			creator.String(), chainID, newMaintainer.String(),
+		))
+		s.Require().Error(err)
+
+		chainlet, err := s.chainletKeeper.Chainlet(s.ctx, chainID)
+		s.Require().NoError(err)
+		s.Require().Equal([]string{maintainer.String(), newMaintainer.String()}, chainlet.Maintainers)
+
+		// The new maintainer removes the original one
+		_, err = s.msgServer.RemoveChainletMaintainer(s.ctx, types.NewMsgRemoveChainletMaintainer(
+			newMaintainer.String(), chainID, maintainer.String(),
+		))
+		s.Require().NoError(err)
+		_, err = s.msgServer.RemoveChainletMaintainer(s.ctx, types.NewMsgRemoveChainletMaintainer(
+			newMaintainer.String(), chainID, maintainer.String(),
+		))
+		s.Require().Error(err)
+
+		// The last maintainer can only be removed from non-CCV chainlets
+		_, err = s.msgServer.RemoveChainletMaintainer(s.ctx, types.NewMsgRemoveChainletMaintainer(
+			creator.String(), chainID, newMaintainer.String(),
+		))
+		chainlet, err2 := s.chainletKeeper.Chainlet(s.ctx, chainID)
+		s.Require().NoError(err2)
+		if ccv {
+			s.Require().ErrorIs(err, types.ErrLastMaintainer)
+			s.Require().Equal([]string{newMaintainer.String()}, chainlet.Maintainers)
+		} else {
+			s.Require().NoError(err)
+			s.Require().Empty(chainlet.Maintainers)
+		}
+	}
+}
+
+func (s *TestSuite) TestTransferChainletOwnership() {
+	stranger := sdk.AccAddress("test4")
+	newLauncher := sdk.AccAddress("test5")
+	chainID := "test_1-1"
+
+	s.SetupTest()
+	s.launchTestChainlet(chainID, true)
+
+	// Only the launcher can transfer the ownership of a CCV chainlet
+	for _, addr := range []sdk.AccAddress{maintainer, admin, stranger} {
+		_, err := s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+			addr.String(), chainID, newLauncher.String(), false,
+		))
+		s.Require().Error(err)
+	}
+
+	// Two-step transfer
+	_, err := s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+		creator.String(), chainID, newLauncher.String(), true,
+	))
+	s.Require().NoError(err)
+	chainlet, err := s.chainletKeeper.Chainlet(s.ctx, chainID)
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), chainlet.Launcher)
+	s.Require().Equal(newLauncher.String(), chainlet.PendingLauncher)
+
+	_, err = s.msgServer.AcceptChainletOwnership(s.ctx, types.NewMsgAcceptChainletOwnership(
+		stranger.String(), chainID,
+	))
+	s.Require().Error(err)
+
+	// The launcher cancels the pending transfer by transferring to nobody or to itself
+	for _, to := range []string{"", creator.String()} {
+		_, err = s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+			creator.String(), chainID, newLauncher.String(), true,
+		))
+		s.Require().NoError(err)
+		_, err = s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+			creator.String(), chainID, to, false,
+		))
+		s.Require().NoError(err)
+		chainlet, err = s.chainletKeeper.Chainlet(s.ctx, chainID)
+		s.Require().NoError(err)
+		s.Require().Equal(creator.String(), chainlet.Launcher)
+		s.Require().Empty(chainlet.PendingLauncher)
+		_, err = s.msgServer.AcceptChainletOwnership(s.ctx, types.NewMsgAcceptChainletOwnership(
+			newLauncher.String(), chainID,
+		))
+		s.Require().Error(err)
+	}
+	// Nothing left to cancel
+	_, err = s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+		creator.String(), chainID, "", false,
+	))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+		creator.String(), chainID, newLauncher.String(), true,
+	))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AcceptChainletOwnership(s.ctx, types.NewMsgAcceptChainletOwnership(
+		newLauncher.String(), chainID,
+	))
+	s.Require().NoError(err)
+	chainlet, err = s.chainletKeeper.Chainlet(s.ctx, chainID)
+	s.Require().NoError(err)
+	s.Require().Equal(newLauncher.String(), chainlet.Launcher)
+	s.Require().Empty(chainlet.PendingLauncher)
+
+	// Nothing left to accept
+	_, err = s.msgServer.AcceptChainletOwnership(s.ctx, types.NewMsgAcceptChainletOwnership(
+		newLauncher.String(), chainID,
+	))
+	s.Require().Error(err)
+
+	// The old launcher lost the ownership
+	_, err = s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+		creator.String(), chainID, stranger.String(), false,
+	))
+	s.Require().Error(err)
+
+	// Direct transfer back
+	_, err = s.msgServer.TransferChainletOwnership(s.ctx, types.NewMsgTransferChainletOwnership(
+		newLauncher.String(), chainID, creator.String(), false,
+	))
+	s.Require().NoError(err)
+	chainlet, err = s.chainletKeeper.Chainlet(s.ctx, chainID)
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), chainlet.Launcher)
+}
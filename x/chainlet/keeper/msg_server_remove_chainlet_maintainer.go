@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) RemoveChainletMaintainer(goCtx context.Context, msg *types.MsgRemoveChainletMaintainer) (*types.MsgRemoveChainletMaintainerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgRemoveChainletMaintainerResponse{}, err
+	}
+
+	chainlet, err := k.Chainlet(ctx, msg.ChainId)
+	if err != nil {
+		return &types.MsgRemoveChainletMaintainerResponse{}, err
+	}
+	if !k.canManageChainlet(ctx, chainlet, msg.Creator) {
+		return nil, cosmossdkerrors.Wrapf(types.ErrNotMaintainer, "address %s cannot remove maintainers from chainlet %s", msg.Creator, chainlet.ChainId)
+	}
+	idx := slices.Index(chainlet.Maintainers, msg.Maintainer)
+	if idx < 0 {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "address %s is not a maintainer of chainlet %s", msg.Maintainer, chainlet.ChainId)
+	}
+	// Only maintainers can upgrade CCV chainlets, removing the last one would make the chainlet impossible to upgrade
+	if chainlet.IsCCVConsumer && len(chainlet.Maintainers) == 1 {
+		return nil, cosmossdkerrors.Wrapf(types.ErrLastMaintainer, "chainlet %s", chainlet.ChainId)
+	}
+
+	chainlet.Maintainers = slices.Delete(chainlet.Maintainers, idx, idx+1)
+	k.setChainletInfo(ctx, &chainlet)
+
+	return &types.MsgRemoveChainletMaintainerResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainletMaintainerRemoved{
+		ChainId:    chainlet.ChainId,
+		Maintainer: msg.Maintainer,
+		By:         msg.Creator,
+	})
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) ResumeChainlet(goCtx context.Context, msg *types.MsgResumeChainlet) (*types.MsgResumeChainletResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgResumeChainletResponse{}, err
+	}
+
+	if !k.aclKeeper.IsAdmin(ctx, msg.GetSigners()[0]) {
+		return nil, types.ErrUnauthorized.Wrap("only admins can resume chainlets")
+	}
+
+	err = k.Keeper.ResumeChainlet(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeChainletResponse{}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) SuspendChainlet(goCtx context.Context, msg *types.MsgSuspendChainlet) (*types.MsgSuspendChainletResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgSuspendChainletResponse{}, err
+	}
+
+	if !k.aclKeeper.IsAdmin(ctx, msg.GetSigners()[0]) {
+		return nil, types.ErrUnauthorized.Wrap("only admins can suspend chainlets")
+	}
+
+	err = k.Keeper.SuspendChainlet(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendChainletResponse{}, nil
+}
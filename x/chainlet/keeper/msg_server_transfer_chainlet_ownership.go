@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) TransferChainletOwnership(goCtx context.Context, msg *types.MsgTransferChainletOwnership) (*types.MsgTransferChainletOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgTransferChainletOwnershipResponse{}, err
+	}
+
+	chainlet, err := k.Chainlet(ctx, msg.ChainId)
+	if err != nil {
+		return &types.MsgTransferChainletOwnershipResponse{}, err
+	}
+	if !k.canTransferChainlet(ctx, chainlet, msg.Creator) {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s cannot transfer ownership of chainlet %s", msg.Creator, chainlet.ChainId)
+	}
+	// Transferring to nobody or to the current launcher cancels the pending transfer
+	if msg.NewLauncher == "" || msg.NewLauncher == chainlet.Launcher {
+		if chainlet.PendingLauncher == "" {
+			return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no pending ownership transfer of chainlet %s to cancel", chainlet.ChainId)
+		}
+		pendingLauncher := chainlet.PendingLauncher
+		chainlet.PendingLauncher = ""
+		k.setChainletInfo(ctx, &chainlet)
+
+		return &types.MsgTransferChainletOwnershipResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainletOwnershipTransferCancelled{
+			ChainId: chainlet.ChainId,
+			To:      pendingLauncher,
+			By:      msg.Creator,
+		})
+	}
+
+	if msg.RequireAccept {
+		chainlet.PendingLauncher = msg.NewLauncher
+		k.setChainletInfo(ctx, &chainlet)
+
+		return &types.MsgTransferChainletOwnershipResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainletOwnershipTransferProposed{
+			ChainId: chainlet.ChainId,
+			From:    chainlet.Launcher,
+			To:      msg.NewLauncher,
+		})
+	}
+
+	return &types.MsgTransferChainletOwnershipResponse{}, k.transferOwnership(ctx, &chainlet, msg.NewLauncher)
+}
+
+func (k Keeper) transferOwnership(ctx sdk.Context, chainlet *types.Chainlet, newLauncher string) error {
+	oldLauncher := chainlet.Launcher
+	chainlet.Launcher = newLauncher
+	chainlet.PendingLauncher = ""
+	k.setChainletInfo(ctx, chainlet)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChainletOwnershipTransferred{
+		ChainId: chainlet.ChainId,
+		From:    oldLauncher,
+		To:      newLauncher,
+	})
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (k msgServer) UpdateChainletParams(goCtx context.Context, msg *types.MsgUpdateChainletParams) (*types.MsgUpdateChainletParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgUpdateChainletParamsResponse{}, err
+	}
+
+	if k.IsTombstoned(ctx, msg.ChainId) {
+		return nil, cosmossdkerrors.Wrapf(types.ErrChainletNotActive, "chainlet %s was decommissioned", msg.ChainId)
+	}
+	chainlet, err := k.Chainlet(ctx, msg.ChainId)
+	if err != nil {
+		return &types.MsgUpdateChainletParamsResponse{}, err
+	}
+	if !slices.Contains(chainlet.Maintainers, msg.Creator) {
+		return nil, cosmossdkerrors.Wrapf(types.ErrNotMaintainer, "address %s cannot update params of chainlet %s", msg.Creator, chainlet.ChainId)
+	}
+	if !chainlet.Status.IsActive() {
+		return nil, cosmossdkerrors.Wrapf(types.ErrChainletNotActive, "cannot update params of chainlet %s with status %s", chainlet.ChainId, chainlet.Status)
+	}
+
+	err = updateChainletParams(&chainlet.Params, msg.Params, msg.UpdateMask)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update params of chainlet %s: %w", chainlet.ChainId, err)
+	}
+	k.setChainletInfo(ctx, &chainlet)
+
+	return &types.MsgUpdateChainletParamsResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventUpdateChainletParams{
+		ChainId: chainlet.ChainId,
+		Params:  chainlet.Params,
+		By:      msg.Creator,
+	})
+}
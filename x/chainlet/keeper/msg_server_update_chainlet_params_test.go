@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (s *TestSuite) TestUpdateChainletParams() {
+	stranger := sdk.AccAddress("test4")
+	feeAccount := sdk.AccAddress("test5")
+
+	initialParams := types.ChainletParams{
+		GasLimit:          10000000,
+		CreateEmptyBlocks: true,
+		FixedBaseFee:      "100",
+	}
+
+	testCases := []struct {
+		name       string
+		sender     sdk.AccAddress
+		params     types.ChainletParams
+		updateMask []string
+		expParams  types.ChainletParams
+		expErr     string
+	}{
+		{
+			"ok - maintainer updates a single param",
+			maintainer,
+			types.ChainletParams{
+				GasLimit: 20000000,
+			},
+			[]string{types.ChainletParamGasLimit},
+			types.ChainletParams{
+				GasLimit:          20000000,
+				CreateEmptyBlocks: true,
+				FixedBaseFee:      "100",
+			},
+			"",
+		},
+		{
+			"ok - maintainer updates multiple params",
+			maintainer,
+			types.ChainletParams{
+				DacEnable:    true,
+				FixedBaseFee: "200",
+				FeeAccount:   feeAccount.String(),
+			},
+			[]string{types.ChainletParamDacEnable, types.ChainletParamFixedBaseFee, types.ChainletParamFeeAccount},
+			types.ChainletParams{
+				GasLimit:          10000000,
+				CreateEmptyBlocks: true,
+				DacEnable:         true,
+				FixedBaseFee:      "200",
+				FeeAccount:        feeAccount.String(),
+			},
+			"",
+		},
+		{
+			"ok - maintainer resets params to their zero value",
+			maintainer,
+			types.ChainletParams{},
+			[]string{types.ChainletParamCreateEmptyBlocks, types.ChainletParamFixedBaseFee},
+			types.ChainletParams{
+				GasLimit: 10000000,
+			},
+			"",
+		},
+		{
+			"fail - launcher",
+			creator,
+			types.ChainletParams{
+				GasLimit: 20000000,
+			},
+			[]string{types.ChainletParamGasLimit},
+			initialParams,
+			"not allowed to manage chainlet",
+		},
+		{
+			"fail - admin",
+			admin,
+			types.ChainletParams{
+				GasLimit: 20000000,
+			},
+			[]string{types.ChainletParamGasLimit},
+			initialParams,
+			"not allowed to manage chainlet",
+		},
+		{
+			"fail - stranger",
+			stranger,
+			types.ChainletParams{
+				GasLimit: 20000000,
+			},
+			[]string{types.ChainletParamGasLimit},
+			initialParams,
+			"not allowed to manage chainlet",
+		},
+		{
+			"fail - maintainer sets gas limit too high",
+			maintainer,
+			types.ChainletParams{
+				GasLimit: types.ChainletGasLimit + 1,
+			},
+			[]string{types.ChainletParamGasLimit},
+			initialParams,
+			"gas limit too high",
+		},
+	}
+	for i, tc := range testCases {
+		s.Run(fmt.Sprintf("%d: %s", i, tc.name), func() {
+			s.SetupTest()
+
+			// Mocks we do not care about
+			s.escrowKeeper.EXPECT().
+				NewChainletAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).
+				AnyTimes()
+			s.billingKeeper.EXPECT().
+				BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).
+				AnyTimes()
+			s.aclKeeper.EXPECT().
+				IsAdmin(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, addr sdk.AccAddress) bool {
+					return addr.Equals(admin)
+				}).
+				AnyTimes()
+
+			// Create a stack and launch a chainlet
+			ver := "1.2.3"
+			_, err := s.msgServer.CreateChainletStack(s.ctx, types.NewMsgCreateChainletStack(
+				creator.String(), "test", "test", "test/test:"+ver, ver, "abcd"+ver, fees, false,
+			))
+			s.Require().NoError(err)
+			chainID := fmt.Sprintf("test_%d-1", i+1)
+			_, err = s.msgServer.LaunchChainlet(s.ctx, types.NewMsgLaunchChainlet(
+				creator.String(), []string{maintainer.String()}, "test", ver, "test_chainlet", chainID, "asaga", initialParams, nil, false, "",
+			))
+			s.Require().NoError(err)
+
+			// Update the params
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err = s.msgServer.UpdateChainletParams(s.ctx, types.NewMsgUpdateChainletParams(
+				tc.sender.String(), chainID, tc.params, tc.updateMask,
+			))
+			if tc.expErr == "" {
+				s.Require().NoError(err)
+
+				var found bool
+				for _, event := range s.ctx.EventManager().Events() {
+					if event.Type == "ssc.chainlet.EventUpdateChainletParams" {
+						found = true
+					}
+				}
+				s.Require().True(found)
+			} else {
+				s.Require().Error(err)
+				if !strings.Contains(err.Error(), tc.expErr) {
+					s.Require().Fail(fmt.Sprintf("err '%s' does not contain '%s'", err.Error(), tc.expErr))
+				}
+			}
+
+			chainlet, err := s.chainletKeeper.Chainlet(s.ctx, chainID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expParams, chainlet.Params)
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateChainletParamsBackToFalse() {
+	s.escrowKeeper.EXPECT().
+		NewChainletAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	s.billingKeeper.EXPECT().
+		BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	s.aclKeeper.EXPECT().
+		IsAdmin(gomock.Any(), gomock.Any()).
+		Return(false).
+		AnyTimes()
+
+	ver := "1.2.3"
+	_, err := s.msgServer.CreateChainletStack(s.ctx, types.NewMsgCreateChainletStack(
+		creator.String(), "test", "test", "test/test:"+ver, ver, "abcd"+ver, fees, false,
+	))
+	s.Require().NoError(err)
+	chainID := "test_1-1"
+	_, err = s.msgServer.LaunchChainlet(s.ctx, types.NewMsgLaunchChainlet(
+		creator.String(), []string{maintainer.String()}, "test", ver, "test_chainlet", chainID, "asaga", types.ChainletParams{}, nil, false, "",
+	))
+	s.Require().NoError(err)
+
+	// Enable DAC, then disable it again
+	for _, enable := range []bool{true, false} {
+		_, err = s.msgServer.UpdateChainletParams(s.ctx, types.NewMsgUpdateChainletParams(
+			maintainer.String(), chainID, types.ChainletParams{DacEnable: enable}, []string{types.ChainletParamDacEnable},
+		))
+		s.Require().NoError(err)
+
+		chainlet, err := s.chainletKeeper.Chainlet(s.ctx, chainID)
+		s.Require().NoError(err)
+		s.Require().Equal(enable, chainlet.Params.DacEnable)
+	}
+}
+
+func (s *TestSuite) TestUpdateChainletParamsInactive() {
+	chainID := "test_1-1"
+	update := func() error {
+		_, err := s.msgServer.UpdateChainletParams(s.ctx, types.NewMsgUpdateChainletParams(
+			maintainer.String(), chainID, types.ChainletParams{GasLimit: 20000000}, []string{types.ChainletParamGasLimit},
+		))
+		return err
+	}
+
+	s.SetupTest()
+	s.launchTestChainlet(chainID, false)
+
+	// Suspended chainlets keep their params
+	s.Require().NoError(s.chainletKeeper.StopChainlet(s.ctx, chainID))
+	s.Require().ErrorIs(update(), types.ErrChainletNotActive)
+	s.Require().NoError(s.chainletKeeper.StartExistingChainlet(s.ctx, chainID))
+	s.Require().NoError(update())
+
+	s.escrowKeeper.EXPECT().
+		RefundChainlet(gomock.Any(), chainID).
+		Return(nil)
+	s.peersKeeper.EXPECT().
+		DeleteChainletData(gomock.Any(), chainID)
+	_, err := s.msgServer.DecommissionChainlet(s.ctx, types.NewMsgDecommissionChainlet(creator.String(), chainID))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(update(), types.ErrChainletNotActive)
+}
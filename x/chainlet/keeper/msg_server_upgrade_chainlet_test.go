@@ -173,7 +173,7 @@ func (s *TestSuite) TestUpgradeChainlet() {
 				Return(nil).
 				AnyTimes()
 			s.billingKeeper.EXPECT().
-				BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil).
 				AnyTimes()
 
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// transitionStatus moves the chainlet to a new status and emits an event. Every status
+// change has to go through this function. The chainlet is not saved, callers are
+// expected to store it.
+func (k *Keeper) transitionStatus(ctx sdk.Context, chainlet *types.Chainlet, to types.Status) error {
+	from := chainlet.Status
+	err := types.ValidateStatusTransition(from, to)
+	if err != nil {
+		return fmt.Errorf("chainlet %s: %w", chainlet.ChainId, err)
+	}
+	chainlet.Status = to
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChainletStatusChanged{
+		ChainId: chainlet.ChainId,
+		From:    from,
+		To:      to,
+	})
+}
+
+// resumedStatus returns the status a suspended chainlet goes back to.
+func (k *Keeper) resumedStatus(ctx sdk.Context, chainlet *types.Chainlet) types.Status {
+	if k.isPendingSpawn(ctx, chainlet.ChainId) {
+		return types.Status_STATUS_PENDING_SPAWN
+	}
+	if chainlet.Upgrade != nil {
+		return types.Status_STATUS_UPGRADING
+	}
+	return types.Status_STATUS_ONLINE
+}
+
+// SuspendChainlet stops an active chainlet on behalf of an admin.
+func (k *Keeper) SuspendChainlet(ctx sdk.Context, chainId string) error {
+	chainlet, err := k.Chainlet(ctx, chainId)
+	if err != nil {
+		return err
+	}
+	err = k.transitionStatus(ctx, &chainlet, types.Status_STATUS_SUSPENDED_ADMIN)
+	if err != nil {
+		return err
+	}
+	k.setChainletInfo(ctx, &chainlet)
+
+	err = k.billingKeeper.RefundUnusedEpochFee(ctx, chainlet)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(err, "cannot refund unused epoch fee of chainlet %s", chainId)
+	}
+	return nil
+}
+
+// ResumeChainlet restarts a chainlet previously suspended by an admin. Unless it already paid for
+// the current billing epoch, the rest of the epoch is billed as at launch, without the setup fee.
+func (k *Keeper) ResumeChainlet(ctx sdk.Context, chainId string) error {
+	chainlet, err := k.Chainlet(ctx, chainId)
+	if err != nil {
+		return err
+	}
+	if chainlet.Status != types.Status_STATUS_SUSPENDED_ADMIN {
+		return fmt.Errorf("chainlet %s is not suspended by an admin (%s)", chainId, chainlet.Status)
+	}
+	if !chainlet.IsServiceChainlet && !k.billingKeeper.ChargedForCurrentEpoch(ctx, chainId) {
+		err = k.billResumedChainlet(ctx, chainlet)
+		if err != nil {
+			return err
+		}
+	}
+	err = k.transitionStatus(ctx, &chainlet, k.resumedStatus(ctx, &chainlet))
+	if err != nil {
+		return err
+	}
+	k.setChainletInfo(ctx, &chainlet)
+	return nil
+}
+
+// billResumedChainlet bills the epoch fee of a resumed chainlet with the first fee option of its
+// stack the escrow can pay.
+func (k *Keeper) billResumedChainlet(ctx sdk.Context, chainlet types.Chainlet) error {
+	stack, err := k.getChainletStack(ctx, chainlet.ChainletStackName)
+	if err != nil {
+		return err
+	}
+	for _, feeOption := range stack.Fees {
+		epochfee, err := sdk.ParseCoinNormalized(feeOption.EpochFee)
+		if err != nil {
+			return types.ErrInvalidCoin
+		}
+		err = k.billingKeeper.BillEpochFee(ctx, epochfee, sdk.NewCoin(epochfee.Denom, math.ZeroInt()), chainlet, "resuming chainlet")
+		if err == nil {
+			return nil
+		}
+	}
+	return cosmossdkerrors.Wrapf(types.ErrBillingFailure, "failed to bill resumed chainlet %s", chainlet.ChainId)
+}
+
+func (k *Keeper) setPendingSpawn(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpawnKey)
+	store.Set([]byte(chainId), k.cdc.MustMarshal(&types.PendingSpawn{}))
+}
+
+func (k *Keeper) isPendingSpawn(ctx sdk.Context, chainId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpawnKey)
+	return store.Has([]byte(chainId))
+}
+
+func (k *Keeper) deletePendingSpawn(ctx sdk.Context, chainId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpawnKey)
+	store.Delete([]byte(chainId))
+}
+
+// SpawnChainlets brings chainlets whose spawn time has passed online.
+func (k *Keeper) SpawnChainlets(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpawnKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		chainId := string(iterator.Key())
+		chainlet, err := k.Chainlet(ctx, chainId)
+		if err != nil {
+			panic(fmt.Sprintf("pending spawn of a missing chainlet %s", chainId))
+		}
+		if chainlet.SpawnTime.After(ctx.BlockTime()) {
+			continue
+		}
+
+		// Suspended chainlets keep their status and come online when resumed
+		if chainlet.Status == types.Status_STATUS_PENDING_SPAWN {
+			err = k.transitionStatus(ctx, &chainlet, types.Status_STATUS_ONLINE)
+			if err != nil {
+				panic(err)
+			}
+			k.setChainletInfo(ctx, &chainlet)
+		}
+		defer store.Delete(iterator.Key())
+	}
+}
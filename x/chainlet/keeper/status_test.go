@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/sagaxyz/ssc/x/chainlet/keeper"
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func (s *TestSuite) requireStatus(chainID string, status types.Status) {
+	chainlet, err := s.chainletKeeper.Chainlet(s.ctx, chainID)
+	s.Require().NoError(err)
+	s.Require().Equal(status, chainlet.Status)
+}
+
+func (s *TestSuite) TestChainletLifecycle() {
+	chainID := "test_1-1"
+
+	s.SetupTest()
+	s.billingKeeper.EXPECT().
+		ChargedForCurrentEpoch(gomock.Any(), chainID).
+		Return(false).
+		AnyTimes()
+	s.launchTestChainlet(chainID, false)
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+
+	// Billing suspension
+	s.Require().NoError(s.chainletKeeper.StopChainlet(s.ctx, chainID))
+	s.requireStatus(chainID, types.Status_STATUS_SUSPENDED_BILLING)
+	s.Require().ErrorIs(s.chainletKeeper.StopChainlet(s.ctx, chainID), types.ErrInvalidStatusTransition)
+	s.Require().NoError(s.chainletKeeper.StartExistingChainlet(s.ctx, chainID))
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+	s.Require().Error(s.chainletKeeper.StartExistingChainlet(s.ctx, chainID))
+
+	// Admin suspension
+	_, err := s.msgServer.SuspendChainlet(s.ctx, types.NewMsgSuspendChainlet(maintainer.String(), chainID))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.SuspendChainlet(s.ctx, types.NewMsgSuspendChainlet(admin.String(), chainID))
+	s.Require().NoError(err)
+	s.requireStatus(chainID, types.Status_STATUS_SUSPENDED_ADMIN)
+
+	// Billing cannot restart a chainlet suspended by an admin
+	s.Require().Error(s.chainletKeeper.StartExistingChainlet(s.ctx, chainID))
+	active, err := s.chainletKeeper.IsChainletStarted(s.ctx, chainID)
+	s.Require().NoError(err)
+	s.Require().False(active)
+
+	_, err = s.msgServer.ResumeChainlet(s.ctx, types.NewMsgResumeChainlet(maintainer.String(), chainID))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ResumeChainlet(s.ctx, types.NewMsgResumeChainlet(admin.String(), chainID))
+	s.Require().NoError(err)
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+	_, err = s.msgServer.ResumeChainlet(s.ctx, types.NewMsgResumeChainlet(admin.String(), chainID))
+	s.Require().Error(err)
+
+	// Every transition emitted an event
+	var transitions int
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == "ssc.chainlet.EventChainletStatusChanged" {
+			transitions++
+		}
+	}
+	s.Require().Equal(4, transitions)
+}
+
+func (s *TestSuite) TestResumeChainletBilling() {
+	chainID := "test_1-1"
+
+	s.SetupTest()
+	// Resuming bills the epoch fee without the setup fee unless already paid, the first attempt fails
+	charged := false
+	billingErrs := []error{fmt.Errorf("insufficient funds"), nil}
+	billed := 0
+	s.billingKeeper.EXPECT().
+		ChargedForCurrentEpoch(gomock.Any(), chainID).
+		DoAndReturn(func(_ sdk.Context, _ string) bool { return charged }).
+		AnyTimes()
+	s.billingKeeper.EXPECT().
+		BillEpochFee(gomock.Any(), sdk.NewInt64Coin("utsaga", 10), sdk.NewInt64Coin("utsaga", 0), gomock.Any(), "resuming chainlet").
+		DoAndReturn(func(_ sdk.Context, _, _ sdk.Coin, _ types.Chainlet, _ string) error {
+			err := billingErrs[billed]
+			billed++
+			return err
+		}).
+		AnyTimes()
+	s.launchTestChainlet(chainID, false)
+
+	_, err := s.msgServer.SuspendChainlet(s.ctx, types.NewMsgSuspendChainlet(admin.String(), chainID))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ResumeChainlet(s.ctx, types.NewMsgResumeChainlet(admin.String(), chainID))
+	s.Require().ErrorIs(err, types.ErrBillingFailure)
+	s.requireStatus(chainID, types.Status_STATUS_SUSPENDED_ADMIN)
+
+	_, err = s.msgServer.ResumeChainlet(s.ctx, types.NewMsgResumeChainlet(admin.String(), chainID))
+	s.Require().NoError(err)
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+	s.Require().Equal(2, billed)
+
+	// A chainlet that was not refunded on suspension is not billed twice for the epoch
+	charged = true
+	_, err = s.msgServer.SuspendChainlet(s.ctx, types.NewMsgSuspendChainlet(admin.String(), chainID))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ResumeChainlet(s.ctx, types.NewMsgResumeChainlet(admin.String(), chainID))
+	s.Require().NoError(err)
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+	s.Require().Equal(2, billed)
+}
+
+func (s *TestSuite) TestChainletPendingSpawn() {
+	chainID := "test_1-1"
+
+	s.SetupTest()
+	s.launchTestChainlet(chainID, true)
+	s.requireStatus(chainID, types.Status_STATUS_PENDING_SPAWN)
+
+	// Still waiting for the spawn time
+	s.chainletKeeper.SpawnChainlets(s.ctx)
+	s.requireStatus(chainID, types.Status_STATUS_PENDING_SPAWN)
+
+	// Suspended before spawning, resumed after
+	s.Require().NoError(s.chainletKeeper.StopChainlet(s.ctx, chainID))
+	s.Require().NoError(s.chainletKeeper.StartExistingChainlet(s.ctx, chainID))
+	s.requireStatus(chainID, types.Status_STATUS_PENDING_SPAWN)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.DefaultParams().LaunchDelay))
+	s.chainletKeeper.SpawnChainlets(s.ctx)
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+
+	// Spawning only happens once
+	s.Require().NoError(s.chainletKeeper.StopChainlet(s.ctx, chainID))
+	s.chainletKeeper.SpawnChainlets(s.ctx)
+	s.Require().NoError(s.chainletKeeper.StartExistingChainlet(s.ctx, chainID))
+	s.requireStatus(chainID, types.Status_STATUS_ONLINE)
+}
+
+func (s *TestSuite) TestMigrateChainletStatus() {
+	s.SetupTest()
+
+	chainlets := map[string]struct {
+		chainlet types.Chainlet
+		expected types.Status
+	}{
+		"offline_1-1": {
+			types.Chainlet{Status: types.Status_STATUS_OFFLINE},
+			types.Status_STATUS_SUSPENDED_BILLING,
+		},
+		"online_1-1": {
+			types.Chainlet{Status: types.Status_STATUS_ONLINE},
+			types.Status_STATUS_ONLINE,
+		},
+		"upgrading_1-1": {
+			types.Chainlet{Status: types.Status_STATUS_ONLINE, Upgrade: &types.Upgrade{Height: 10, Version: "1.0.0"}},
+			types.Status_STATUS_UPGRADING,
+		},
+		"spawning_1-1": {
+			types.Chainlet{Status: types.Status_STATUS_ONLINE, SpawnTime: s.ctx.BlockTime().Add(time.Minute)},
+			types.Status_STATUS_PENDING_SPAWN,
+		},
+	}
+	for chainID, tc := range chainlets {
+		tc.chainlet.ChainId = chainID
+		s.Require().NoError(s.chainletKeeper.ImportChainlet(s.ctx, tc.chainlet))
+	}
+
+	err := keeper.NewMigrator(s.chainletKeeper, nil).Migrate3to4(s.ctx)
+	s.Require().NoError(err)
+
+	for chainID, tc := range chainlets {
+		s.requireStatus(chainID, tc.expected)
+	}
+
+	// Migrated pending chainlets come online at their spawn time
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Minute))
+	s.chainletKeeper.SpawnChainlets(s.ctx)
+	s.requireStatus("spawning_1-1", types.Status_STATUS_ONLINE)
+}
@@ -222,9 +222,15 @@ func (k *Keeper) setUpgrading(ctx sdk.Context, chainlet *types.Chainlet, version
 		Height:  height,
 		Version: version,
 	}
+	// Suspended chainlets keep their status until resumed
+	if chainlet.Status == types.Status_STATUS_ONLINE {
+		err = k.transitionStatus(ctx, chainlet, types.Status_STATUS_UPGRADING)
+		if err != nil {
+			return err
+		}
+	}
 
-	updatedValue := k.cdc.MustMarshal(chainlet)
-	store.Set(key, updatedValue)
+	k.setChainletInfo(ctx, chainlet)
 
 	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradingChainletsKey)
 	store.Set([]byte(chainlet.ChainId), k.cdc.MustMarshal(&types.UpgradingChainlet{}))
@@ -237,19 +243,30 @@ func (k *Keeper) finishUpgrading(ctx sdk.Context, chainlet *types.Chainlet) erro
 	}
 	chainlet.ChainletStackVersion = chainlet.Upgrade.Version
 	chainlet.Upgrade = nil
+	if chainlet.Status == types.Status_STATUS_UPGRADING {
+		err := k.transitionStatus(ctx, chainlet, types.Status_STATUS_ONLINE)
+		if err != nil {
+			return err
+		}
+	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
-	store.Set([]byte(chainlet.ChainId), k.cdc.MustMarshal(chainlet))
-	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradingChainletsKey)
+	k.setChainletInfo(ctx, chainlet)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradingChainletsKey)
 	store.Delete([]byte(chainlet.ChainId))
 	return nil
 }
 
-func (k *Keeper) cancelUpgrading(ctx sdk.Context, chainlet *types.Chainlet) {
+func (k *Keeper) cancelUpgrading(ctx sdk.Context, chainlet *types.Chainlet) error {
 	chainlet.Upgrade = nil
+	if chainlet.Status == types.Status_STATUS_UPGRADING {
+		err := k.transitionStatus(ctx, chainlet, types.Status_STATUS_ONLINE)
+		if err != nil {
+			return err
+		}
+	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainletKey)
-	store.Set([]byte(chainlet.ChainId), k.cdc.MustMarshal(chainlet))
-	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradingChainletsKey)
+	k.setChainletInfo(ctx, chainlet)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradingChainletsKey)
 	store.Delete([]byte(chainlet.ChainId))
+	return nil
 }
@@ -106,7 +106,7 @@ func (s *TestSuite) TestUpgrade() {
 				Return(nil).
 				AnyTimes()
 			s.billingKeeper.EXPECT().
-				BillAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				BillEpochFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil).
 				AnyTimes()
 			s.providerKeeper.EXPECT().
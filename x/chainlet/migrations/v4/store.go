@@ -0,0 +1,47 @@
+package v4
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// MigrateStore maps the legacy ONLINE/OFFLINE statuses to the chainlet lifecycle statuses.
+// Chainlets could only be stopped for non-payment, so offline chainlets become suspended
+// for billing.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.ChainletKey)
+	pendingSpawnStore := prefix.NewStore(ctx.KVStore(storeKey), types.PendingSpawnKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var chainlets []types.Chainlet
+	for ; iterator.Valid(); iterator.Next() {
+		var chainlet types.Chainlet
+		cdc.MustUnmarshal(iterator.Value(), &chainlet)
+		chainlets = append(chainlets, chainlet)
+	}
+
+	for _, chainlet := range chainlets {
+		switch {
+		case chainlet.Status == types.Status_STATUS_OFFLINE:
+			chainlet.Status = types.Status_STATUS_SUSPENDED_BILLING
+		case chainlet.Status != types.Status_STATUS_ONLINE:
+			continue
+		case chainlet.SpawnTime.After(ctx.BlockTime()):
+			chainlet.Status = types.Status_STATUS_PENDING_SPAWN
+			pendingSpawnStore.Set([]byte(chainlet.ChainId), cdc.MustMarshal(&types.PendingSpawn{}))
+		case chainlet.Upgrade != nil:
+			chainlet.Status = types.Status_STATUS_UPGRADING
+		default:
+			continue
+		}
+		store.Set([]byte(chainlet.ChainId), cdc.MustMarshal(&chainlet))
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package v5
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+// MigrateStore backfills the secondary indexes of all chainlets.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.ChainletKey)
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.ChainletIndexKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var chainlet types.Chainlet
+		cdc.MustUnmarshal(iterator.Value(), &chainlet)
+
+		for _, key := range types.ChainletIndexKeys(chainlet) {
+			indexStore.Set(key, []byte{})
+		}
+	}
+
+	return nil
+}
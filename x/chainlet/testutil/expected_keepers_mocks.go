@@ -293,6 +293,21 @@ func (mr *MockProviderMsgServerMockRecorder) CreateConsumer(goCtx, msg interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsumer", reflect.TypeOf((*MockProviderMsgServer)(nil).CreateConsumer), goCtx, msg)
 }
 
+// RemoveConsumer mocks base method.
+func (m *MockProviderMsgServer) RemoveConsumer(goCtx context.Context, msg *types4.MsgRemoveConsumer) (*types4.MsgRemoveConsumerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveConsumer", goCtx, msg)
+	ret0, _ := ret[0].(*types4.MsgRemoveConsumerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveConsumer indicates an expected call of RemoveConsumer.
+func (mr *MockProviderMsgServerMockRecorder) RemoveConsumer(goCtx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveConsumer", reflect.TypeOf((*MockProviderMsgServer)(nil).RemoveConsumer), goCtx, msg)
+}
+
 // MockClientKeeper is a mock of ClientKeeper interface.
 type MockClientKeeper struct {
 	ctrl     *gomock.Controller
@@ -444,18 +459,46 @@ func (m *MockBillingKeeper) EXPECT() *MockBillingKeeperMockRecorder {
 	return m.recorder
 }
 
-// BillAccount mocks base method.
-func (m *MockBillingKeeper) BillAccount(ctx types.Context, amount types.Coin, chainlet types6.Chainlet, memo string) error {
+// BillEpochFee mocks base method.
+func (m *MockBillingKeeper) BillEpochFee(ctx types.Context, epochFee, setupFee types.Coin, chainlet types6.Chainlet, memo string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BillAccount", ctx, amount, chainlet, memo)
+	ret := m.ctrl.Call(m, "BillEpochFee", ctx, epochFee, setupFee, chainlet, memo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// BillAccount indicates an expected call of BillAccount.
-func (mr *MockBillingKeeperMockRecorder) BillAccount(ctx, amount, chainlet, memo interface{}) *gomock.Call {
+// BillEpochFee indicates an expected call of BillEpochFee.
+func (mr *MockBillingKeeperMockRecorder) BillEpochFee(ctx, epochFee, setupFee, chainlet, memo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillAccount", reflect.TypeOf((*MockBillingKeeper)(nil).BillAccount), ctx, amount, chainlet, memo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillEpochFee", reflect.TypeOf((*MockBillingKeeper)(nil).BillEpochFee), ctx, epochFee, setupFee, chainlet, memo)
+}
+
+// ChargedForCurrentEpoch mocks base method.
+func (m *MockBillingKeeper) ChargedForCurrentEpoch(ctx types.Context, chainId string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargedForCurrentEpoch", ctx, chainId)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ChargedForCurrentEpoch indicates an expected call of ChargedForCurrentEpoch.
+func (mr *MockBillingKeeperMockRecorder) ChargedForCurrentEpoch(ctx, chainId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargedForCurrentEpoch", reflect.TypeOf((*MockBillingKeeper)(nil).ChargedForCurrentEpoch), ctx, chainId)
+}
+
+// OnChainletDecommissioned mocks base method.
+func (m *MockBillingKeeper) OnChainletDecommissioned(ctx types.Context, chainlet types6.Chainlet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnChainletDecommissioned", ctx, chainlet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnChainletDecommissioned indicates an expected call of OnChainletDecommissioned.
+func (mr *MockBillingKeeperMockRecorder) OnChainletDecommissioned(ctx, chainlet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnChainletDecommissioned", reflect.TypeOf((*MockBillingKeeper)(nil).OnChainletDecommissioned), ctx, chainlet)
 }
 
 // PayEpochFeeToValidator mocks base method.
@@ -472,6 +515,20 @@ func (mr *MockBillingKeeperMockRecorder) PayEpochFeeToValidator(ctx, epochFee, f
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayEpochFeeToValidator", reflect.TypeOf((*MockBillingKeeper)(nil).PayEpochFeeToValidator), ctx, epochFee, fromModuleName, valAddr, memo)
 }
 
+// RefundUnusedEpochFee mocks base method.
+func (m *MockBillingKeeper) RefundUnusedEpochFee(ctx types.Context, chainlet types6.Chainlet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundUnusedEpochFee", ctx, chainlet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundUnusedEpochFee indicates an expected call of RefundUnusedEpochFee.
+func (mr *MockBillingKeeperMockRecorder) RefundUnusedEpochFee(ctx, chainlet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundUnusedEpochFee", reflect.TypeOf((*MockBillingKeeper)(nil).RefundUnusedEpochFee), ctx, chainlet)
+}
+
 // MockEscrowKeeper is a mock of EscrowKeeper interface.
 type MockEscrowKeeper struct {
 	ctrl     *gomock.Controller
@@ -523,6 +580,20 @@ func (mr *MockEscrowKeeperMockRecorder) NewChainletAccount(ctx, address, chainId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChainletAccount", reflect.TypeOf((*MockEscrowKeeper)(nil).NewChainletAccount), ctx, address, chainId, depositAmount)
 }
 
+// RefundChainlet mocks base method.
+func (m *MockEscrowKeeper) RefundChainlet(ctx types.Context, chainId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundChainlet", ctx, chainId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundChainlet indicates an expected call of RefundChainlet.
+func (mr *MockEscrowKeeperMockRecorder) RefundChainlet(ctx, chainId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundChainlet", reflect.TypeOf((*MockEscrowKeeper)(nil).RefundChainlet), ctx, chainId)
+}
+
 // MockAclKeeper is a mock of AclKeeper interface.
 type MockAclKeeper struct {
 	ctrl     *gomock.Controller
@@ -573,3 +644,38 @@ func (mr *MockAclKeeperMockRecorder) IsAdmin(ctx, addr interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAclKeeper)(nil).IsAdmin), ctx, addr)
 }
+
+// MockPeersKeeper is a mock of PeersKeeper interface.
+type MockPeersKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPeersKeeperMockRecorder
+}
+
+// MockPeersKeeperMockRecorder is the mock recorder for MockPeersKeeper.
+type MockPeersKeeperMockRecorder struct {
+	mock *MockPeersKeeper
+}
+
+// NewMockPeersKeeper creates a new mock instance.
+func NewMockPeersKeeper(ctrl *gomock.Controller) *MockPeersKeeper {
+	mock := &MockPeersKeeper{ctrl: ctrl}
+	mock.recorder = &MockPeersKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPeersKeeper) EXPECT() *MockPeersKeeperMockRecorder {
+	return m.recorder
+}
+
+// DeleteChainletData mocks base method.
+func (m *MockPeersKeeper) DeleteChainletData(ctx types.Context, chainId string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteChainletData", ctx, chainId)
+}
+
+// DeleteChainletData indicates an expected call of DeleteChainletData.
+func (mr *MockPeersKeeperMockRecorder) DeleteChainletData(ctx, chainId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChainletData", reflect.TypeOf((*MockPeersKeeper)(nil).DeleteChainletData), ctx, chainId)
+}
@@ -30,18 +30,40 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Status int32
 
 const (
+	// Deprecated: replaced by the suspended statuses, kept for state created
+	// before the lifecycle migration
 	Status_STATUS_OFFLINE Status = 0
 	Status_STATUS_ONLINE  Status = 1
+	// Launched and waiting for its spawn time
+	Status_STATUS_PENDING_SPAWN Status = 2
+	// Stopped because it could not be billed
+	Status_STATUS_SUSPENDED_BILLING Status = 3
+	// Stopped by an admin
+	Status_STATUS_SUSPENDED_ADMIN Status = 4
+	// Running with a stack upgrade in progress
+	Status_STATUS_UPGRADING Status = 5
+	// Permanently shut down
+	Status_STATUS_DECOMMISSIONED Status = 6
 )
 
 var Status_name = map[int32]string{
 	0: "STATUS_OFFLINE",
 	1: "STATUS_ONLINE",
+	2: "STATUS_PENDING_SPAWN",
+	3: "STATUS_SUSPENDED_BILLING",
+	4: "STATUS_SUSPENDED_ADMIN",
+	5: "STATUS_UPGRADING",
+	6: "STATUS_DECOMMISSIONED",
 }
 
 var Status_value = map[string]int32{
-	"STATUS_OFFLINE": 0,
-	"STATUS_ONLINE":  1,
+	"STATUS_OFFLINE":           0,
+	"STATUS_ONLINE":            1,
+	"STATUS_PENDING_SPAWN":     2,
+	"STATUS_SUSPENDED_BILLING": 3,
+	"STATUS_SUSPENDED_ADMIN":   4,
+	"STATUS_UPGRADING":         5,
+	"STATUS_DECOMMISSIONED":    6,
 }
 
 func (x Status) String() string {
@@ -71,6 +93,8 @@ type Chainlet struct {
 	Upgrade              *Upgrade       `protobuf:"bytes,16,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 	GenesisStackVersion  string         `protobuf:"bytes,17,opt,name=genesisStackVersion,proto3" json:"genesisStackVersion,omitempty"`
 	ConsumerId           string         `protobuf:"bytes,18,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
+	// Launcher proposed by a two-step ownership transfer, pending acceptance
+	PendingLauncher string `protobuf:"bytes,19,opt,name=pendingLauncher,proto3" json:"pendingLauncher,omitempty"`
 }
 
 func (m *Chainlet) Reset()         { *m = Chainlet{} }
@@ -232,6 +256,13 @@ func (m *Chainlet) GetConsumerId() string {
 	return ""
 }
 
+func (m *Chainlet) GetPendingLauncher() string {
+	if m != nil {
+		return m.PendingLauncher
+	}
+	return ""
+}
+
 type Upgrade struct {
 	Height  uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -356,56 +387,174 @@ func (m *PendingInit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingInit proto.InternalMessageInfo
 
+type PendingSpawn struct {
+}
+
+func (m *PendingSpawn) Reset()         { *m = PendingSpawn{} }
+func (m *PendingSpawn) String() string { return proto.CompactTextString(m) }
+func (*PendingSpawn) ProtoMessage()    {}
+func (*PendingSpawn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08c7224137a3f4b, []int{4}
+}
+func (m *PendingSpawn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSpawn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSpawn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSpawn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSpawn.Merge(m, src)
+}
+func (m *PendingSpawn) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSpawn) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSpawn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSpawn proto.InternalMessageInfo
+
+// ChainletTombstone is kept for decommissioned chainlets so that their chain ID
+// cannot be reused.
+type ChainletTombstone struct {
+	ChainId          string    `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Launcher         string    `protobuf:"bytes,2,opt,name=launcher,proto3" json:"launcher,omitempty"`
+	DecommissionedBy string    `protobuf:"bytes,3,opt,name=decommissionedBy,proto3" json:"decommissionedBy,omitempty"`
+	DecommissionTime time.Time `protobuf:"bytes,4,opt,name=decommissionTime,proto3,stdtime" json:"decommissionTime"`
+}
+
+func (m *ChainletTombstone) Reset()         { *m = ChainletTombstone{} }
+func (m *ChainletTombstone) String() string { return proto.CompactTextString(m) }
+func (*ChainletTombstone) ProtoMessage()    {}
+func (*ChainletTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08c7224137a3f4b, []int{5}
+}
+func (m *ChainletTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainletTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainletTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainletTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainletTombstone.Merge(m, src)
+}
+func (m *ChainletTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainletTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainletTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainletTombstone proto.InternalMessageInfo
+
+func (m *ChainletTombstone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainletTombstone) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+func (m *ChainletTombstone) GetDecommissionedBy() string {
+	if m != nil {
+		return m.DecommissionedBy
+	}
+	return ""
+}
+
+func (m *ChainletTombstone) GetDecommissionTime() time.Time {
+	if m != nil {
+		return m.DecommissionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("ssc.chainlet.Status", Status_name, Status_value)
 	proto.RegisterType((*Chainlet)(nil), "ssc.chainlet.Chainlet")
 	proto.RegisterType((*Upgrade)(nil), "ssc.chainlet.Upgrade")
 	proto.RegisterType((*UpgradingChainlet)(nil), "ssc.chainlet.UpgradingChainlet")
 	proto.RegisterType((*PendingInit)(nil), "ssc.chainlet.PendingInit")
+	proto.RegisterType((*PendingSpawn)(nil), "ssc.chainlet.PendingSpawn")
+	proto.RegisterType((*ChainletTombstone)(nil), "ssc.chainlet.ChainletTombstone")
 }
 
 func init() { proto.RegisterFile("ssc/chainlet/chainlet.proto", fileDescriptor_f08c7224137a3f4b) }
 
 var fileDescriptor_f08c7224137a3f4b = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x5d, 0x4f, 0xdb, 0x30,
-	0x14, 0x6d, 0x46, 0xe9, 0xc7, 0x2d, 0x65, 0xd4, 0x74, 0x93, 0xd5, 0x4d, 0xa1, 0xaa, 0x26, 0xad,
-	0x42, 0x28, 0x99, 0xd8, 0xdb, 0xf6, 0x44, 0xab, 0x21, 0x55, 0x9a, 0x18, 0x4a, 0x0b, 0x0f, 0x7b,
-	0x41, 0x26, 0xf5, 0x52, 0x6b, 0x4d, 0x5c, 0xc5, 0x0e, 0x83, 0xfd, 0x0a, 0x7e, 0x16, 0x8f, 0x3c,
-	0xee, 0x69, 0x9b, 0xe0, 0x17, 0xec, 0x1f, 0x4c, 0xb9, 0x89, 0x81, 0xa8, 0x7d, 0xf3, 0x3d, 0xe7,
-	0x5c, 0x1f, 0xdf, 0x63, 0x1b, 0x5e, 0x29, 0xe5, 0xbb, 0xfe, 0x8c, 0x89, 0x68, 0xce, 0xf5, 0xc3,
-	0xc2, 0x59, 0xc4, 0x52, 0x4b, 0xb2, 0xa1, 0x94, 0xef, 0x18, 0xac, 0xd3, 0x0e, 0x64, 0x20, 0x91,
-	0x70, 0xd3, 0x55, 0xa6, 0xe9, 0xec, 0x04, 0x52, 0x06, 0x73, 0xee, 0x62, 0x75, 0x9e, 0x7c, 0x73,
-	0xb5, 0x08, 0xb9, 0xd2, 0x2c, 0x5c, 0xe4, 0x82, 0xde, 0x4a, 0x87, 0xb3, 0x05, 0x8b, 0x59, 0xa8,
-	0x32, 0x4d, 0xef, 0xdf, 0x3a, 0xd4, 0x86, 0x39, 0x43, 0x06, 0x50, 0x57, 0x0b, 0xf6, 0x23, 0x9a,
-	0x88, 0x90, 0x53, 0xab, 0x6b, 0xf5, 0x1b, 0xfb, 0x1d, 0x27, 0x73, 0x71, 0x8c, 0x8b, 0x33, 0x31,
-	0x2e, 0x83, 0xda, 0xcd, 0xef, 0x9d, 0xd2, 0xf5, 0x9f, 0x1d, 0xcb, 0x7b, 0x6c, 0x23, 0x1d, 0xa8,
-	0xcd, 0x59, 0x12, 0xf9, 0x33, 0x1e, 0xd3, 0x67, 0x5d, 0xab, 0x5f, 0xf7, 0x1e, 0x6a, 0xd2, 0x85,
-	0x46, 0xc8, 0x44, 0xa4, 0x99, 0x88, 0x78, 0xac, 0xe8, 0x5a, 0x77, 0xad, 0x5f, 0xf7, 0x9e, 0x42,
-	0x64, 0x0f, 0x5a, 0xe6, 0x9c, 0x63, 0xcd, 0xfc, 0xef, 0x47, 0x2c, 0xe4, 0xb4, 0x8c, 0xdb, 0x2c,
-	0x13, 0x64, 0x1f, 0xda, 0x05, 0xf0, 0x94, 0xc7, 0x4a, 0xc8, 0x88, 0xae, 0x63, 0xc3, 0x4a, 0x8e,
-	0x50, 0xa8, 0x22, 0x3e, 0x9a, 0xd2, 0x0a, 0xca, 0x4c, 0x49, 0x7a, 0xb0, 0x61, 0x3a, 0xd0, 0xb6,
-	0x8a, 0x74, 0x01, 0x23, 0x6d, 0x58, 0x9f, 0xf2, 0x48, 0x86, 0xb4, 0x86, 0x64, 0x56, 0x90, 0x0f,
-	0x50, 0xc9, 0x42, 0xa5, 0x75, 0x0c, 0xed, 0xb5, 0xf3, 0xf4, 0xfa, 0x1c, 0x93, 0xef, 0x31, 0x6a,
-	0x06, 0xe5, 0x34, 0x36, 0x2f, 0xef, 0x20, 0x7b, 0x50, 0x51, 0x9a, 0xe9, 0x44, 0x51, 0xe8, 0x5a,
-	0xfd, 0xcd, 0xfd, 0x76, 0xb1, 0x77, 0x8c, 0x9c, 0x97, 0x6b, 0xc8, 0x2e, 0x6c, 0xb1, 0x44, 0xcb,
-	0x93, 0x45, 0x10, 0xb3, 0x29, 0xc7, 0xc1, 0x68, 0xa3, 0x6b, 0xf5, 0x6b, 0xde, 0x12, 0x9e, 0x66,
-	0x19, 0xf0, 0x88, 0x2b, 0xa1, 0x4e, 0xd9, 0x5c, 0x4c, 0x99, 0x96, 0xb1, 0xa2, 0x1b, 0x98, 0xf9,
-	0x32, 0x41, 0x08, 0x94, 0x35, 0x0b, 0x14, 0x6d, 0xa2, 0x00, 0xd7, 0xe9, 0x0e, 0x42, 0x8d, 0x79,
-	0x7c, 0x21, 0x7c, 0x6e, 0x86, 0xa0, 0x9b, 0x68, 0xb7, 0x4c, 0x90, 0x37, 0xd0, 0x14, 0x6a, 0x38,
-	0x3c, 0x1d, 0xca, 0x48, 0x25, 0x21, 0x8f, 0xe9, 0x73, 0x54, 0x16, 0x41, 0xe2, 0x42, 0x35, 0xc9,
-	0x4e, 0x49, 0xb7, 0x30, 0xac, 0x17, 0xc5, 0x81, 0xf3, 0x11, 0x3c, 0xa3, 0x22, 0xef, 0x60, 0x3b,
-	0x3f, 0x6d, 0xe1, 0x8e, 0x5b, 0x78, 0x01, 0xab, 0x28, 0x62, 0x03, 0xf8, 0xb9, 0xdd, 0x68, 0x4a,
-	0x09, 0x0a, 0x9f, 0x20, 0xbd, 0x8f, 0x50, 0xcd, 0x5d, 0xc8, 0x4b, 0xa8, 0xcc, 0xb8, 0x08, 0x66,
-	0x1a, 0x9f, 0x7b, 0xd9, 0xcb, 0xab, 0xf4, 0x95, 0x5c, 0xe4, 0x46, 0xd9, 0x23, 0x36, 0x65, 0x6f,
-	0x1b, 0x5a, 0x59, 0xb3, 0x88, 0x02, 0x33, 0x7a, 0xaf, 0x09, 0x8d, 0x63, 0x1e, 0xa5, 0xd0, 0x28,
-	0x12, 0x7a, 0xd7, 0x85, 0x4a, 0x76, 0x6f, 0x84, 0xc0, 0xe6, 0x78, 0x72, 0x30, 0x39, 0x19, 0x9f,
-	0x7d, 0x39, 0x3c, 0xfc, 0x3c, 0x3a, 0xfa, 0xb4, 0x55, 0x22, 0x2d, 0x68, 0x1a, 0xec, 0x08, 0x21,
-	0x6b, 0x70, 0x70, 0x73, 0x67, 0x5b, 0xb7, 0x77, 0xb6, 0xf5, 0xf7, 0xce, 0xb6, 0xae, 0xef, 0xed,
-	0xd2, 0xed, 0xbd, 0x5d, 0xfa, 0x75, 0x6f, 0x97, 0xbe, 0xbe, 0x0d, 0x84, 0x9e, 0x25, 0xe7, 0x8e,
-	0x2f, 0x43, 0x57, 0xb1, 0x80, 0x5d, 0x5e, 0xfd, 0x74, 0xd3, 0x6f, 0x7d, 0xf9, 0xf8, 0xb1, 0xf5,
-	0xd5, 0x82, 0xab, 0xf3, 0x0a, 0x7e, 0xd0, 0xf7, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x74, 0xe5,
-	0x97, 0x68, 0x57, 0x04, 0x00, 0x00,
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xb6, 0x1a, 0x47, 0x71, 0x4e, 0x7e, 0x2a, 0x33, 0x6e, 0xc1, 0x79, 0x85, 0x63, 0x08, 0x03,
+	0x66, 0x04, 0x85, 0x3c, 0x64, 0x77, 0xdb, 0x95, 0xff, 0x1a, 0x08, 0x70, 0x14, 0x43, 0xb2, 0x33,
+	0x60, 0x37, 0x06, 0x2d, 0x71, 0x32, 0x31, 0x4b, 0x32, 0x44, 0xba, 0x6b, 0xf6, 0x14, 0xbd, 0xdf,
+	0x6b, 0xec, 0x1d, 0xd6, 0xcb, 0x5e, 0xee, 0x6a, 0x1b, 0x92, 0x17, 0x19, 0x44, 0x51, 0x8d, 0x35,
+	0x07, 0x43, 0xef, 0x78, 0xbe, 0xef, 0x3b, 0x3c, 0x87, 0x1f, 0x0f, 0x09, 0x5f, 0x72, 0xee, 0x77,
+	0xfd, 0x25, 0x61, 0xf1, 0x8a, 0x8a, 0x4f, 0x0b, 0x6b, 0x9d, 0x26, 0x22, 0x41, 0xc7, 0x9c, 0xfb,
+	0x56, 0x81, 0x35, 0x1b, 0x61, 0x12, 0x26, 0x92, 0xe8, 0x66, 0xab, 0x5c, 0xd3, 0x3c, 0x0f, 0x93,
+	0x24, 0x5c, 0xd1, 0xae, 0x8c, 0x16, 0x9b, 0x9f, 0xba, 0x82, 0x45, 0x94, 0x0b, 0x12, 0xad, 0x95,
+	0xc0, 0x7c, 0xb2, 0xc2, 0x7c, 0x4d, 0x52, 0x12, 0xf1, 0x5c, 0x63, 0xfe, 0xa6, 0x43, 0x6d, 0xa0,
+	0x18, 0xd4, 0x87, 0x43, 0xbe, 0x26, 0xbf, 0xc4, 0x53, 0x16, 0x51, 0xac, 0xb5, 0xb5, 0xce, 0xd1,
+	0x65, 0xd3, 0xca, 0xab, 0x58, 0x45, 0x15, 0x6b, 0x5a, 0x54, 0xe9, 0xd7, 0x3e, 0xfc, 0x75, 0x5e,
+	0x79, 0xff, 0xf7, 0xb9, 0xe6, 0x3e, 0xa6, 0xa1, 0x26, 0xd4, 0x56, 0x64, 0x13, 0xfb, 0x4b, 0x9a,
+	0xe2, 0x67, 0x6d, 0xad, 0x73, 0xe8, 0x7e, 0x8a, 0x51, 0x1b, 0x8e, 0x22, 0xc2, 0x62, 0x41, 0x58,
+	0x4c, 0x53, 0x8e, 0xf7, 0xda, 0x7b, 0x9d, 0x43, 0x77, 0x1b, 0x42, 0xaf, 0xa1, 0x5e, 0xf4, 0xe9,
+	0x09, 0xe2, 0xff, 0xec, 0x90, 0x88, 0xe2, 0xaa, 0xdc, 0x66, 0x97, 0x40, 0x97, 0xd0, 0x28, 0x81,
+	0xb7, 0x34, 0xe5, 0x2c, 0x89, 0xf1, 0xbe, 0x4c, 0x78, 0x92, 0x43, 0x18, 0x0e, 0x24, 0x6e, 0x07,
+	0x58, 0x97, 0xb2, 0x22, 0x44, 0x26, 0x1c, 0x17, 0x19, 0xb2, 0xec, 0x81, 0xa4, 0x4b, 0x18, 0x6a,
+	0xc0, 0x7e, 0x40, 0xe3, 0x24, 0xc2, 0x35, 0x49, 0xe6, 0x01, 0xfa, 0x0e, 0xf4, 0xdc, 0x54, 0x7c,
+	0x28, 0x4d, 0x7b, 0x65, 0x6d, 0x5f, 0x9f, 0x55, 0xf8, 0x3b, 0x91, 0x9a, 0x7e, 0x35, 0xb3, 0xcd,
+	0x55, 0x19, 0xe8, 0x35, 0xe8, 0x5c, 0x10, 0xb1, 0xe1, 0x18, 0xda, 0x5a, 0xe7, 0xf4, 0xb2, 0x51,
+	0xce, 0xf5, 0x24, 0xe7, 0x2a, 0x0d, 0xba, 0x00, 0x83, 0x6c, 0x44, 0x32, 0x5b, 0x87, 0x29, 0x09,
+	0xa8, 0x3c, 0x18, 0x3e, 0x6a, 0x6b, 0x9d, 0x9a, 0xbb, 0x83, 0x67, 0x5e, 0x86, 0x34, 0xa6, 0x9c,
+	0xf1, 0x5b, 0xb2, 0x62, 0x01, 0x11, 0x49, 0xca, 0xf1, 0xb1, 0xf4, 0x7c, 0x97, 0x40, 0x08, 0xaa,
+	0x82, 0x84, 0x1c, 0x9f, 0x48, 0x81, 0x5c, 0x67, 0x3b, 0x30, 0xee, 0xd1, 0xf4, 0x2d, 0xf3, 0x69,
+	0x71, 0x08, 0x7c, 0x2a, 0xcb, 0xed, 0x12, 0xe8, 0x2b, 0x38, 0x61, 0x7c, 0x30, 0xb8, 0x1d, 0x24,
+	0x31, 0xdf, 0x44, 0x34, 0xc5, 0xcf, 0xa5, 0xb2, 0x0c, 0xa2, 0x2e, 0x1c, 0x6c, 0xf2, 0x2e, 0xb1,
+	0x21, 0xcd, 0x7a, 0x51, 0x3e, 0xb0, 0x3a, 0x82, 0x5b, 0xa8, 0xd0, 0x37, 0x70, 0xa6, 0xba, 0x2d,
+	0xdd, 0x71, 0x5d, 0x5e, 0xc0, 0x53, 0x14, 0x6a, 0x01, 0xf8, 0xaa, 0x9c, 0x1d, 0x60, 0x24, 0x85,
+	0x5b, 0x08, 0xea, 0xc0, 0xf3, 0x35, 0x8d, 0x03, 0x16, 0x87, 0xe3, 0x62, 0x52, 0xcf, 0xa4, 0xe8,
+	0xbf, 0xb0, 0xf9, 0x3d, 0x1c, 0xa8, 0x7e, 0xd0, 0x4b, 0xd0, 0x97, 0x94, 0x85, 0x4b, 0x21, 0x1f,
+	0x46, 0xd5, 0x55, 0x51, 0x36, 0x4f, 0x6f, 0x55, 0x4b, 0xf9, 0xb8, 0x17, 0xa1, 0x79, 0x06, 0xf5,
+	0x3c, 0x99, 0xc5, 0x61, 0x61, 0x92, 0x79, 0x02, 0x47, 0x93, 0xbc, 0x88, 0x1d, 0x33, 0x61, 0x9e,
+	0xc2, 0xb1, 0x0a, 0xbd, 0xec, 0x05, 0x99, 0x7f, 0x68, 0x50, 0x2f, 0xb4, 0xd3, 0x24, 0x5a, 0x70,
+	0x91, 0xc4, 0x74, 0x7b, 0x66, 0xb5, 0xf2, 0xcc, 0xfe, 0xdf, 0x6b, 0xbb, 0x00, 0x23, 0xa0, 0x7e,
+	0x12, 0x45, 0x8c, 0x67, 0xfd, 0xd0, 0xa0, 0x7f, 0x87, 0xf7, 0xa4, 0x66, 0x07, 0x47, 0x93, 0xb2,
+	0x76, 0xca, 0xd4, 0xb3, 0xfb, 0xdc, 0x0f, 0x60, 0x27, 0xfb, 0xe2, 0x77, 0x0d, 0xf4, 0x7c, 0x78,
+	0x11, 0x82, 0x53, 0x6f, 0xda, 0x9b, 0xce, 0xbc, 0xf9, 0xcd, 0x9b, 0x37, 0x63, 0xdb, 0x19, 0x19,
+	0x15, 0x54, 0x87, 0x93, 0x02, 0x73, 0x24, 0xa4, 0x21, 0x0c, 0x0d, 0x05, 0x4d, 0x46, 0xce, 0xd0,
+	0x76, 0xae, 0xe6, 0xde, 0xa4, 0xf7, 0x83, 0x63, 0x3c, 0x43, 0xaf, 0x00, 0x2b, 0xc6, 0x9b, 0x79,
+	0x19, 0x39, 0x1a, 0xce, 0xfb, 0xf6, 0x78, 0x6c, 0x3b, 0x57, 0xc6, 0x1e, 0x6a, 0xc2, 0xcb, 0x1d,
+	0xb6, 0x37, 0xbc, 0xb6, 0x1d, 0xa3, 0x8a, 0x1a, 0x60, 0x28, 0x6e, 0x36, 0xb9, 0x72, 0x7b, 0xd9,
+	0xae, 0xc6, 0x3e, 0xfa, 0x02, 0x5e, 0x28, 0x74, 0x38, 0x1a, 0xdc, 0x5c, 0x5f, 0xdb, 0x9e, 0x67,
+	0xdf, 0x38, 0xa3, 0xa1, 0xa1, 0xf7, 0x7b, 0x1f, 0xee, 0x5b, 0xda, 0xc7, 0xfb, 0x96, 0xf6, 0xcf,
+	0x7d, 0x4b, 0x7b, 0xff, 0xd0, 0xaa, 0x7c, 0x7c, 0x68, 0x55, 0xfe, 0x7c, 0x68, 0x55, 0x7e, 0xfc,
+	0x3a, 0x64, 0x62, 0xb9, 0x59, 0x58, 0x7e, 0x12, 0x75, 0x39, 0x09, 0xc9, 0xbb, 0xbb, 0x5f, 0xbb,
+	0xd9, 0x07, 0xfb, 0xee, 0xf1, 0x8b, 0x15, 0x77, 0x6b, 0xca, 0x17, 0xba, 0x74, 0xea, 0xdb, 0x7f,
+	0x07, 0x00, 0x1a, 0x9d, 0xc6, 0x0a, 0xe1, 0x05, 0x00, 0x00,
 }
 
 func (m *Chainlet) Marshal() (dAtA []byte, err error) {
@@ -428,6 +577,15 @@ func (m *Chainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingLauncher) > 0 {
+		i -= len(m.PendingLauncher)
+		copy(dAtA[i:], m.PendingLauncher)
+		i = encodeVarintChainlet(dAtA, i, uint64(len(m.PendingLauncher)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
@@ -666,6 +824,81 @@ func (m *PendingInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingSpawn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSpawn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSpawn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainletTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainletTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainletTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DecommissionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DecommissionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintChainlet(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.DecommissionedBy) > 0 {
+		i -= len(m.DecommissionedBy)
+		copy(dAtA[i:], m.DecommissionedBy)
+		i = encodeVarintChainlet(dAtA, i, uint64(len(m.DecommissionedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintChainlet(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintChainlet(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChainlet(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChainlet(v)
 	base := offset
@@ -753,6 +986,10 @@ func (m *Chainlet) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovChainlet(uint64(l))
 	}
+	l = len(m.PendingLauncher)
+	if l > 0 {
+		n += 2 + l + sovChainlet(uint64(l))
+	}
 	return n
 }
 
@@ -790,6 +1027,38 @@ func (m *PendingInit) Size() (n int) {
 	return n
 }
 
+func (m *PendingSpawn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChainletTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovChainlet(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovChainlet(uint64(l))
+	}
+	l = len(m.DecommissionedBy)
+	if l > 0 {
+		n += 1 + l + sovChainlet(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DecommissionTime)
+	n += 1 + l + sovChainlet(uint64(l))
+	return n
+}
+
 func sovChainlet(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1358,6 +1627,38 @@ func (m *Chainlet) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingLauncher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingLauncher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChainlet(dAtA[iNdEx:])
@@ -1580,6 +1881,235 @@ func (m *PendingInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingSpawn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainlet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSpawn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSpawn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainlet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainletTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChainlet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainletTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainletTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecommissionedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecommissionedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecommissionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainlet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DecommissionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChainlet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChainlet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChainlet(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLaunchChainlet{}, "chainlet/LaunchChainlet", nil)
 	cdc.RegisterConcrete(&MsgUpdateChainletStack{}, "chainlet/UpdateChainletStack", nil)
 	cdc.RegisterConcrete(&MsgUpgradeChainlet{}, "chainlet/UpgradeChainlet", nil)
+	cdc.RegisterConcrete(&MsgUpdateChainletParams{}, "chainlet/UpdateChainletParams", nil)
+	cdc.RegisterConcrete(&MsgAddChainletMaintainer{}, "chainlet/AddChainletMaintainer", nil)
+	cdc.RegisterConcrete(&MsgRemoveChainletMaintainer{}, "chainlet/RemoveChainletMaintainer", nil)
+	cdc.RegisterConcrete(&MsgTransferChainletOwnership{}, "chainlet/TransferChainletOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptChainletOwnership{}, "chainlet/AcceptChainletOwnership", nil)
+	cdc.RegisterConcrete(&MsgDecommissionChainlet{}, "chainlet/DecommissionChainlet", nil)
+	cdc.RegisterConcrete(&MsgSuspendChainlet{}, "chainlet/SuspendChainlet", nil)
+	cdc.RegisterConcrete(&MsgResumeChainlet{}, "chainlet/ResumeChainlet", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -28,6 +36,22 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpgradeChainlet{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateChainletParams{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddChainletMaintainer{},
+		&MsgRemoveChainletMaintainer{},
+		&MsgTransferChainletOwnership{},
+		&MsgAcceptChainletOwnership{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDecommissionChainlet{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSuspendChainlet{},
+		&MsgResumeChainlet{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -23,4 +23,8 @@ var (
 	ErrInvalidVersion          = sdkerrors.Register(ModuleName, 6912, "invalid version")
 	ErrInvalidFees             = sdkerrors.Register(ModuleName, 6913, "invalid fees")
 	ErrDuplicateDenom          = sdkerrors.Register(ModuleName, 6914, "duplicate denom in fees")
+	ErrNotMaintainer           = sdkerrors.Register(ModuleName, 6915, "not allowed to manage chainlet")
+	ErrLastMaintainer          = sdkerrors.Register(ModuleName, 6916, "cannot remove the last maintainer of a consumer chainlet")
+	ErrInvalidStatusTransition = sdkerrors.Register(ModuleName, 6917, "invalid chainlet status transition")
+	ErrChainletNotActive       = sdkerrors.Register(ModuleName, 6918, "chainlet is not active")
 )
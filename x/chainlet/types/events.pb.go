@@ -479,263 +479,556 @@ func (m *EventUpdateChainletFees) GetBy() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventLaunchChainlet)(nil), "ssc.chainlet.EventLaunchChainlet")
-	proto.RegisterType((*EventNewChainletStack)(nil), "ssc.chainlet.EventNewChainletStack")
-	proto.RegisterType((*EventNewChainletStackVersion)(nil), "ssc.chainlet.EventNewChainletStackVersion")
-	proto.RegisterType((*EventChainletStackVersionDisabled)(nil), "ssc.chainlet.EventChainletStackVersionDisabled")
-	proto.RegisterType((*EventUpdateChainlet)(nil), "ssc.chainlet.EventUpdateChainlet")
-	proto.RegisterType((*EventChainletStopped)(nil), "ssc.chainlet.EventChainletStopped")
-	proto.RegisterType((*EventChainletRestarted)(nil), "ssc.chainlet.EventChainletRestarted")
-	proto.RegisterType((*EventUpdateChainletFees)(nil), "ssc.chainlet.EventUpdateChainletFees")
+type EventUpdateChainletParams struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId string         `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Params  ChainletParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	By      string         `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"`
 }
 
-func init() { proto.RegisterFile("ssc/chainlet/events.proto", fileDescriptor_7824474c202708e0) }
-
-var fileDescriptor_7824474c202708e0 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6e, 0xda, 0x40,
-	0x14, 0x66, 0x0c, 0xb4, 0x65, 0x84, 0xba, 0x98, 0xd2, 0xd6, 0x45, 0xc8, 0x6a, 0xbd, 0x69, 0x57,
-	0xb8, 0x52, 0x4f, 0x50, 0xf2, 0x23, 0x45, 0x42, 0x48, 0x01, 0x25, 0x8b, 0x64, 0x11, 0x8d, 0xed,
-	0x17, 0x63, 0x05, 0x3c, 0x96, 0x67, 0x20, 0x38, 0xa7, 0xc8, 0x1d, 0x72, 0x99, 0x2c, 0x59, 0x66,
-	0x19, 0xc1, 0x45, 0xa2, 0x19, 0xc6, 0x06, 0x23, 0x27, 0x52, 0x76, 0xef, 0xf7, 0x7b, 0xdf, 0x7c,
-	0x6f, 0x1e, 0xfe, 0xc1, 0xb9, 0xe7, 0x78, 0x63, 0x1a, 0x46, 0x13, 0x10, 0x0e, 0xcc, 0x21, 0x12,
-	0xbc, 0x1b, 0x27, 0x4c, 0x30, 0xd2, 0xe4, 0xdc, 0xeb, 0x66, 0xa9, 0x76, 0x2b, 0x60, 0x01, 0x53,
-	0x09, 0x47, 0x5a, 0x9b, 0x1a, 0xfb, 0x01, 0xe1, 0x2f, 0x47, 0xb2, 0xa9, 0x4f, 0x67, 0x91, 0x37,
-	0x3e, 0xd0, 0xd5, 0xa4, 0x83, 0x1b, 0xaa, 0x73, 0x40, 0xa7, 0x60, 0xa2, 0x9f, 0xe8, 0x4f, 0x63,
-	0xb8, 0x0d, 0x90, 0x36, 0xfe, 0x34, 0x51, 0xf5, 0x90, 0x98, 0x86, 0x4a, 0xe6, 0x3e, 0x31, 0xf1,
-	0x47, 0x55, 0x78, 0xe2, 0x9b, 0x55, 0x95, 0xca, 0x5c, 0xd2, 0xc2, 0x75, 0x2e, 0xa8, 0x77, 0x63,
-	0xd6, 0x54, 0x7c, 0xe3, 0x10, 0x1b, 0x37, 0x95, 0x71, 0x0e, 0x09, 0x0f, 0x59, 0x64, 0xd6, 0x55,
-	0xb2, 0x10, 0xb3, 0xaf, 0xf0, 0x57, 0x45, 0x72, 0x00, 0xb7, 0x19, 0xc3, 0x91, 0x6a, 0x96, 0xc3,
-	0x12, 0xa0, 0x82, 0x25, 0x9a, 0x64, 0xe6, 0x12, 0x82, 0x6b, 0x91, 0xe4, 0xbe, 0xa1, 0xa7, 0x6c,
-	0x59, 0x3d, 0xd7, 0x53, 0x34, 0x35, 0xed, 0xda, 0x7d, 0xdc, 0x29, 0x1d, 0xa0, 0x09, 0xe4, 0x68,
-	0xa8, 0x1c, 0xcd, 0x28, 0xa2, 0x9d, 0xe2, 0x5f, 0x0a, 0xad, 0x0c, 0xea, 0x30, 0xe4, 0xd4, 0x9d,
-	0x80, 0xff, 0x4e, 0xc8, 0x91, 0x5e, 0xd3, 0x59, 0xec, 0x53, 0x01, 0xf9, 0x9a, 0x76, 0xc4, 0x46,
-	0x45, 0xb1, 0xf7, 0x65, 0x35, 0x4a, 0x64, 0xfd, 0x8b, 0x5b, 0x7b, 0x3c, 0x59, 0x1c, 0x83, 0xff,
-	0x3a, 0xaa, 0xdd, 0xc3, 0xdf, 0x0a, 0x1d, 0x43, 0xe0, 0x82, 0x26, 0xe2, 0xad, 0x1e, 0xf2, 0x19,
-	0x1b, 0x6e, 0xaa, 0xe7, 0x1b, 0x6e, 0x6a, 0x5f, 0xe2, 0xef, 0x25, 0x4f, 0x39, 0x06, 0xe0, 0xf2,
-	0xd7, 0x29, 0x82, 0xbb, 0xbf, 0x2e, 0x0f, 0x48, 0xc5, 0xae, 0x01, 0x78, 0xb6, 0x52, 0x69, 0x6b,
-	0xf0, 0x6a, 0x06, 0xde, 0xfb, 0xff, 0xb8, 0xb2, 0xd0, 0x72, 0x65, 0xa1, 0xe7, 0x95, 0x85, 0xee,
-	0xd7, 0x56, 0x65, 0xb9, 0xb6, 0x2a, 0x4f, 0x6b, 0xab, 0x72, 0xf1, 0x3b, 0x08, 0xc5, 0x78, 0xe6,
-	0x76, 0x3d, 0x36, 0x75, 0x38, 0x0d, 0xe8, 0x22, 0xbd, 0x73, 0xe4, 0xed, 0x2c, 0xb6, 0xd7, 0x23,
-	0xd2, 0x18, 0xb8, 0xfb, 0x41, 0x5d, 0xc6, 0xbf, 0x97, 0x00, 0x00, 0x00, 0xff, 0xff, 0x81, 0x99,
-	0xef, 0x54, 0x5a, 0x03, 0x00, 0x00,
+func (m *EventUpdateChainletParams) Reset()         { *m = EventUpdateChainletParams{} }
+func (m *EventUpdateChainletParams) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateChainletParams) ProtoMessage()    {}
+func (*EventUpdateChainletParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{8}
 }
-
-func (m *EventLaunchChainlet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateChainletParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateChainletParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateChainletParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventLaunchChainlet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUpdateChainletParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateChainletParams.Merge(m, src)
+}
+func (m *EventUpdateChainletParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateChainletParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateChainletParams.DiscardUnknown(m)
 }
 
-func (m *EventLaunchChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StackVersion) > 0 {
-		i -= len(m.StackVersion)
-		copy(dAtA[i:], m.StackVersion)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StackVersion)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Stack) > 0 {
-		i -= len(m.Stack)
-		copy(dAtA[i:], m.Stack)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Stack)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Launcher) > 0 {
-		i -= len(m.Launcher)
-		copy(dAtA[i:], m.Launcher)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Launcher)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventUpdateChainletParams proto.InternalMessageInfo
+
+func (m *EventUpdateChainletParams) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.ChainName) > 0 {
-		i -= len(m.ChainName)
-		copy(dAtA[i:], m.ChainName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainName)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUpdateChainletParams) GetParams() ChainletParams {
+	if m != nil {
+		return m.Params
 	}
-	return len(dAtA) - i, nil
+	return ChainletParams{}
 }
 
-func (m *EventNewChainletStack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateChainletParams) GetBy() string {
+	if m != nil {
+		return m.By
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNewChainletStack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventChainletMaintainerAdded struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId    string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Maintainer string `protobuf:"bytes,2,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	By         string `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"`
 }
 
-func (m *EventNewChainletStack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventChainletMaintainerAdded) Reset()         { *m = EventChainletMaintainerAdded{} }
+func (m *EventChainletMaintainerAdded) String() string { return proto.CompactTextString(m) }
+func (*EventChainletMaintainerAdded) ProtoMessage()    {}
+func (*EventChainletMaintainerAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{9}
+}
+func (m *EventChainletMaintainerAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletMaintainerAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletMaintainerAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventChainletMaintainerAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletMaintainerAdded.Merge(m, src)
+}
+func (m *EventChainletMaintainerAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletMaintainerAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletMaintainerAdded.DiscardUnknown(m)
 }
 
-func (m *EventNewChainletStackVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventChainletMaintainerAdded proto.InternalMessageInfo
+
+func (m *EventChainletMaintainerAdded) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventNewChainletStackVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventChainletMaintainerAdded) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
+	}
+	return ""
 }
 
-func (m *EventNewChainletStackVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventChainletMaintainerAdded) GetBy() string {
+	if m != nil {
+		return m.By
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventChainletStackVersionDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type EventChainletMaintainerRemoved struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId    string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Maintainer string `protobuf:"bytes,2,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	By         string `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"`
 }
 
-func (m *EventChainletStackVersionDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventChainletMaintainerRemoved) Reset()         { *m = EventChainletMaintainerRemoved{} }
+func (m *EventChainletMaintainerRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventChainletMaintainerRemoved) ProtoMessage()    {}
+func (*EventChainletMaintainerRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{10}
+}
+func (m *EventChainletMaintainerRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletMaintainerRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletMaintainerRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainletMaintainerRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletMaintainerRemoved.Merge(m, src)
+}
+func (m *EventChainletMaintainerRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletMaintainerRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletMaintainerRemoved.DiscardUnknown(m)
 }
 
-func (m *EventChainletStackVersionDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventChainletMaintainerRemoved proto.InternalMessageInfo
+
+func (m *EventChainletMaintainerRemoved) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventChainletMaintainerRemoved) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUpdateChainlet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventChainletMaintainerRemoved) GetBy() string {
+	if m != nil {
+		return m.By
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateChainlet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventChainletOwnershipTransferProposed struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (m *EventUpdateChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StackVersion) > 0 {
-		i -= len(m.StackVersion)
-		copy(dAtA[i:], m.StackVersion)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StackVersion)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventChainletOwnershipTransferProposed) Reset() {
+	*m = EventChainletOwnershipTransferProposed{}
+}
+func (m *EventChainletOwnershipTransferProposed) String() string { return proto.CompactTextString(m) }
+func (*EventChainletOwnershipTransferProposed) ProtoMessage()    {}
+func (*EventChainletOwnershipTransferProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{11}
+}
+func (m *EventChainletOwnershipTransferProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletOwnershipTransferProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletOwnershipTransferProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventChainletOwnershipTransferProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletOwnershipTransferProposed.Merge(m, src)
+}
+func (m *EventChainletOwnershipTransferProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletOwnershipTransferProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletOwnershipTransferProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainletOwnershipTransferProposed proto.InternalMessageInfo
+
+func (m *EventChainletOwnershipTransferProposed) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventChainletStopped) Marshal() (dAtA []byte, err error) {
+func (m *EventChainletOwnershipTransferProposed) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventChainletOwnershipTransferProposed) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type EventChainletOwnershipTransferCancelled struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// Pending launcher that can no longer accept the transfer
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	By string `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"`
+}
+
+func (m *EventChainletOwnershipTransferCancelled) Reset() {
+	*m = EventChainletOwnershipTransferCancelled{}
+}
+func (m *EventChainletOwnershipTransferCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventChainletOwnershipTransferCancelled) ProtoMessage()    {}
+func (*EventChainletOwnershipTransferCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{12}
+}
+func (m *EventChainletOwnershipTransferCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletOwnershipTransferCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletOwnershipTransferCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainletOwnershipTransferCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletOwnershipTransferCancelled.Merge(m, src)
+}
+func (m *EventChainletOwnershipTransferCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletOwnershipTransferCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletOwnershipTransferCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainletOwnershipTransferCancelled proto.InternalMessageInfo
+
+func (m *EventChainletOwnershipTransferCancelled) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EventChainletOwnershipTransferCancelled) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EventChainletOwnershipTransferCancelled) GetBy() string {
+	if m != nil {
+		return m.By
+	}
+	return ""
+}
+
+type EventChainletOwnershipTransferred struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *EventChainletOwnershipTransferred) Reset()         { *m = EventChainletOwnershipTransferred{} }
+func (m *EventChainletOwnershipTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventChainletOwnershipTransferred) ProtoMessage()    {}
+func (*EventChainletOwnershipTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{13}
+}
+func (m *EventChainletOwnershipTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletOwnershipTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletOwnershipTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainletOwnershipTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletOwnershipTransferred.Merge(m, src)
+}
+func (m *EventChainletOwnershipTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletOwnershipTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletOwnershipTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainletOwnershipTransferred proto.InternalMessageInfo
+
+func (m *EventChainletOwnershipTransferred) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EventChainletOwnershipTransferred) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventChainletOwnershipTransferred) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type EventChainletDecommissioned struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	By      string `protobuf:"bytes,2,opt,name=by,proto3" json:"by,omitempty"`
+}
+
+func (m *EventChainletDecommissioned) Reset()         { *m = EventChainletDecommissioned{} }
+func (m *EventChainletDecommissioned) String() string { return proto.CompactTextString(m) }
+func (*EventChainletDecommissioned) ProtoMessage()    {}
+func (*EventChainletDecommissioned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{14}
+}
+func (m *EventChainletDecommissioned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletDecommissioned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletDecommissioned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainletDecommissioned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletDecommissioned.Merge(m, src)
+}
+func (m *EventChainletDecommissioned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletDecommissioned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletDecommissioned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainletDecommissioned proto.InternalMessageInfo
+
+func (m *EventChainletDecommissioned) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EventChainletDecommissioned) GetBy() string {
+	if m != nil {
+		return m.By
+	}
+	return ""
+}
+
+type EventChainletStatusChanged struct {
+	// option (gogoproto.goproto_stringer) = false;
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	From    Status `protobuf:"varint,2,opt,name=from,proto3,enum=ssc.chainlet.Status" json:"from,omitempty"`
+	To      Status `protobuf:"varint,3,opt,name=to,proto3,enum=ssc.chainlet.Status" json:"to,omitempty"`
+}
+
+func (m *EventChainletStatusChanged) Reset()         { *m = EventChainletStatusChanged{} }
+func (m *EventChainletStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventChainletStatusChanged) ProtoMessage()    {}
+func (*EventChainletStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7824474c202708e0, []int{15}
+}
+func (m *EventChainletStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainletStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainletStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainletStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainletStatusChanged.Merge(m, src)
+}
+func (m *EventChainletStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainletStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainletStatusChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainletStatusChanged proto.InternalMessageInfo
+
+func (m *EventChainletStatusChanged) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EventChainletStatusChanged) GetFrom() Status {
+	if m != nil {
+		return m.From
+	}
+	return Status_STATUS_OFFLINE
+}
+
+func (m *EventChainletStatusChanged) GetTo() Status {
+	if m != nil {
+		return m.To
+	}
+	return Status_STATUS_OFFLINE
+}
+
+func init() {
+	proto.RegisterType((*EventLaunchChainlet)(nil), "ssc.chainlet.EventLaunchChainlet")
+	proto.RegisterType((*EventNewChainletStack)(nil), "ssc.chainlet.EventNewChainletStack")
+	proto.RegisterType((*EventNewChainletStackVersion)(nil), "ssc.chainlet.EventNewChainletStackVersion")
+	proto.RegisterType((*EventChainletStackVersionDisabled)(nil), "ssc.chainlet.EventChainletStackVersionDisabled")
+	proto.RegisterType((*EventUpdateChainlet)(nil), "ssc.chainlet.EventUpdateChainlet")
+	proto.RegisterType((*EventChainletStopped)(nil), "ssc.chainlet.EventChainletStopped")
+	proto.RegisterType((*EventChainletRestarted)(nil), "ssc.chainlet.EventChainletRestarted")
+	proto.RegisterType((*EventUpdateChainletFees)(nil), "ssc.chainlet.EventUpdateChainletFees")
+	proto.RegisterType((*EventUpdateChainletParams)(nil), "ssc.chainlet.EventUpdateChainletParams")
+	proto.RegisterType((*EventChainletMaintainerAdded)(nil), "ssc.chainlet.EventChainletMaintainerAdded")
+	proto.RegisterType((*EventChainletMaintainerRemoved)(nil), "ssc.chainlet.EventChainletMaintainerRemoved")
+	proto.RegisterType((*EventChainletOwnershipTransferProposed)(nil), "ssc.chainlet.EventChainletOwnershipTransferProposed")
+	proto.RegisterType((*EventChainletOwnershipTransferCancelled)(nil), "ssc.chainlet.EventChainletOwnershipTransferCancelled")
+	proto.RegisterType((*EventChainletOwnershipTransferred)(nil), "ssc.chainlet.EventChainletOwnershipTransferred")
+	proto.RegisterType((*EventChainletDecommissioned)(nil), "ssc.chainlet.EventChainletDecommissioned")
+	proto.RegisterType((*EventChainletStatusChanged)(nil), "ssc.chainlet.EventChainletStatusChanged")
+}
+
+func init() { proto.RegisterFile("ssc/chainlet/events.proto", fileDescriptor_7824474c202708e0) }
+
+var fileDescriptor_7824474c202708e0 = []byte{
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdd, 0x1f, 0xe8, 0x50, 0xf5, 0x60, 0x02, 0xb8, 0x69, 0x65, 0xa8, 0x85, 0x68, 0x4f,
+	0x09, 0x2a, 0x37, 0x6e, 0xfd, 0x01, 0x84, 0x54, 0x4a, 0x49, 0x81, 0x03, 0x1c, 0xaa, 0x8d, 0x3d,
+	0x8d, 0x0d, 0xb1, 0xd7, 0xda, 0xdd, 0xa4, 0x0d, 0x37, 0x2e, 0x9c, 0x79, 0x07, 0x5e, 0xa6, 0xc7,
+	0x1e, 0x39, 0x21, 0x94, 0xbc, 0x08, 0xda, 0xcd, 0xda, 0x8d, 0x13, 0xc7, 0xa8, 0x82, 0xdb, 0xce,
+	0xdf, 0xf7, 0x7d, 0x3b, 0x33, 0x6b, 0xc3, 0x2a, 0xe7, 0x5e, 0xc3, 0x0b, 0x48, 0x18, 0x77, 0x50,
+	0x34, 0xb0, 0x87, 0xb1, 0xe0, 0xf5, 0x84, 0x51, 0x41, 0xad, 0x65, 0xce, 0xbd, 0x7a, 0x1a, 0xaa,
+	0x55, 0xdb, 0xb4, 0x4d, 0x55, 0xa0, 0x21, 0x4f, 0xa3, 0x9c, 0xda, 0x5a, 0xae, 0x3c, 0x3d, 0xe8,
+	0xa0, 0x5b, 0x18, 0x3c, 0x49, 0x08, 0x23, 0x91, 0x26, 0x71, 0x7f, 0x18, 0x70, 0xfb, 0x99, 0x64,
+	0x3d, 0x20, 0xdd, 0xd8, 0x0b, 0xf6, 0x74, 0x92, 0xb5, 0x0e, 0x4b, 0xaa, 0xe0, 0x90, 0x44, 0x68,
+	0x1b, 0x0f, 0x8c, 0xad, 0xa5, 0xe6, 0x95, 0xc3, 0xaa, 0xc1, 0xcd, 0x8e, 0xca, 0x47, 0x66, 0x9b,
+	0x2a, 0x98, 0xd9, 0x96, 0x0d, 0x37, 0x54, 0xe2, 0x4b, 0xdf, 0x9e, 0x53, 0xa1, 0xd4, 0xb4, 0xaa,
+	0xb0, 0xc0, 0x05, 0xf1, 0x3e, 0xdb, 0xf3, 0xca, 0x3f, 0x32, 0x2c, 0x17, 0x96, 0xd5, 0xe1, 0x3d,
+	0x32, 0x1e, 0xd2, 0xd8, 0x5e, 0x50, 0xc1, 0x9c, 0xcf, 0x3d, 0x81, 0x3b, 0x4a, 0xe4, 0x21, 0x9e,
+	0xa5, 0x0a, 0x8f, 0x55, 0xb1, 0x24, 0x63, 0x48, 0x04, 0x65, 0x5a, 0x64, 0x6a, 0x5a, 0x16, 0xcc,
+	0xc7, 0x52, 0xfb, 0x48, 0x9e, 0x3a, 0xcb, 0xec, 0x9e, 0x66, 0xd1, 0xd2, 0xb4, 0xe9, 0x1e, 0xc0,
+	0x7a, 0x21, 0x81, 0x16, 0x90, 0xa1, 0x19, 0xc5, 0x68, 0x66, 0x1e, 0xed, 0x0d, 0x6c, 0x28, 0xb4,
+	0x22, 0xa8, 0xfd, 0x90, 0x93, 0x56, 0x07, 0xfd, 0x6b, 0x42, 0x1e, 0xeb, 0x31, 0xbd, 0x4b, 0x7c,
+	0x22, 0x30, 0x1b, 0xd3, 0x58, 0xb3, 0x8d, 0x7c, 0xb3, 0x27, 0xdb, 0x6a, 0x16, 0xb4, 0xf5, 0x31,
+	0x54, 0x27, 0x74, 0xd2, 0x24, 0x41, 0x7f, 0x36, 0xaa, 0xbb, 0x0b, 0x77, 0x73, 0x15, 0x4d, 0xe4,
+	0x82, 0x30, 0x51, 0x56, 0x63, 0xad, 0x80, 0xd9, 0xea, 0x6b, 0x7e, 0xb3, 0xd5, 0x77, 0x3f, 0xc2,
+	0xbd, 0x82, 0xab, 0x3c, 0x47, 0xe4, 0x72, 0xeb, 0x94, 0xc0, 0xf1, 0xad, 0xcb, 0x1c, 0xb2, 0x63,
+	0xa7, 0x88, 0x3c, 0x1d, 0xa9, 0x3c, 0x6b, 0xf0, 0xb9, 0x0c, 0xfc, 0xab, 0x01, 0xab, 0x05, 0xe8,
+	0x47, 0x6a, 0xe7, 0x4b, 0x44, 0x3e, 0x85, 0xc5, 0xd1, 0xbb, 0x50, 0xe8, 0xb7, 0xb6, 0xd7, 0xeb,
+	0xe3, 0xaf, 0xaf, 0x9e, 0xc7, 0xd9, 0x9d, 0xbf, 0xf8, 0x75, 0xbf, 0xd2, 0xd4, 0x15, 0x53, 0x1a,
+	0x02, 0xbd, 0x4c, 0x69, 0xd1, 0x2b, 0x12, 0xc6, 0x82, 0x84, 0x31, 0xb2, 0x1d, 0xdf, 0x2f, 0x6d,
+	0x95, 0x03, 0x10, 0x65, 0xc9, 0xfa, 0x9e, 0x63, 0x9e, 0x29, 0xa6, 0x4f, 0xe0, 0xcc, 0x60, 0x6a,
+	0x62, 0x44, 0x7b, 0xff, 0x95, 0xeb, 0x14, 0x1e, 0xe5, 0xb8, 0x5e, 0x9f, 0xc5, 0xc8, 0x78, 0x10,
+	0x26, 0x6f, 0x19, 0x89, 0xf9, 0x29, 0xb2, 0x23, 0x46, 0x13, 0xca, 0x4b, 0x39, 0xe5, 0x04, 0x19,
+	0x8d, 0xb2, 0x09, 0x32, 0x1a, 0x49, 0x1e, 0x41, 0x53, 0x1e, 0x41, 0x5d, 0x0f, 0x36, 0xcb, 0x79,
+	0xf6, 0x48, 0xec, 0x61, 0xa7, 0xf3, 0xb7, 0x9d, 0x13, 0x34, 0xdd, 0x39, 0x41, 0xa7, 0x2e, 0x43,
+	0x60, 0xa3, 0x9c, 0x84, 0xfd, 0xf3, 0x3d, 0x5e, 0xc0, 0x5a, 0x8e, 0x62, 0x1f, 0x3d, 0x1a, 0x45,
+	0x21, 0x97, 0x2f, 0xef, 0x5a, 0xef, 0xe5, 0x9b, 0x01, 0xb5, 0xc9, 0xcf, 0x89, 0xe8, 0xf2, 0xbd,
+	0x80, 0xc4, 0xed, 0x52, 0xa0, 0xad, 0x31, 0x95, 0x2b, 0xdb, 0xd5, 0xfc, 0x46, 0x8f, 0x40, 0xb4,
+	0xf6, 0x87, 0x99, 0xf6, 0x59, 0x79, 0xa6, 0xa0, 0xbb, 0x3b, 0x17, 0x03, 0xc7, 0xb8, 0x1c, 0x38,
+	0xc6, 0xef, 0x81, 0x63, 0x7c, 0x1f, 0x3a, 0x95, 0xcb, 0xa1, 0x53, 0xf9, 0x39, 0x74, 0x2a, 0x1f,
+	0x36, 0xdb, 0xa1, 0x08, 0xba, 0xad, 0xba, 0x47, 0xa3, 0x06, 0x27, 0x6d, 0x72, 0xde, 0xff, 0xd2,
+	0x90, 0x3f, 0x9f, 0xf3, 0xab, 0xdf, 0x8f, 0xe8, 0x27, 0xc8, 0x5b, 0x8b, 0xea, 0xaf, 0xf3, 0xe4,
+	0xcf, 0x00, 0x6b, 0xe8, 0xc2, 0xe5, 0xf7, 0x06, 0x00, 0x00,
+}
+
+func (m *EventLaunchChainlet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -745,27 +1038,55 @@ func (m *EventChainletStopped) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventChainletStopped) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventLaunchChainlet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventChainletStopped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventLaunchChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.StackVersion) > 0 {
+		i -= len(m.StackVersion)
+		copy(dAtA[i:], m.StackVersion)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StackVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Stack) > 0 {
+		i -= len(m.Stack)
+		copy(dAtA[i:], m.Stack)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Stack)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainName) > 0 {
+		i -= len(m.ChainName)
+		copy(dAtA[i:], m.ChainName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainName)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventChainletRestarted) Marshal() (dAtA []byte, err error) {
+func (m *EventNewChainletStack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -775,34 +1096,41 @@ func (m *EventChainletRestarted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventChainletRestarted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNewChainletStack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventChainletRestarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNewChainletStack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.By) > 0 {
-		i -= len(m.By)
-		copy(dAtA[i:], m.By)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateChainletFees) Marshal() (dAtA []byte, err error) {
+func (m *EventNewChainletStackVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -812,210 +1140,1786 @@ func (m *EventUpdateChainletFees) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateChainletFees) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNewChainletStackVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateChainletFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNewChainletStackVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.By) > 0 {
-		i -= len(m.By)
-		copy(dAtA[i:], m.By)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.StackName) > 0 {
-		i -= len(m.StackName)
-		copy(dAtA[i:], m.StackName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StackName)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventChainletStackVersionDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventLaunchChainlet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Launcher)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Stack)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.StackVersion)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *EventChainletStackVersionDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewChainletStack) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventChainletStackVersionDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNewChainletStackVersion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventUpdateChainlet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateChainlet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.StackVersion) > 0 {
+		i -= len(m.StackVersion)
+		copy(dAtA[i:], m.StackVersion)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StackVersion)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventChainletStackVersionDisabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventChainletStopped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletStopped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletStopped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateChainlet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventChainletRestarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletRestarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletRestarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.StackVersion)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventChainletStopped) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventUpdateChainletFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateChainletFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateChainletFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StackName) > 0 {
+		i -= len(m.StackName)
+		copy(dAtA[i:], m.StackName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StackName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateChainletParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateChainletParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateChainletParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletMaintainerAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletMaintainerAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletMaintainerAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Maintainer) > 0 {
+		i -= len(m.Maintainer)
+		copy(dAtA[i:], m.Maintainer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maintainer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletMaintainerRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletMaintainerRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletMaintainerRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Maintainer) > 0 {
+		i -= len(m.Maintainer)
+		copy(dAtA[i:], m.Maintainer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maintainer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletOwnershipTransferProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletOwnershipTransferProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletOwnershipTransferProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletOwnershipTransferCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletOwnershipTransferCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletOwnershipTransferCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletOwnershipTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletOwnershipTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletOwnershipTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletDecommissioned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletDecommissioned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletDecommissioned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.By) > 0 {
+		i -= len(m.By)
+		copy(dAtA[i:], m.By)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.By)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainletStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainletStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainletStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventLaunchChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Stack)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StackVersion)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewChainletStack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewChainletStackVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletStackVersionDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StackVersion)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletStopped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletRestarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.By)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateChainletFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StackName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.By)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateChainletParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.By)
+	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventChainletMaintainerAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Maintainer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.By)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletMaintainerRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Maintainer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.By)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletOwnershipTransferProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletOwnershipTransferCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.By)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletOwnershipTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletDecommissioned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.By)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainletStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovEvents(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovEvents(uint64(m.To))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLaunchChainlet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLaunchChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stack = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewChainletStack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewChainletStack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewChainletStackVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewChainletStackVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewChainletStackVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainletStackVersionDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainletStackVersionDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainletStackVersionDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateChainlet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateChainlet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainletStopped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainletStopped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainletStopped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventChainletRestarted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.By)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *EventUpdateChainletFees) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StackName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fees)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.By)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventChainletRestarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainletRestarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainletRestarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.By = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateChainletFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1038,15 +2942,15 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLaunchChainlet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateChainletFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLaunchChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateChainletFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1074,11 +2978,11 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainName = string(dAtA[iNdEx:postIndex])
+			m.StackName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1106,9 +3010,91 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Launcher = string(dAtA[iNdEx:postIndex])
+			m.Fees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.By = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateChainletParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateChainletParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateChainletParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -1140,11 +3126,11 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1154,27 +3140,28 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stack = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1202,7 +3189,7 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StackVersion = string(dAtA[iNdEx:postIndex])
+			m.By = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1225,7 +3212,7 @@ func (m *EventLaunchChainlet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
+func (m *EventChainletMaintainerAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,15 +3235,15 @@ func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewChainletStack: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletMaintainerAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewChainletStack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletMaintainerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1284,11 +3271,11 @@ func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1316,11 +3303,11 @@ func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Maintainer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1348,7 +3335,7 @@ func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.By = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1371,7 +3358,7 @@ func (m *EventNewChainletStack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewChainletStackVersion) Unmarshal(dAtA []byte) error {
+func (m *EventChainletMaintainerRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1394,15 +3381,15 @@ func (m *EventNewChainletStackVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewChainletStackVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletMaintainerRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewChainletStackVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletMaintainerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1430,11 +3417,11 @@ func (m *EventNewChainletStackVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,7 +3449,39 @@ func (m *EventNewChainletStackVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Maintainer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.By = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1485,7 +3504,7 @@ func (m *EventNewChainletStackVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventChainletStackVersionDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventChainletOwnershipTransferProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1508,15 +3527,15 @@ func (m *EventChainletStackVersionDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventChainletStackVersionDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletOwnershipTransferProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventChainletStackVersionDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletOwnershipTransferProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1544,11 +3563,11 @@ func (m *EventChainletStackVersionDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1576,7 +3595,39 @@ func (m *EventChainletStackVersionDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1599,7 +3650,7 @@ func (m *EventChainletStackVersionDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateChainlet) Unmarshal(dAtA []byte) error {
+func (m *EventChainletOwnershipTransferCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,10 +3673,10 @@ func (m *EventUpdateChainlet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateChainlet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletOwnershipTransferCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletOwnershipTransferCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1662,7 +3713,7 @@ func (m *EventUpdateChainlet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,7 +3741,39 @@ func (m *EventUpdateChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StackVersion = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.By = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1713,7 +3796,7 @@ func (m *EventUpdateChainlet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventChainletStopped) Unmarshal(dAtA []byte) error {
+func (m *EventChainletOwnershipTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,10 +3819,10 @@ func (m *EventChainletStopped) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventChainletStopped: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletOwnershipTransferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventChainletStopped: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletOwnershipTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1774,6 +3857,70 @@ func (m *EventChainletStopped) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1795,7 +3942,7 @@ func (m *EventChainletStopped) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventChainletRestarted) Unmarshal(dAtA []byte) error {
+func (m *EventChainletDecommissioned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1818,10 +3965,10 @@ func (m *EventChainletRestarted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventChainletRestarted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletDecommissioned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventChainletRestarted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletDecommissioned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1909,7 +4056,7 @@ func (m *EventChainletRestarted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateChainletFees) Unmarshal(dAtA []byte) error {
+func (m *EventChainletStatusChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1932,15 +4079,15 @@ func (m *EventUpdateChainletFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateChainletFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainletStatusChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateChainletFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainletStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1968,13 +4115,13 @@ func (m *EventUpdateChainletFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StackName = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1984,29 +4131,16 @@ func (m *EventUpdateChainletFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.From |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fees = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var stringLen uint64
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2016,24 +4150,11 @@ func (m *EventUpdateChainletFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.To |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.By = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
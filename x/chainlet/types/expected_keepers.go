@@ -38,6 +38,7 @@ type ProviderKeeper interface {
 
 type ProviderMsgServer interface {
 	CreateConsumer(goCtx context.Context, msg *ccvprovidertypes.MsgCreateConsumer) (*ccvprovidertypes.MsgCreateConsumerResponse, error)
+	RemoveConsumer(goCtx context.Context, msg *ccvprovidertypes.MsgRemoveConsumer) (*ccvprovidertypes.MsgRemoveConsumerResponse, error)
 }
 
 type ClientKeeper interface {
@@ -59,16 +60,24 @@ type ConnectionKeeper interface {
 }
 
 type BillingKeeper interface {
-	BillAccount(ctx sdk.Context, amount sdk.Coin, chainlet Chainlet, memo string) error
+	BillEpochFee(ctx sdk.Context, epochFee, setupFee sdk.Coin, chainlet Chainlet, memo string) error
+	RefundUnusedEpochFee(ctx sdk.Context, chainlet Chainlet) error
+	ChargedForCurrentEpoch(ctx sdk.Context, chainId string) bool
+	OnChainletDecommissioned(ctx sdk.Context, chainlet Chainlet) error
 	PayEpochFeeToValidator(ctx sdk.Context, epochFee sdk.Coins, fromModuleName string, valAddr sdk.AccAddress, memo string) (err error)
 }
 
 type EscrowKeeper interface {
 	NewChainletAccount(ctx sdk.Context, address sdk.AccAddress, chainId string, depositAmount sdk.Coin) error
 	GetSupportedDenoms(ctx sdk.Context) []string
+	RefundChainlet(ctx sdk.Context, chainId string) error
 }
 
 type AclKeeper interface {
 	Allowed(ctx sdk.Context, addr sdk.AccAddress) bool
 	IsAdmin(ctx sdk.Context, addr sdk.AccAddress) bool
 }
+
+type PeersKeeper interface {
+	DeleteChainletData(ctx sdk.Context, chainId string)
+}
@@ -13,6 +13,7 @@ func DefaultGenesis() *GenesisState {
 		Chainlets:      []Chainlet{},
 		ChainletStacks: []ChainletStack{},
 		ChainletCount:  0,
+		Tombstones:     []ChainletTombstone{},
 	}
 }
 
@@ -30,6 +31,15 @@ func (gs GenesisState) Validate() error {
 		chainletIDs[chainlet.ChainId] = true
 	}
 
+	// Validate decommissioned chain IDs are unique and not in use
+	tombstoneIDs := make(map[string]bool)
+	for _, tombstone := range gs.Tombstones {
+		if chainletIDs[tombstone.ChainId] || tombstoneIDs[tombstone.ChainId] {
+			return ErrChainletExists
+		}
+		tombstoneIDs[tombstone.ChainId] = true
+	}
+
 	// Validate chainlet stacks have unique display names
 	stackNames := make(map[string]bool)
 	for _, stack := range gs.ChainletStacks {
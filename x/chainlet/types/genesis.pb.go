@@ -32,6 +32,8 @@ type GenesisState struct {
 	ChainletStacks []ChainletStack `protobuf:"bytes,3,rep,name=chainlet_stacks,json=chainletStacks,proto3" json:"chainlet_stacks"`
 	// Chainlet count
 	ChainletCount uint64 `protobuf:"varint,4,opt,name=chainlet_count,json=chainletCount,proto3" json:"chainlet_count,omitempty"`
+	// Tombstones of decommissioned chainlets
+	Tombstones []ChainletTombstone `protobuf:"bytes,5,rep,name=tombstones,proto3" json:"tombstones"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -95,6 +97,13 @@ func (m *GenesisState) GetChainletCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetTombstones() []ChainletTombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ssc.chainlet.GenesisState")
 }
@@ -102,25 +111,27 @@ func init() {
 func init() { proto.RegisterFile("ssc/chainlet/genesis.proto", fileDescriptor_d094dfce36c926a5) }
 
 var fileDescriptor_d094dfce36c926a5 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
+	// 309 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x2e, 0x4e, 0xd6,
 	0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x49, 0x2d, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x29, 0x2e, 0x4e, 0xd6, 0x83, 0xc9, 0x49, 0x89,
 	0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x49, 0x14, 0xfd, 0x05,
 	0x89, 0x45, 0x89, 0xb9, 0x50, 0xed, 0x52, 0xd2, 0x28, 0x52, 0x30, 0x06, 0x54, 0x52, 0x11, 0xab,
-	0x64, 0x7c, 0x71, 0x49, 0x62, 0x72, 0x36, 0x44, 0x89, 0xd2, 0x47, 0x46, 0x2e, 0x1e, 0x77, 0x88,
+	0x64, 0x7c, 0x71, 0x49, 0x62, 0x72, 0x36, 0x44, 0x89, 0xd2, 0x0e, 0x26, 0x2e, 0x1e, 0x77, 0x88,
 	0x83, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x8c, 0xb8, 0xd8, 0x20, 0x16, 0x48, 0x30, 0x2a, 0x30,
 	0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0x3b, 0x50, 0x2f, 0x00, 0x2c, 0xe7, 0xc4, 0x72, 0xe2, 0x9e,
 	0x3c, 0x43, 0x10, 0x54, 0xa5, 0x90, 0x15, 0x17, 0x27, 0x4c, 0x41, 0xb1, 0x04, 0x93, 0x02, 0xb3,
 	0x06, 0xb7, 0x91, 0x18, 0xaa, 0x36, 0x67, 0x28, 0x03, 0xaa, 0x11, 0xa1, 0x5c, 0xc8, 0x8b, 0x8b,
 	0x1f, 0xd5, 0x61, 0xc5, 0x12, 0xcc, 0x60, 0x13, 0xa4, 0xb1, 0x9b, 0x10, 0x0c, 0x52, 0x03, 0x35,
 	0x86, 0x2f, 0x19, 0x59, 0xb0, 0x58, 0x48, 0x95, 0x0b, 0x2e, 0x12, 0x9f, 0x9c, 0x5f, 0x9a, 0x57,
-	0x22, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x0b, 0x13, 0x75, 0x06, 0x09, 0x3a, 0x39, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x71, 0x62, 0x7a, 0x62, 0x45, 0x65, 0x95, 0x3e, 0x28, 0x0c,
-	0x2b, 0x10, 0xa1, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x3d, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x67, 0xf0, 0xc3, 0xc8, 0xda, 0x01, 0x00, 0x00,
+	0x22, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x0b, 0x13, 0x75, 0x06, 0x09, 0x0a, 0xb9, 0x72,
+	0x71, 0x95, 0xe4, 0xe7, 0x26, 0x15, 0x97, 0xe4, 0xe7, 0xa5, 0x16, 0x4b, 0xb0, 0x82, 0x6d, 0x93,
+	0xc7, 0x6e, 0x5b, 0x08, 0x4c, 0x1d, 0xd4, 0x46, 0x24, 0x8d, 0x4e, 0x8e, 0x27, 0x1e, 0xc9, 0x31,
+	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
+	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9e, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
+	0x9f, 0xab, 0x5f, 0x9c, 0x98, 0x9e, 0x58, 0x51, 0x59, 0xa5, 0x0f, 0x8a, 0x8a, 0x0a, 0x44, 0x64,
+	0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x23, 0xc1, 0x18, 0x30, 0x00, 0x53, 0xbf, 0x2f,
+	0xc7, 0x21, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -143,6 +154,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.ChainletCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ChainletCount))
 		i--
@@ -223,6 +248,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.ChainletCount != 0 {
 		n += 1 + sovGenesis(uint64(m.ChainletCount))
 	}
+	if len(m.Tombstones) > 0 {
+		for _, e := range m.Tombstones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -381,6 +412,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, ChainletTombstone{})
+			if err := m.Tombstones[len(m.Tombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
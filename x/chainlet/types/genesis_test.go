@@ -90,6 +90,26 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid genesis state - tombstoned chain ID in use",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					ChainletStackProtections:         false,
+					NEpochDeposit:                    "30",
+					AutomaticChainletUpgrades:        true,
+					AutomaticChainletUpgradeInterval: 100,
+				},
+				Chainlets: []types.Chainlet{
+					{ChainId: "chain-1"},
+				},
+				ChainletStacks: []types.ChainletStack{},
+				ChainletCount:  1,
+				Tombstones: []types.ChainletTombstone{
+					{ChainId: "chain-1"},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
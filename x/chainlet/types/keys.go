@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "chainlet"
@@ -20,8 +24,63 @@ var (
 	ChainletInit          = []byte{0x03}
 	ChainletCountKey      = []byte{0x04}
 	UpgradingChainletsKey = []byte{0x05}
+	TombstoneKey          = []byte{0x06}
+	PendingSpawnKey       = []byte{0x07}
+	ChainletIndexKey      = []byte{0x08}
+)
+
+// Secondary indexes of chainlets, stored under ChainletIndexKey
+const (
+	LauncherIndex byte = iota + 1
+	MaintainerIndex
+	StackNameIndex
+	StackVersionIndex
+	TagIndex
+	StatusIndex
+	CCVIndex
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// ChainletIndexPrefix returns the prefix of all the chainlets with the given value in an index.
+// The value is length-prefixed so that a value cannot match the prefix of a longer one.
+func ChainletIndexPrefix(index byte, value []byte) []byte {
+	key := []byte{index}
+	key = binary.AppendUvarint(key, uint64(len(value)))
+	return append(key, value...)
+}
+
+func StatusIndexValue(status Status) []byte {
+	return binary.BigEndian.AppendUint32(nil, uint32(status))
+}
+
+func CCVIndexValue(ccv bool) []byte {
+	if ccv {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// ChainletIndexKeys returns the keys of all the index entries of a chainlet.
+func ChainletIndexKeys(chainlet Chainlet) [][]byte {
+	entry := func(index byte, value []byte) []byte {
+		return append(ChainletIndexPrefix(index, value), chainlet.ChainId...)
+	}
+
+	keys := [][]byte{
+		entry(LauncherIndex, []byte(chainlet.Launcher)),
+		entry(StackNameIndex, []byte(chainlet.ChainletStackName)),
+		entry(StackVersionIndex, []byte(chainlet.ChainletStackVersion)),
+		entry(StatusIndex, StatusIndexValue(chainlet.Status)),
+		entry(CCVIndex, CCVIndexValue(chainlet.IsCCVConsumer)),
+	}
+	for _, maintainer := range chainlet.Maintainers {
+		keys = append(keys, entry(MaintainerIndex, []byte(maintainer)))
+	}
+	for _, tag := range chainlet.Tags {
+		keys = append(keys, entry(TagIndex, []byte(tag)))
+	}
+	return keys
+}
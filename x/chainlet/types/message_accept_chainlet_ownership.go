@@ -0,0 +1,47 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptChainletOwnership = "accept_chainlet_ownership"
+
+var _ sdk.Msg = &MsgAcceptChainletOwnership{}
+
+func NewMsgAcceptChainletOwnership(creator string, chainId string) *MsgAcceptChainletOwnership {
+	return &MsgAcceptChainletOwnership{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgAcceptChainletOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptChainletOwnership) Type() string {
+	return TypeMsgAcceptChainletOwnership
+}
+
+func (msg *MsgAcceptChainletOwnership) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptChainletOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAddChainletMaintainer = "add_chainlet_maintainer"
+
+var _ sdk.Msg = &MsgAddChainletMaintainer{}
+
+func NewMsgAddChainletMaintainer(creator string, chainId string, maintainer string) *MsgAddChainletMaintainer {
+	return &MsgAddChainletMaintainer{
+		Creator:    creator,
+		ChainId:    chainId,
+		Maintainer: maintainer,
+	}
+}
+
+func (msg *MsgAddChainletMaintainer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddChainletMaintainer) Type() string {
+	return TypeMsgAddChainletMaintainer
+}
+
+func (msg *MsgAddChainletMaintainer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddChainletMaintainer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Maintainer)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid maintainer address (%s)", err)
+	}
+	return nil
+}
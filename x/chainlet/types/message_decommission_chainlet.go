@@ -0,0 +1,47 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDecommissionChainlet = "decommission_chainlet"
+
+var _ sdk.Msg = &MsgDecommissionChainlet{}
+
+func NewMsgDecommissionChainlet(creator string, chainId string) *MsgDecommissionChainlet {
+	return &MsgDecommissionChainlet{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgDecommissionChainlet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDecommissionChainlet) Type() string {
+	return TypeMsgDecommissionChainlet
+}
+
+func (msg *MsgDecommissionChainlet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDecommissionChainlet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+	return nil
+}
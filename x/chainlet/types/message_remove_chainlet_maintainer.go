@@ -0,0 +1,53 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveChainletMaintainer = "remove_chainlet_maintainer"
+
+var _ sdk.Msg = &MsgRemoveChainletMaintainer{}
+
+func NewMsgRemoveChainletMaintainer(creator string, chainId string, maintainer string) *MsgRemoveChainletMaintainer {
+	return &MsgRemoveChainletMaintainer{
+		Creator:    creator,
+		ChainId:    chainId,
+		Maintainer: maintainer,
+	}
+}
+
+func (msg *MsgRemoveChainletMaintainer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveChainletMaintainer) Type() string {
+	return TypeMsgRemoveChainletMaintainer
+}
+
+func (msg *MsgRemoveChainletMaintainer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveChainletMaintainer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Maintainer)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid maintainer address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResumeChainlet = "resume_chainlet"
+
+var _ sdk.Msg = &MsgResumeChainlet{}
+
+func NewMsgResumeChainlet(creator string, chainId string) *MsgResumeChainlet {
+	return &MsgResumeChainlet{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgResumeChainlet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumeChainlet) Type() string {
+	return TypeMsgResumeChainlet
+}
+
+func (msg *MsgResumeChainlet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResumeChainlet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSuspendChainlet = "suspend_chainlet"
+
+var _ sdk.Msg = &MsgSuspendChainlet{}
+
+func NewMsgSuspendChainlet(creator string, chainId string) *MsgSuspendChainlet {
+	return &MsgSuspendChainlet{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgSuspendChainlet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSuspendChainlet) Type() string {
+	return TypeMsgSuspendChainlet
+}
+
+func (msg *MsgSuspendChainlet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSuspendChainlet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferChainletOwnership = "transfer_chainlet_ownership"
+
+var _ sdk.Msg = &MsgTransferChainletOwnership{}
+
+func NewMsgTransferChainletOwnership(creator string, chainId string, newLauncher string, requireAccept bool) *MsgTransferChainletOwnership {
+	return &MsgTransferChainletOwnership{
+		Creator:       creator,
+		ChainId:       chainId,
+		NewLauncher:   newLauncher,
+		RequireAccept: requireAccept,
+	}
+}
+
+func (msg *MsgTransferChainletOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferChainletOwnership) Type() string {
+	return TypeMsgTransferChainletOwnership
+}
+
+func (msg *MsgTransferChainletOwnership) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferChainletOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+
+	// An empty new launcher cancels the pending transfer
+	if msg.NewLauncher == "" {
+		if msg.RequireAccept {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot require accepting a cancellation")
+		}
+		return nil
+	}
+	_, err = sdk.AccAddressFromBech32(msg.NewLauncher)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new launcher address (%s)", err)
+	}
+	return nil
+}
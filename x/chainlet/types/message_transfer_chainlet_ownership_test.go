@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferChainletOwnership_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferChainletOwnership
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferChainletOwnership{
+				Creator:     "invalid_address",
+				ChainId:     "test_12345-1",
+				NewLauncher: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid new launcher",
+			msg: MsgTransferChainletOwnership{
+				Creator:     sample.AccAddress(),
+				ChainId:     "test_12345-1",
+				NewLauncher: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "cancel with self",
+			msg: MsgTransferChainletOwnership{
+				Creator:     addr,
+				ChainId:     "test_12345-1",
+				NewLauncher: addr,
+			},
+		}, {
+			name: "cancel with empty new launcher",
+			msg: MsgTransferChainletOwnership{
+				Creator: addr,
+				ChainId: "test_12345-1",
+			},
+		}, {
+			name: "cancel requiring accept",
+			msg: MsgTransferChainletOwnership{
+				Creator:       addr,
+				ChainId:       "test_12345-1",
+				RequireAccept: true,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgTransferChainletOwnership{
+				Creator:       sample.AccAddress(),
+				ChainId:       "test_12345-1",
+				NewLauncher:   sample.AccAddress(),
+				RequireAccept: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateChainletParams = "update_chainlet_params"
+
+// Names of the chainlet params maintainers can update, as used in update masks
+const (
+	ChainletParamGasLimit          = "gasLimit"
+	ChainletParamCreateEmptyBlocks = "createEmptyBlocks"
+	ChainletParamDacEnable         = "dacEnable"
+	ChainletParamFixedBaseFee      = "fixedBaseFee"
+	ChainletParamFeeAccount        = "feeAccount"
+)
+
+var _ sdk.Msg = &MsgUpdateChainletParams{}
+
+func NewMsgUpdateChainletParams(creator string, chainId string, params ChainletParams, updateMask []string) *MsgUpdateChainletParams {
+	return &MsgUpdateChainletParams{
+		Creator:    creator,
+		ChainId:    chainId,
+		Params:     params,
+		UpdateMask: updateMask,
+	}
+}
+
+func (msg *MsgUpdateChainletParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateChainletParams) Type() string {
+	return TypeMsgUpdateChainletParams
+}
+
+func (msg *MsgUpdateChainletParams) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateChainletParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	valid := validateChainId(msg.ChainId)
+	if !valid {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id %s is invalid", msg.ChainId)
+	}
+
+	// Genesis-only params cannot be changed on a running chainlet
+	if len(msg.Params.GenAcctBalances.List) > 0 {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "genesis account balances cannot be updated")
+	}
+	if msg.Params.EvmDisable {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "evm disable cannot be updated")
+	}
+
+	if len(msg.UpdateMask) == 0 {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no params to update")
+	}
+	masked := make(map[string]bool)
+	for _, field := range msg.UpdateMask {
+		switch field {
+		case ChainletParamGasLimit, ChainletParamCreateEmptyBlocks, ChainletParamDacEnable, ChainletParamFixedBaseFee, ChainletParamFeeAccount:
+		default:
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "param %s cannot be updated", field)
+		}
+		if masked[field] {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate param %s in update mask", field)
+		}
+		masked[field] = true
+	}
+
+	// Values outside of the update mask would be silently ignored
+	set := []struct {
+		field string
+		isSet bool
+	}{
+		{ChainletParamGasLimit, msg.Params.GasLimit != 0},
+		{ChainletParamCreateEmptyBlocks, msg.Params.CreateEmptyBlocks},
+		{ChainletParamDacEnable, msg.Params.DacEnable},
+		{ChainletParamFixedBaseFee, msg.Params.FixedBaseFee != ""},
+		{ChainletParamFeeAccount, msg.Params.FeeAccount != ""},
+	}
+	for _, param := range set {
+		if param.isSet && !masked[param.field] {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "param %s is set but not in the update mask", param.field)
+		}
+	}
+
+	if msg.Params.FixedBaseFee != "" {
+		i, ok := math.NewIntFromString(msg.Params.FixedBaseFee)
+		if !ok {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "could not parse fixed base fee '%s'", msg.Params.FixedBaseFee)
+		}
+		if i.IsNegative() {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fixed base fee cannot be negative")
+		}
+	}
+	if msg.Params.FeeAccount != "" {
+		_, err := sdk.AccAddressFromBech32(msg.Params.FeeAccount)
+		if err != nil {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee account address: %s", err)
+		}
+	}
+	if msg.Params.GasLimit > ChainletGasLimit {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "gas limit too high, must be less than %v", ChainletGasLimit)
+	}
+	return nil
+}
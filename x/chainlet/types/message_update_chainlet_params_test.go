@@ -0,0 +1,163 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateChainletParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateChainletParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateChainletParams{
+				Creator:    "invalid_address",
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{GasLimit: 1000},
+				UpdateMask: []string{ChainletParamGasLimit},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid chain id",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "invalid",
+				Params:     ChainletParams{GasLimit: 1000},
+				UpdateMask: []string{ChainletParamGasLimit},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no params",
+			msg: MsgUpdateChainletParams{
+				Creator: sample.AccAddress(),
+				ChainId: "test_12345-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "gas limit too high",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{GasLimit: ChainletGasLimit + 1},
+				UpdateMask: []string{ChainletParamGasLimit},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative fixed base fee",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{FixedBaseFee: "-1"},
+				UpdateMask: []string{ChainletParamFixedBaseFee},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid fixed base fee",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{FixedBaseFee: "abc"},
+				UpdateMask: []string{ChainletParamFixedBaseFee},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid fee account",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{FeeAccount: "invalid_address"},
+				UpdateMask: []string{ChainletParamFeeAccount},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "genesis balances",
+			msg: MsgUpdateChainletParams{
+				Creator: sample.AccAddress(),
+				ChainId: "test_12345-1",
+				Params: ChainletParams{
+					GenAcctBalances: GenesisAccountBalances{
+						List: []*AccountBalance{{Address: sample.AccAddress(), Balance: "1"}},
+					},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "evm disable",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{GasLimit: 1000, EvmDisable: true},
+				UpdateMask: []string{ChainletParamGasLimit},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "param not updatable",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				UpdateMask: []string{"evmDisable"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate param",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				UpdateMask: []string{ChainletParamDacEnable, ChainletParamDacEnable},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "param set outside of the update mask",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				Params:     ChainletParams{GasLimit: 1000, DacEnable: true},
+				UpdateMask: []string{ChainletParamGasLimit},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid reset to zero values",
+			msg: MsgUpdateChainletParams{
+				Creator:    sample.AccAddress(),
+				ChainId:    "test_12345-1",
+				UpdateMask: []string{ChainletParamCreateEmptyBlocks, ChainletParamDacEnable, ChainletParamFixedBaseFee, ChainletParamGasLimit},
+			},
+		}, {
+			name: "valid",
+			msg: MsgUpdateChainletParams{
+				Creator: sample.AccAddress(),
+				ChainId: "test_12345-1",
+				Params: ChainletParams{
+					GasLimit:          1000,
+					CreateEmptyBlocks: true,
+					DacEnable:         true,
+					FixedBaseFee:      "1000",
+					FeeAccount:        sample.AccAddress(),
+				},
+				UpdateMask: []string{
+					ChainletParamGasLimit,
+					ChainletParamCreateEmptyBlocks,
+					ChainletParamDacEnable,
+					ChainletParamFixedBaseFee,
+					ChainletParamFeeAccount,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
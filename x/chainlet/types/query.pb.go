@@ -30,6 +30,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type CCVFilter int32
+
+const (
+	CCVFilter_CCV_FILTER_ANY        CCVFilter = 0
+	CCVFilter_CCV_FILTER_CONSUMER   CCVFilter = 1
+	CCVFilter_CCV_FILTER_STANDALONE CCVFilter = 2
+)
+
+var CCVFilter_name = map[int32]string{
+	0: "CCV_FILTER_ANY",
+	1: "CCV_FILTER_CONSUMER",
+	2: "CCV_FILTER_STANDALONE",
+}
+
+var CCVFilter_value = map[string]int32{
+	"CCV_FILTER_ANY":        0,
+	"CCV_FILTER_CONSUMER":   1,
+	"CCV_FILTER_STANDALONE": 2,
+}
+
+func (x CCVFilter) String() string {
+	return proto.EnumName(CCVFilter_name, int32(x))
+}
+
+func (CCVFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_79bbab29ed6da853, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -299,6 +327,15 @@ func (m *QueryGetChainletStackResponse) GetChainletStack() ChainletStack {
 
 type QueryListChainletsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional filters, listed chainlets match all the ones that are set
+	Launcher     string `protobuf:"bytes,2,opt,name=launcher,proto3" json:"launcher,omitempty"`
+	Maintainer   string `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	StackName    string `protobuf:"bytes,4,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	StackVersion string `protobuf:"bytes,5,opt,name=stackVersion,proto3" json:"stackVersion,omitempty"`
+	Tag          string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	// STATUS_OFFLINE (the default) does not filter by status
+	Status Status    `protobuf:"varint,7,opt,name=status,proto3,enum=ssc.chainlet.Status" json:"status,omitempty"`
+	Ccv    CCVFilter `protobuf:"varint,8,opt,name=ccv,proto3,enum=ssc.chainlet.CCVFilter" json:"ccv,omitempty"`
 }
 
 func (m *QueryListChainletsRequest) Reset()         { *m = QueryListChainletsRequest{} }
@@ -341,6 +378,55 @@ func (m *QueryListChainletsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryListChainletsRequest) GetLauncher() string {
+	if m != nil {
+		return m.Launcher
+	}
+	return ""
+}
+
+func (m *QueryListChainletsRequest) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
+	}
+	return ""
+}
+
+func (m *QueryListChainletsRequest) GetStackName() string {
+	if m != nil {
+		return m.StackName
+	}
+	return ""
+}
+
+func (m *QueryListChainletsRequest) GetStackVersion() string {
+	if m != nil {
+		return m.StackVersion
+	}
+	return ""
+}
+
+func (m *QueryListChainletsRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *QueryListChainletsRequest) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_STATUS_OFFLINE
+}
+
+func (m *QueryListChainletsRequest) GetCcv() CCVFilter {
+	if m != nil {
+		return m.Ccv
+	}
+	return CCVFilter_CCV_FILTER_ANY
+}
+
 type QueryListChainletsResponse struct {
 	Chainlets  []*Chainlet         `protobuf:"bytes,1,rep,name=Chainlets,proto3" json:"Chainlets,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -563,6 +649,7 @@ func (m *QueryChainletCountResponse) GetCount() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("ssc.chainlet.CCVFilter", CCVFilter_name, CCVFilter_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "ssc.chainlet.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ssc.chainlet.QueryParamsResponse")
 	proto.RegisterType((*QueryListChainletStackRequest)(nil), "ssc.chainlet.QueryListChainletStackRequest")
@@ -580,52 +667,63 @@ func init() {
 func init() { proto.RegisterFile("ssc/chainlet/query.proto", fileDescriptor_79bbab29ed6da853) }
 
 var fileDescriptor_79bbab29ed6da853 = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xfc, 0xa0, 0x3f, 0x79, 0x15, 0xa3, 0x63, 0x83, 0xa5, 0xd4, 0x15, 0x56, 0x84,
-	0x0a, 0x64, 0x07, 0x8a, 0x31, 0x1e, 0x15, 0x12, 0x09, 0x89, 0x31, 0x58, 0x3d, 0x79, 0x21, 0xd3,
-	0x65, 0xb2, 0x6c, 0x68, 0x77, 0x16, 0x66, 0x6b, 0xa8, 0x84, 0x8b, 0x27, 0xe3, 0xc9, 0x44, 0x2f,
-	0xc6, 0xa3, 0x77, 0xff, 0x0e, 0x8e, 0x24, 0x5e, 0x3c, 0x19, 0x03, 0xfa, 0x7f, 0x98, 0xce, 0xbe,
-	0xa5, 0x9d, 0x76, 0xdb, 0x72, 0xe0, 0xd6, 0x9d, 0xf7, 0x9d, 0xf7, 0x3e, 0xef, 0xfb, 0xf2, 0xa6,
-	0x90, 0x93, 0xd2, 0xa1, 0xce, 0x0e, 0xf3, 0xfc, 0x2a, 0x0f, 0xe9, 0x5e, 0x9d, 0xef, 0x37, 0xec,
-	0x60, 0x5f, 0x84, 0x82, 0x5c, 0x95, 0xd2, 0xb1, 0xe3, 0x48, 0x3e, 0xeb, 0x0a, 0x57, 0xa8, 0x00,
-	0x6d, 0xfe, 0x8a, 0x34, 0xf9, 0x82, 0x2b, 0x84, 0x5b, 0xe5, 0x94, 0x05, 0x1e, 0x65, 0xbe, 0x2f,
-	0x42, 0x16, 0x7a, 0xc2, 0x97, 0x18, 0x9d, 0x77, 0x84, 0xac, 0x09, 0x49, 0x2b, 0x4c, 0xf2, 0x28,
-	0x35, 0x7d, 0xb3, 0x5c, 0xe1, 0x21, 0x5b, 0xa6, 0x01, 0x73, 0x3d, 0x5f, 0x89, 0x51, 0x3b, 0xa1,
-	0x71, 0x04, 0x6c, 0x9f, 0xd5, 0xe2, 0x34, 0xd3, 0x5a, 0x28, 0xfe, 0xb1, 0x25, 0x43, 0xe6, 0xec,
-	0xa2, 0x64, 0x32, 0x51, 0x12, 0x05, 0xad, 0x2c, 0x90, 0x17, 0xcd, 0xe2, 0x9b, 0x2a, 0x69, 0x99,
-	0xef, 0xd5, 0xb9, 0x0c, 0xad, 0x0d, 0xb8, 0xa9, 0x9d, 0xca, 0x40, 0xf8, 0x92, 0x93, 0x12, 0xa4,
-	0xa3, 0xe2, 0x39, 0x63, 0xca, 0x28, 0x66, 0x4a, 0x59, 0xbb, 0xdd, 0x06, 0x3b, 0x52, 0xaf, 0x0e,
-	0x1f, 0xff, 0xba, 0x93, 0x2a, 0xa3, 0xd2, 0x72, 0xe1, 0xb6, 0x4a, 0xf5, 0xcc, 0x93, 0xe1, 0x1a,
-	0x2a, 0x5f, 0x36, 0xe9, 0xb0, 0x16, 0x79, 0x0a, 0xd0, 0x6a, 0x18, 0x13, 0xcf, 0xda, 0x91, 0x3b,
-	0x76, 0xd3, 0x1d, 0x3b, 0x32, 0x1e, 0xdd, 0xb1, 0x37, 0x99, 0xcb, 0xf1, 0x6e, 0xb9, 0xed, 0xa6,
-	0xf5, 0xdd, 0x00, 0xb3, 0x57, 0x25, 0xe4, 0x5f, 0x83, 0x6b, 0x5a, 0xa0, 0xd9, 0xc7, 0x7f, 0xc5,
-	0x4c, 0x69, 0x52, 0xef, 0x43, 0xbf, 0xdc, 0x71, 0x85, 0xac, 0x6b, 0xbc, 0x43, 0x8a, 0x77, 0x6e,
-	0x20, 0x6f, 0x44, 0xa0, 0x01, 0x3f, 0x86, 0x82, 0xe2, 0x5d, 0xe7, 0xc9, 0xc6, 0x4c, 0x41, 0x66,
-	0xdb, 0x93, 0x41, 0x95, 0x35, 0x9e, 0xb3, 0x1a, 0x57, 0xce, 0x8c, 0x96, 0xdb, 0x8f, 0xac, 0x1d,
-	0xf4, 0xb6, 0x3b, 0x03, 0x36, 0xbc, 0x0e, 0x63, 0x5a, 0x00, 0xed, 0xed, 0xd7, 0x2f, 0x8e, 0x4f,
-	0xbf, 0x67, 0x39, 0x30, 0xd1, 0xe5, 0xad, 0xbc, 0xec, 0x09, 0x7e, 0x35, 0x20, 0x9f, 0x54, 0x05,
-	0x9b, 0x79, 0x00, 0xa3, 0xe7, 0x87, 0x38, 0xb8, 0xf1, 0xe4, 0x46, 0xca, 0x2d, 0xe1, 0xe5, 0x8d,
-	0x6b, 0x05, 0x6e, 0x75, 0x9a, 0x1d, 0x1b, 0x90, 0x83, 0xff, 0x15, 0xc3, 0xc6, 0x36, 0x4e, 0x29,
-	0xfe, 0xb4, 0x5e, 0x41, 0xae, 0xfb, 0x12, 0xf6, 0xf3, 0x08, 0xae, 0xc4, 0x67, 0x68, 0x5a, 0x8f,
-	0x76, 0x70, 0x24, 0xe7, 0x6a, 0x6b, 0x12, 0xa7, 0x11, 0x1f, 0xac, 0x89, 0xba, 0x1f, 0xc3, 0x58,
-	0x25, 0x34, 0xb1, 0x23, 0x88, 0x45, 0xb3, 0x30, 0xe2, 0x34, 0x0f, 0x54, 0xc5, 0xe1, 0x72, 0xf4,
-	0x51, 0xfa, 0x9b, 0x86, 0x11, 0x75, 0x89, 0xec, 0x42, 0x3a, 0x5a, 0x63, 0x32, 0xa5, 0xc3, 0x74,
-	0xbf, 0x12, 0xf9, 0xe9, 0x3e, 0x8a, 0xa8, 0x9c, 0x55, 0x78, 0xf7, 0xe3, 0xcf, 0xa7, 0xa1, 0x71,
-	0x92, 0xa5, 0x09, 0x4f, 0x18, 0xf9, 0x62, 0xc0, 0x8d, 0xae, 0x6d, 0x25, 0x0b, 0x09, 0x69, 0x7b,
-	0xbd, 0x1e, 0xf9, 0xc5, 0x8b, 0x89, 0x11, 0xe7, 0xbe, 0xc2, 0xb9, 0x4b, 0xa6, 0x75, 0x9c, 0xaa,
-	0x27, 0xc3, 0x2d, 0xfd, 0xed, 0x24, 0xdf, 0x0c, 0xb8, 0xde, 0xb9, 0x57, 0x64, 0x3e, 0xa1, 0x5a,
-	0x8f, 0xf5, 0xcd, 0x2f, 0x5c, 0x48, 0x8b, 0x60, 0x0f, 0x15, 0xd8, 0x12, 0xb1, 0x75, 0x30, 0x97,
-	0x77, 0x72, 0xd1, 0xc3, 0xb6, 0x07, 0xe0, 0x88, 0xbc, 0x37, 0x60, 0x4c, 0xdb, 0x16, 0x32, 0x37,
-	0xc0, 0x90, 0xf3, 0xe9, 0x15, 0x07, 0x0b, 0x11, 0x6e, 0x46, 0xc1, 0x99, 0xa4, 0xd0, 0xc7, 0x35,
-	0x49, 0x3e, 0x18, 0x90, 0x69, 0xeb, 0x8f, 0xdc, 0xeb, 0xdf, 0x7f, 0x8c, 0x31, 0x3b, 0x48, 0x86,
-	0x10, 0x8b, 0x0a, 0x62, 0x96, 0xcc, 0xf4, 0x76, 0x88, 0x1e, 0xe2, 0xda, 0x1d, 0x91, 0xcf, 0x46,
-	0xeb, 0xe5, 0x53, 0x0b, 0x90, 0xe8, 0x4b, 0xd2, 0xfe, 0x24, 0xfa, 0x92, 0xb8, 0x4b, 0xd6, 0x92,
-	0x42, 0x9a, 0x27, 0x45, 0x2a, 0x99, 0xcb, 0x0e, 0x1a, 0x6f, 0xfb, 0x0c, 0x4f, 0xed, 0xd9, 0xea,
-	0x93, 0xe3, 0x53, 0xd3, 0x38, 0x39, 0x35, 0x8d, 0xdf, 0xa7, 0xa6, 0xf1, 0xf1, 0xcc, 0x4c, 0x9d,
-	0x9c, 0x99, 0xa9, 0x9f, 0x67, 0x66, 0xea, 0xf5, 0x9c, 0xeb, 0x85, 0x3b, 0xf5, 0x8a, 0xed, 0x88,
-	0x9a, 0x96, 0xed, 0xa0, 0x95, 0x2f, 0x6c, 0x04, 0x5c, 0x56, 0xd2, 0xea, 0x7f, 0x7b, 0xe5, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x98, 0xe0, 0x6e, 0x72, 0x9c, 0x08, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0xfd, 0xa1, 0x58, 0xe3, 0xd8, 0x50, 0x27, 0x6a, 0x4c, 0xd3, 0x0a, 0x6b, 0xb3, 0xa9,
+	0xad, 0x38, 0x06, 0x99, 0x28, 0x45, 0xd1, 0x63, 0x1d, 0xd5, 0x36, 0x0c, 0xb8, 0x4a, 0x4a, 0xb9,
+	0x06, 0xda, 0x8b, 0xb1, 0x66, 0x16, 0x34, 0x11, 0x89, 0x54, 0xb4, 0xab, 0x20, 0x6e, 0x90, 0x4b,
+	0x4f, 0x45, 0x4f, 0x05, 0xda, 0x4b, 0xd1, 0x63, 0xef, 0xfd, 0x1d, 0x39, 0xf4, 0x10, 0xa0, 0x97,
+	0x9e, 0x8a, 0xc2, 0x6e, 0xff, 0x47, 0xc1, 0xe5, 0x52, 0xe6, 0xca, 0x94, 0x94, 0x43, 0x6e, 0xdc,
+	0x99, 0xb7, 0x33, 0x6f, 0xde, 0xcc, 0x8e, 0x04, 0x3a, 0x63, 0x9e, 0xe3, 0x9d, 0x92, 0x20, 0x6c,
+	0x53, 0xee, 0x3c, 0xeb, 0xd3, 0xde, 0x99, 0xdd, 0xed, 0x45, 0x3c, 0xc2, 0xeb, 0x8c, 0x79, 0x76,
+	0xea, 0x31, 0x2a, 0x7e, 0xe4, 0x47, 0xc2, 0xe1, 0xc4, 0x5f, 0x09, 0xc6, 0xa8, 0xfa, 0x51, 0xe4,
+	0xb7, 0xa9, 0x43, 0xba, 0x81, 0x43, 0xc2, 0x30, 0xe2, 0x84, 0x07, 0x51, 0xc8, 0xa4, 0x77, 0xd3,
+	0x8b, 0x58, 0x27, 0x62, 0xce, 0x09, 0x61, 0x34, 0x09, 0xed, 0x3c, 0xbf, 0x7f, 0x42, 0x39, 0xb9,
+	0xef, 0x74, 0x89, 0x1f, 0x84, 0x02, 0x2c, 0xb1, 0xcb, 0x0a, 0x8f, 0x2e, 0xe9, 0x91, 0x4e, 0x1a,
+	0x66, 0x4d, 0x71, 0xa5, 0x1f, 0xc7, 0x8c, 0x13, 0xef, 0xa9, 0x84, 0xac, 0xe4, 0x42, 0x12, 0xa7,
+	0x55, 0x01, 0xfc, 0x32, 0x4e, 0xfe, 0x58, 0x04, 0x75, 0xe9, 0xb3, 0x3e, 0x65, 0xdc, 0xda, 0x87,
+	0x1b, 0x8a, 0x95, 0x75, 0xa3, 0x90, 0x51, 0xac, 0x43, 0x31, 0x49, 0xae, 0x6b, 0xab, 0x5a, 0x6d,
+	0xbe, 0x5e, 0xb1, 0xb3, 0x32, 0xd8, 0x09, 0xfa, 0xe1, 0xcc, 0xeb, 0xbf, 0x3f, 0x28, 0xb8, 0x12,
+	0x69, 0xf9, 0x70, 0x4b, 0x84, 0x3a, 0x08, 0x18, 0x6f, 0x48, 0x64, 0x2b, 0x66, 0x27, 0x73, 0xe1,
+	0x2e, 0xc0, 0x65, 0xc1, 0x32, 0xf0, 0xba, 0x9d, 0xa8, 0x63, 0xc7, 0xea, 0xd8, 0x89, 0xf0, 0x52,
+	0x1d, 0xfb, 0x31, 0xf1, 0xa9, 0xbc, 0xeb, 0x66, 0x6e, 0x5a, 0xbf, 0x6b, 0x60, 0x8e, 0xca, 0x24,
+	0xf9, 0x37, 0x60, 0x51, 0x71, 0xc4, 0x75, 0x4c, 0xd7, 0xe6, 0xeb, 0x2b, 0x6a, 0x1d, 0xea, 0xe5,
+	0xa1, 0x2b, 0xb8, 0xa7, 0xf0, 0x9d, 0x12, 0x7c, 0x37, 0x26, 0xf2, 0x4d, 0x18, 0x28, 0x84, 0x3f,
+	0x83, 0xaa, 0xe0, 0xbb, 0x47, 0xf3, 0x85, 0x59, 0x85, 0xf9, 0x27, 0x01, 0xeb, 0xb6, 0xc9, 0x59,
+	0x93, 0x74, 0xa8, 0x50, 0xa6, 0xe4, 0x66, 0x4d, 0xd6, 0x29, 0xdc, 0x1a, 0x11, 0x41, 0x16, 0xbc,
+	0x07, 0x0b, 0x8a, 0x43, 0xca, 0x3b, 0xae, 0x5e, 0xd9, 0x3e, 0xf5, 0x9e, 0xf5, 0xc7, 0x14, 0x2c,
+	0x5f, 0x11, 0x97, 0xbd, 0xe3, 0x16, 0xa2, 0x01, 0x73, 0x6d, 0xd2, 0x0f, 0xbd, 0x53, 0xda, 0x13,
+	0xc2, 0x96, 0xdc, 0xc1, 0x19, 0x4d, 0x80, 0x0e, 0x09, 0x42, 0x4e, 0x82, 0x90, 0xf6, 0xf4, 0x69,
+	0xe1, 0xcd, 0x58, 0xb0, 0x0a, 0x25, 0x31, 0xf4, 0x42, 0xab, 0x19, 0xe1, 0xbe, 0x34, 0xa0, 0x05,
+	0xd7, 0xc5, 0xe1, 0x88, 0xf6, 0x58, 0xcc, 0x71, 0x56, 0x00, 0x14, 0x1b, 0x96, 0x61, 0x9a, 0x13,
+	0x5f, 0x2f, 0x0a, 0x57, 0xfc, 0x89, 0x5b, 0x50, 0x64, 0x9c, 0xf0, 0x3e, 0xd3, 0xaf, 0xad, 0x6a,
+	0xb5, 0xc5, 0xe1, 0x79, 0x6f, 0x09, 0x9f, 0x2b, 0x31, 0x78, 0x07, 0xa6, 0x3d, 0xef, 0xb9, 0x3e,
+	0x27, 0xa0, 0x4b, 0x43, 0x12, 0x37, 0x8e, 0x76, 0x83, 0x36, 0xa7, 0x3d, 0x37, 0xc6, 0x58, 0xbf,
+	0x6a, 0x60, 0xe4, 0xc9, 0x29, 0xdb, 0xf6, 0x31, 0x94, 0x06, 0x46, 0x39, 0xa2, 0x37, 0xf3, 0x5b,
+	0xe6, 0x5e, 0x02, 0xdf, 0xdd, 0x60, 0x3e, 0x80, 0xa5, 0xe1, 0xb1, 0x4a, 0x3b, 0xad, 0xc3, 0x35,
+	0xc1, 0x61, 0xff, 0x89, 0x9c, 0xc7, 0xf4, 0x68, 0x1d, 0x82, 0x7e, 0xf5, 0x92, 0xac, 0xe7, 0x53,
+	0x98, 0x4b, 0x6d, 0x72, 0x3a, 0x46, 0x94, 0x23, 0x87, 0x6f, 0x80, 0xb6, 0x56, 0xe4, 0xd8, 0xa5,
+	0x86, 0x46, 0xd4, 0x0f, 0x53, 0x32, 0x56, 0x1d, 0x8c, 0x3c, 0xa7, 0x4c, 0x5a, 0x81, 0x59, 0x2f,
+	0x36, 0x88, 0x8c, 0x33, 0x6e, 0x72, 0xd8, 0x6c, 0x41, 0x69, 0xd0, 0x0b, 0x44, 0x58, 0x6c, 0x34,
+	0x8e, 0x8e, 0x77, 0xf7, 0x0f, 0x0e, 0x77, 0xdc, 0xe3, 0xed, 0xe6, 0xd7, 0xe5, 0x02, 0x2e, 0xc1,
+	0x8d, 0x8c, 0xad, 0xf1, 0xa8, 0xd9, 0xfa, 0xea, 0x8b, 0x1d, 0xb7, 0xac, 0xe1, 0x32, 0xbc, 0x9f,
+	0x71, 0xb4, 0x0e, 0xb7, 0x9b, 0x9f, 0x6f, 0x1f, 0x3c, 0x6a, 0xee, 0x94, 0xa7, 0xea, 0xff, 0x15,
+	0x61, 0x56, 0x30, 0xc1, 0xa7, 0x50, 0x4c, 0xb6, 0x20, 0xae, 0xaa, 0x15, 0x5e, 0x5d, 0xb2, 0xc6,
+	0xda, 0x18, 0x44, 0x52, 0x83, 0x55, 0xfd, 0xee, 0xcf, 0x7f, 0x7f, 0x9a, 0xba, 0x89, 0x15, 0x27,
+	0xe7, 0x17, 0x00, 0x7f, 0xd1, 0xe0, 0xbd, 0x2b, 0xcb, 0x0e, 0xef, 0xe6, 0x84, 0x1d, 0xb5, 0x7c,
+	0x8d, 0xad, 0xb7, 0x03, 0x4b, 0x3a, 0x77, 0x04, 0x9d, 0x0f, 0x71, 0x4d, 0xa5, 0xd3, 0x0e, 0x18,
+	0x3f, 0x56, 0x7f, 0x7a, 0xf0, 0x37, 0x0d, 0xca, 0xc3, 0x6b, 0x09, 0x37, 0x73, 0xb2, 0x8d, 0xd8,
+	0x7e, 0xc6, 0xdd, 0xb7, 0xc2, 0x4a, 0x62, 0x9f, 0x08, 0x62, 0xf7, 0xd0, 0x56, 0x89, 0xf9, 0x74,
+	0x98, 0x97, 0xf3, 0x32, 0xb3, 0x3f, 0x5f, 0xe1, 0xf7, 0x1a, 0x2c, 0x28, 0x4f, 0x10, 0x37, 0x26,
+	0x08, 0x32, 0xe8, 0x5e, 0x6d, 0x32, 0x50, 0x92, 0xbb, 0x2d, 0xc8, 0x99, 0x58, 0x1d, 0xa3, 0x1a,
+	0xc3, 0x1f, 0x34, 0x98, 0xcf, 0xd4, 0x87, 0x1f, 0x8d, 0xaf, 0x3f, 0xa5, 0xb1, 0x3e, 0x09, 0x26,
+	0x49, 0x6c, 0x09, 0x12, 0xeb, 0x78, 0x7b, 0xb4, 0x42, 0xce, 0x4b, 0xf9, 0x96, 0x5f, 0xe1, 0xcf,
+	0x1a, 0x2c, 0x28, 0xaf, 0x2a, 0x57, 0x97, 0xbc, 0x47, 0x69, 0xd4, 0x26, 0x03, 0x25, 0xa5, 0x7b,
+	0x82, 0xd2, 0x26, 0xd6, 0x1c, 0x46, 0x7c, 0xf2, 0xe2, 0xec, 0xdb, 0x31, 0xcd, 0x13, 0x8f, 0xf7,
+	0xe1, 0xf6, 0xeb, 0x73, 0x53, 0x7b, 0x73, 0x6e, 0x6a, 0xff, 0x9c, 0x9b, 0xda, 0x8f, 0x17, 0x66,
+	0xe1, 0xcd, 0x85, 0x59, 0xf8, 0xeb, 0xc2, 0x2c, 0x7c, 0xb3, 0xe1, 0x07, 0xfc, 0xb4, 0x7f, 0x62,
+	0x7b, 0x51, 0x47, 0x89, 0xf6, 0xe2, 0x32, 0x1e, 0x3f, 0xeb, 0x52, 0x76, 0x52, 0x14, 0x7f, 0x7b,
+	0x1e, 0xfc, 0x3f, 0x00, 0x85, 0x5f, 0x98, 0x52, 0xdb, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1123,6 +1221,51 @@ func (m *QueryListChainletsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Ccv != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Ccv))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StackVersion) > 0 {
+		i -= len(m.StackVersion)
+		copy(dAtA[i:], m.StackVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StackVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StackName) > 0 {
+		i -= len(m.StackName)
+		copy(dAtA[i:], m.StackName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StackName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Maintainer) > 0 {
+		i -= len(m.Maintainer)
+		copy(dAtA[i:], m.Maintainer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Maintainer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Launcher) > 0 {
+		i -= len(m.Launcher)
+		copy(dAtA[i:], m.Launcher)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Launcher)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1398,6 +1541,32 @@ func (m *QueryListChainletsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Launcher)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Maintainer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StackName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StackVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Ccv != 0 {
+		n += 1 + sovQuery(uint64(m.Ccv))
+	}
 	return n
 }
 
@@ -2040,6 +2209,204 @@ func (m *QueryListChainletsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launcher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Launcher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StackVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ccv", wireType)
+			}
+			m.Ccv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ccv |= CCVFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
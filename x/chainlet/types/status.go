@@ -0,0 +1,64 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+)
+
+// statusTransitions lists the statuses a chainlet can move to from each status.
+var statusTransitions = map[Status][]Status{
+	Status_STATUS_PENDING_SPAWN: {
+		Status_STATUS_ONLINE,
+		Status_STATUS_SUSPENDED_BILLING,
+		Status_STATUS_SUSPENDED_ADMIN,
+		Status_STATUS_DECOMMISSIONED,
+	},
+	Status_STATUS_ONLINE: {
+		Status_STATUS_UPGRADING,
+		Status_STATUS_SUSPENDED_BILLING,
+		Status_STATUS_SUSPENDED_ADMIN,
+		Status_STATUS_DECOMMISSIONED,
+	},
+	Status_STATUS_UPGRADING: {
+		Status_STATUS_ONLINE,
+		Status_STATUS_SUSPENDED_BILLING,
+		Status_STATUS_SUSPENDED_ADMIN,
+	},
+	Status_STATUS_SUSPENDED_BILLING: {
+		Status_STATUS_PENDING_SPAWN,
+		Status_STATUS_ONLINE,
+		Status_STATUS_UPGRADING,
+		Status_STATUS_SUSPENDED_ADMIN,
+		Status_STATUS_DECOMMISSIONED,
+	},
+	Status_STATUS_SUSPENDED_ADMIN: {
+		Status_STATUS_PENDING_SPAWN,
+		Status_STATUS_ONLINE,
+		Status_STATUS_UPGRADING,
+		Status_STATUS_DECOMMISSIONED,
+	},
+}
+
+// ValidateStatusTransition returns an error if a chainlet cannot move from one status to the other.
+func ValidateStatusTransition(from, to Status) error {
+	for _, s := range statusTransitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return cosmossdkerrors.Wrapf(ErrInvalidStatusTransition, "%s to %s", from, to)
+}
+
+// IsActive returns true if the chainlet is expected to be running (or about to be) and billed.
+func (s Status) IsActive() bool {
+	switch s {
+	case Status_STATUS_PENDING_SPAWN, Status_STATUS_ONLINE, Status_STATUS_UPGRADING:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSuspended returns true if the chainlet was stopped and can be resumed.
+func (s Status) IsSuspended() bool {
+	return s == Status_STATUS_SUSPENDED_BILLING || s == Status_STATUS_SUSPENDED_ADMIN
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/chainlet/types"
+)
+
+func TestValidateStatusTransition(t *testing.T) {
+	tests := []struct {
+		from, to types.Status
+		valid    bool
+	}{
+		{types.Status_STATUS_PENDING_SPAWN, types.Status_STATUS_ONLINE, true},
+		{types.Status_STATUS_PENDING_SPAWN, types.Status_STATUS_UPGRADING, false},
+		{types.Status_STATUS_ONLINE, types.Status_STATUS_UPGRADING, true},
+		{types.Status_STATUS_ONLINE, types.Status_STATUS_PENDING_SPAWN, false},
+		{types.Status_STATUS_ONLINE, types.Status_STATUS_ONLINE, false},
+		{types.Status_STATUS_UPGRADING, types.Status_STATUS_ONLINE, true},
+		{types.Status_STATUS_UPGRADING, types.Status_STATUS_DECOMMISSIONED, false},
+		{types.Status_STATUS_SUSPENDED_BILLING, types.Status_STATUS_SUSPENDED_ADMIN, true},
+		{types.Status_STATUS_SUSPENDED_ADMIN, types.Status_STATUS_SUSPENDED_BILLING, false},
+		{types.Status_STATUS_SUSPENDED_ADMIN, types.Status_STATUS_DECOMMISSIONED, true},
+		{types.Status_STATUS_DECOMMISSIONED, types.Status_STATUS_ONLINE, false},
+		{types.Status_STATUS_OFFLINE, types.Status_STATUS_ONLINE, false},
+	}
+	for _, tt := range tests {
+		err := types.ValidateStatusTransition(tt.from, tt.to)
+		if tt.valid {
+			require.NoError(t, err, "%s to %s", tt.from, tt.to)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidStatusTransition, "%s to %s", tt.from, tt.to)
+		}
+	}
+}
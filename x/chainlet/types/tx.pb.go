@@ -883,1349 +883,4478 @@ func (m *MsgUpdateChainletStackFeesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateChainletStackFeesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateChainletStack)(nil), "ssc.chainlet.MsgCreateChainletStack")
-	proto.RegisterType((*MsgCreateChainletStackResponse)(nil), "ssc.chainlet.MsgCreateChainletStackResponse")
-	proto.RegisterType((*MsgLaunchChainlet)(nil), "ssc.chainlet.MsgLaunchChainlet")
-	proto.RegisterType((*MsgLaunchChainletResponse)(nil), "ssc.chainlet.MsgLaunchChainletResponse")
-	proto.RegisterType((*MsgUpdateChainletStack)(nil), "ssc.chainlet.MsgUpdateChainletStack")
-	proto.RegisterType((*MsgUpdateChainletStackResponse)(nil), "ssc.chainlet.MsgUpdateChainletStackResponse")
-	proto.RegisterType((*MsgDisableChainletStackVersion)(nil), "ssc.chainlet.MsgDisableChainletStackVersion")
-	proto.RegisterType((*MsgDisableChainletStackVersionResponse)(nil), "ssc.chainlet.MsgDisableChainletStackVersionResponse")
-	proto.RegisterType((*MsgUpgradeChainlet)(nil), "ssc.chainlet.MsgUpgradeChainlet")
-	proto.RegisterType((*MsgUpgradeChainletResponse)(nil), "ssc.chainlet.MsgUpgradeChainletResponse")
-	proto.RegisterType((*MsgCancelChainletUpgrade)(nil), "ssc.chainlet.MsgCancelChainletUpgrade")
-	proto.RegisterType((*MsgCancelChainletUpgradeResponse)(nil), "ssc.chainlet.MsgCancelChainletUpgradeResponse")
-	proto.RegisterType((*MsgUpdateChainletStackFees)(nil), "ssc.chainlet.MsgUpdateChainletStackFees")
-	proto.RegisterType((*MsgUpdateChainletStackFeesResponse)(nil), "ssc.chainlet.MsgUpdateChainletStackFeesResponse")
+// MsgUpdateChainletParams updates the params of a running chainlet. Only the
+// params named in the update mask are set, zero values included, the others
+// keep their current setting.
+type MsgUpdateChainletParams struct {
+	Creator string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string         `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Params  ChainletParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// Names of the params to update, e.g. "createEmptyBlocks"
+	UpdateMask []string `protobuf:"bytes,4,rep,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (m *MsgUpdateChainletParams) Reset()         { *m = MsgUpdateChainletParams{} }
+func (m *MsgUpdateChainletParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainletParams) ProtoMessage()    {}
+func (*MsgUpdateChainletParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{14}
+}
+func (m *MsgUpdateChainletParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("ssc/chainlet/tx.proto", fileDescriptor_7e7ff960f25a570e) }
-
-var fileDescriptor_7e7ff960f25a570e = []byte{
-	// 969 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x8f, 0xd3, 0x46,
-	0x18, 0x5e, 0x27, 0xd9, 0xb0, 0xfb, 0x26, 0x02, 0x31, 0x7c, 0x19, 0x43, 0x13, 0xd7, 0x42, 0x4b,
-	0x84, 0x90, 0xdd, 0xa6, 0x5c, 0xca, 0x8d, 0xdd, 0x55, 0x25, 0xa4, 0x6e, 0x85, 0x82, 0xe8, 0x01,
-	0xa9, 0xaa, 0x26, 0xe3, 0xc1, 0x19, 0x11, 0x7b, 0x22, 0x8f, 0xbd, 0xda, 0xed, 0xa9, 0xad, 0x7a,
-	0xe1, 0xd6, 0x43, 0x0f, 0xfd, 0x13, 0x48, 0xfc, 0x8c, 0x3d, 0xee, 0xb1, 0x27, 0x5a, 0xed, 0x1e,
-	0xf8, 0x19, 0xad, 0x66, 0xfc, 0x81, 0xbf, 0x12, 0x42, 0xdb, 0x53, 0x3c, 0xef, 0x3c, 0x33, 0xf3,
-	0x3e, 0xcf, 0xfb, 0x15, 0xb8, 0x26, 0x04, 0x71, 0xc8, 0x0c, 0xb3, 0x60, 0x4e, 0x23, 0x27, 0x3a,
-	0xb2, 0x17, 0x21, 0x8f, 0x38, 0xea, 0x0b, 0x41, 0xec, 0xcc, 0x6c, 0x5c, 0xf5, 0xb8, 0xc7, 0xd5,
-	0x86, 0x23, 0xbf, 0x12, 0x8c, 0x31, 0xf0, 0x38, 0xf7, 0xe6, 0xd4, 0x51, 0xab, 0x69, 0xfc, 0xc2,
-	0x71, 0xe3, 0x10, 0x47, 0x8c, 0x07, 0xe9, 0xfe, 0x0d, 0xc2, 0x85, 0xcf, 0x85, 0xe3, 0x0b, 0xcf,
-	0x39, 0xfc, 0x5c, 0xfe, 0xa4, 0x1b, 0x56, 0xe9, 0xcd, 0xec, 0xe3, 0xfb, 0x05, 0x0e, 0xb1, 0x2f,
-	0x52, 0xcc, 0xa7, 0xcd, 0x18, 0x11, 0x61, 0xf2, 0x32, 0x81, 0x58, 0xaf, 0x5b, 0x70, 0xfd, 0x40,
-	0x78, 0x7b, 0x21, 0xc5, 0x11, 0xdd, 0x4b, 0x11, 0x4f, 0x25, 0x00, 0xe9, 0x70, 0x81, 0x48, 0x33,
-	0x0f, 0x75, 0xcd, 0xd4, 0x46, 0xdb, 0x93, 0x6c, 0x89, 0x4c, 0xe8, 0xb9, 0x4c, 0x2c, 0xe6, 0xf8,
-	0xf8, 0x1b, 0xec, 0x53, 0xbd, 0xa5, 0x76, 0x8b, 0x26, 0x85, 0xa0, 0x82, 0x84, 0x6c, 0x21, 0xb9,
-	0xe8, 0xed, 0x14, 0xf1, 0xde, 0x84, 0xae, 0xc2, 0x26, 0xf3, 0xb1, 0x47, 0xf5, 0x8e, 0xda, 0x4b,
-	0x16, 0xf2, 0xcd, 0x43, 0x1a, 0x0a, 0x79, 0x66, 0x33, 0x79, 0x33, 0x5d, 0x22, 0x03, 0xb6, 0xc8,
-	0x8c, 0x92, 0x97, 0x22, 0xf6, 0xf5, 0xae, 0xda, 0xca, 0xd7, 0xe8, 0x4b, 0xe8, 0xbc, 0xa0, 0x54,
-	0xe8, 0x17, 0x4c, 0x6d, 0xd4, 0x1b, 0x0f, 0xed, 0xa2, 0xee, 0x76, 0x89, 0xd4, 0x57, 0x94, 0x8a,
-	0xdd, 0xce, 0xc9, 0xdb, 0xe1, 0xc6, 0x44, 0x1d, 0x91, 0x8e, 0x12, 0x72, 0xb8, 0xc7, 0x03, 0x11,
-	0xfb, 0x34, 0xd4, 0xb7, 0x4c, 0x6d, 0xb4, 0x35, 0x29, 0x9a, 0x1e, 0xf6, 0x7f, 0x7e, 0xf7, 0xe6,
-	0x5e, 0x46, 0xdd, 0x32, 0x61, 0xd0, 0x2c, 0xd7, 0x84, 0x8a, 0x05, 0x0f, 0x04, 0xb5, 0xfe, 0x6e,
-	0xc3, 0xe5, 0x03, 0xe1, 0x7d, 0x8d, 0xe3, 0x80, 0xcc, 0x32, 0xc8, 0x0a, 0x31, 0x2d, 0xe8, 0x67,
-	0xbe, 0x16, 0xd4, 0x2c, 0xd9, 0xd4, 0x69, 0xb9, 0x7e, 0xec, 0xa6, 0x52, 0x66, 0x4b, 0x74, 0x1f,
-	0x2e, 0x93, 0xa2, 0x1b, 0xea, 0x8a, 0x44, 0xd2, 0xfa, 0x06, 0x1a, 0xc3, 0xd5, 0x92, 0xf1, 0xdb,
-	0x92, 0xd6, 0x8d, 0x7b, 0x52, 0x21, 0x1f, 0xb3, 0x20, 0xc2, 0x2c, 0xa0, 0xa1, 0xd0, 0xbb, 0x66,
-	0x5b, 0x86, 0xb2, 0x60, 0x92, 0xa1, 0x74, 0x69, 0xc0, 0x7d, 0xa5, 0xff, 0xf6, 0x24, 0x59, 0xa0,
-	0x87, 0xd0, 0x4d, 0x92, 0x51, 0x89, 0xda, 0x1b, 0xdf, 0x6e, 0x0e, 0xcb, 0x13, 0x85, 0x49, 0x63,
-	0x92, 0x9e, 0x40, 0xfb, 0xf0, 0x89, 0xcb, 0x04, 0x9e, 0xce, 0xe9, 0xa3, 0x38, 0xe2, 0x3e, 0x8e,
-	0x18, 0x51, 0x3e, 0x3d, 0x5b, 0x78, 0x21, 0x76, 0xa9, 0xd0, 0xb7, 0x55, 0x9c, 0x56, 0x83, 0xa4,
-	0x36, 0x4c, 0x3c, 0xa5, 0xe1, 0x21, 0x23, 0x79, 0xac, 0x74, 0x50, 0x27, 0xeb, 0x1b, 0x08, 0x41,
-	0x27, 0xc2, 0x9e, 0xd0, 0x7b, 0x8a, 0xa0, 0xfa, 0x46, 0x3b, 0x70, 0x91, 0xc4, 0x22, 0xe2, 0x7e,
-	0x12, 0x4d, 0x1a, 0xea, 0x7d, 0x45, 0xb1, 0x62, 0xad, 0xe4, 0xc8, 0x2d, 0xb8, 0x59, 0x4b, 0x80,
-	0x3c, 0x3d, 0x4e, 0x35, 0x55, 0x70, 0xcf, 0x16, 0xee, 0xff, 0x5a, 0x70, 0x79, 0x39, 0xb5, 0x97,
-	0x94, 0x53, 0x67, 0x79, 0x39, 0x6d, 0x56, 0xca, 0xa9, 0x52, 0x13, 0xdd, 0xf5, 0x6a, 0xa2, 0x81,
-	0x51, 0x4e, 0xfa, 0x17, 0x4d, 0x41, 0xf6, 0x93, 0x70, 0xed, 0x35, 0xa5, 0xd9, 0x7f, 0x21, 0x5f,
-	0xa0, 0xd9, 0x2e, 0xd1, 0xac, 0x38, 0x3a, 0x82, 0x9d, 0xd5, 0x5e, 0xe4, 0x0e, 0xbf, 0x6a, 0x01,
-	0x52, 0x9c, 0x54, 0x2a, 0xad, 0x51, 0xc5, 0x85, 0x0a, 0x6d, 0x95, 0x2b, 0xd4, 0x82, 0xbe, 0x28,
-	0xd6, 0x5a, 0xe2, 0x61, 0xc9, 0x26, 0x29, 0xce, 0x28, 0xf3, 0x66, 0xd1, 0x3e, 0x9d, 0x47, 0x58,
-	0xc5, 0xaa, 0x33, 0x29, 0x9a, 0xd0, 0x6d, 0xd8, 0x26, 0x33, 0x1c, 0x04, 0x74, 0xfe, 0xd8, 0x4d,
-	0x03, 0xf6, 0xde, 0x80, 0x0e, 0xe0, 0x52, 0x1c, 0x4c, 0x79, 0xe0, 0xb2, 0xc0, 0x7b, 0x42, 0x43,
-	0xc6, 0x5d, 0x15, 0xb5, 0xde, 0xf8, 0xa6, 0x9d, 0xcc, 0x17, 0x3b, 0x9b, 0x2f, 0xf6, 0x7e, 0x3a,
-	0x5f, 0x76, 0xb7, 0x4e, 0xde, 0x0e, 0xb5, 0xdf, 0xff, 0x1c, 0x6a, 0x93, 0xea, 0xd9, 0x8a, 0x6a,
-	0x0f, 0xc0, 0xa8, 0x4b, 0x91, 0x29, 0x85, 0xae, 0x43, 0x37, 0xf1, 0x53, 0x29, 0xd2, 0x99, 0xa4,
-	0x2b, 0xeb, 0x37, 0x0d, 0x74, 0xd9, 0x29, 0x71, 0x40, 0xe8, 0x3c, 0x3b, 0x95, 0x5e, 0xf2, 0xaf,
-	0x74, 0x5c, 0x1a, 0xe4, 0xb2, 0x36, 0x9d, 0x8a, 0x36, 0x15, 0x32, 0x16, 0x98, 0xcb, 0xbc, 0xca,
-	0x83, 0xff, 0x5a, 0x4b, 0x19, 0xd7, 0x12, 0x5a, 0x8e, 0x8f, 0x15, 0xce, 0x37, 0x36, 0xe3, 0xd6,
-	0xb2, 0x66, 0x9c, 0x4d, 0xad, 0xb6, 0xd9, 0xfe, 0xc8, 0xa9, 0x55, 0xe1, 0x74, 0x07, 0xac, 0xe5,
-	0xee, 0x66, 0xac, 0xc6, 0xaf, 0xba, 0xd0, 0x3e, 0x10, 0x1e, 0x62, 0x70, 0xa5, 0x69, 0xda, 0xdf,
-	0x29, 0xbf, 0xdf, 0x3c, 0xe4, 0x8c, 0xfb, 0xeb, 0xa0, 0xf2, 0xdc, 0x78, 0x0e, 0x17, 0x2b, 0x63,
-	0x70, 0x58, 0x3b, 0x5f, 0x06, 0x18, 0x77, 0x3f, 0x00, 0xc8, 0xef, 0x66, 0x70, 0xa5, 0xa9, 0x87,
-	0xd6, 0x69, 0x34, 0xa0, 0x1a, 0x68, 0xac, 0xe8, 0x5e, 0xe8, 0x27, 0x0d, 0x6e, 0xad, 0x6a, 0x5d,
-	0xf5, 0xdb, 0x56, 0xa0, 0x8d, 0x07, 0x1f, 0x83, 0xce, 0x7d, 0x88, 0xe1, 0xc6, 0xb2, 0x7c, 0x1c,
-	0xad, 0x43, 0x46, 0x22, 0x8d, 0xcf, 0xd6, 0x45, 0xe6, 0xcf, 0x7e, 0x07, 0x97, 0xaa, 0x3d, 0xd0,
-	0x6c, 0xb8, 0xa4, 0x84, 0x30, 0x46, 0x1f, 0x42, 0xe4, 0xd7, 0x73, 0xb8, 0xd6, 0xdc, 0x20, 0x76,
-	0xea, 0x79, 0xd6, 0x84, 0x33, 0xec, 0xf5, 0x70, 0xd9, 0x83, 0xc6, 0xe6, 0x8f, 0xef, 0xde, 0xdc,
-	0xd3, 0x76, 0x1f, 0x9d, 0x9c, 0x0d, 0xb4, 0xd3, 0xb3, 0x81, 0xf6, 0xd7, 0xd9, 0x40, 0xfb, 0xf5,
-	0x7c, 0xb0, 0x71, 0x7a, 0x3e, 0xd8, 0xf8, 0xe3, 0x7c, 0xb0, 0xf1, 0xfc, 0xae, 0xc7, 0xa2, 0x59,
-	0x3c, 0xb5, 0x09, 0xf7, 0x1d, 0x81, 0x3d, 0x7c, 0x74, 0xfc, 0x83, 0x23, 0xff, 0x45, 0x1f, 0x15,
-	0xfe, 0xdf, 0x1f, 0x2f, 0xa8, 0x98, 0x76, 0x55, 0x47, 0xfd, 0xe2, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xc7, 0xb8, 0x9d, 0x81, 0xfc, 0x0b, 0x00, 0x00,
+func (m *MsgUpdateChainletParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainletParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainletParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainletParams.Merge(m, src)
+}
+func (m *MsgUpdateChainletParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainletParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainletParams.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateChainletParams proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateChainletStack(ctx context.Context, in *MsgCreateChainletStack, opts ...grpc.CallOption) (*MsgCreateChainletStackResponse, error)
-	LaunchChainlet(ctx context.Context, in *MsgLaunchChainlet, opts ...grpc.CallOption) (*MsgLaunchChainletResponse, error)
-	UpdateChainletStack(ctx context.Context, in *MsgUpdateChainletStack, opts ...grpc.CallOption) (*MsgUpdateChainletStackResponse, error)
-	DisableChainletStackVersion(ctx context.Context, in *MsgDisableChainletStackVersion, opts ...grpc.CallOption) (*MsgDisableChainletStackVersionResponse, error)
-	UpdateChainletStackFees(ctx context.Context, in *MsgUpdateChainletStackFees, opts ...grpc.CallOption) (*MsgUpdateChainletStackFeesResponse, error)
-	UpgradeChainlet(ctx context.Context, in *MsgUpgradeChainlet, opts ...grpc.CallOption) (*MsgUpgradeChainletResponse, error)
-	CancelChainletUpgrade(ctx context.Context, in *MsgCancelChainletUpgrade, opts ...grpc.CallOption) (*MsgCancelChainletUpgradeResponse, error)
+func (m *MsgUpdateChainletParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateChainletParams) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateChainletParams) GetParams() ChainletParams {
+	if m != nil {
+		return m.Params
+	}
+	return ChainletParams{}
 }
 
-func (c *msgClient) CreateChainletStack(ctx context.Context, in *MsgCreateChainletStack, opts ...grpc.CallOption) (*MsgCreateChainletStackResponse, error) {
-	out := new(MsgCreateChainletStackResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/CreateChainletStack", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateChainletParams) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) LaunchChainlet(ctx context.Context, in *MsgLaunchChainlet, opts ...grpc.CallOption) (*MsgLaunchChainletResponse, error) {
-	out := new(MsgLaunchChainletResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/LaunchChainlet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUpdateChainletParamsResponse struct {
 }
 
-func (c *msgClient) UpdateChainletStack(ctx context.Context, in *MsgUpdateChainletStack, opts ...grpc.CallOption) (*MsgUpdateChainletStackResponse, error) {
-	out := new(MsgUpdateChainletStackResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpdateChainletStack", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateChainletParamsResponse) Reset()         { *m = MsgUpdateChainletParamsResponse{} }
+func (m *MsgUpdateChainletParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainletParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateChainletParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{15}
+}
+func (m *MsgUpdateChainletParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainletParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainletParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateChainletParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainletParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateChainletParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainletParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainletParamsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DisableChainletStackVersion(ctx context.Context, in *MsgDisableChainletStackVersion, opts ...grpc.CallOption) (*MsgDisableChainletStackVersionResponse, error) {
-	out := new(MsgDisableChainletStackVersionResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/DisableChainletStackVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateChainletParamsResponse proto.InternalMessageInfo
+
+type MsgAddChainletMaintainer struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Maintainer string `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
 }
 
-func (c *msgClient) UpdateChainletStackFees(ctx context.Context, in *MsgUpdateChainletStackFees, opts ...grpc.CallOption) (*MsgUpdateChainletStackFeesResponse, error) {
-	out := new(MsgUpdateChainletStackFeesResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpdateChainletStackFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddChainletMaintainer) Reset()         { *m = MsgAddChainletMaintainer{} }
+func (m *MsgAddChainletMaintainer) String() string { return proto.CompactTextString(m) }
+func (*MsgAddChainletMaintainer) ProtoMessage()    {}
+func (*MsgAddChainletMaintainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{16}
+}
+func (m *MsgAddChainletMaintainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddChainletMaintainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddChainletMaintainer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAddChainletMaintainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddChainletMaintainer.Merge(m, src)
+}
+func (m *MsgAddChainletMaintainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddChainletMaintainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddChainletMaintainer.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpgradeChainlet(ctx context.Context, in *MsgUpgradeChainlet, opts ...grpc.CallOption) (*MsgUpgradeChainletResponse, error) {
-	out := new(MsgUpgradeChainletResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpgradeChainlet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddChainletMaintainer proto.InternalMessageInfo
+
+func (m *MsgAddChainletMaintainer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelChainletUpgrade(ctx context.Context, in *MsgCancelChainletUpgrade, opts ...grpc.CallOption) (*MsgCancelChainletUpgradeResponse, error) {
-	out := new(MsgCancelChainletUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/CancelChainletUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddChainletMaintainer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateChainletStack(context.Context, *MsgCreateChainletStack) (*MsgCreateChainletStackResponse, error)
-	LaunchChainlet(context.Context, *MsgLaunchChainlet) (*MsgLaunchChainletResponse, error)
-	UpdateChainletStack(context.Context, *MsgUpdateChainletStack) (*MsgUpdateChainletStackResponse, error)
-	DisableChainletStackVersion(context.Context, *MsgDisableChainletStackVersion) (*MsgDisableChainletStackVersionResponse, error)
-	UpdateChainletStackFees(context.Context, *MsgUpdateChainletStackFees) (*MsgUpdateChainletStackFeesResponse, error)
-	UpgradeChainlet(context.Context, *MsgUpgradeChainlet) (*MsgUpgradeChainletResponse, error)
-	CancelChainletUpgrade(context.Context, *MsgCancelChainletUpgrade) (*MsgCancelChainletUpgradeResponse, error)
+func (m *MsgAddChainletMaintainer) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgAddChainletMaintainerResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateChainletStack(ctx context.Context, req *MsgCreateChainletStack) (*MsgCreateChainletStackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateChainletStack not implemented")
-}
-func (*UnimplementedMsgServer) LaunchChainlet(ctx context.Context, req *MsgLaunchChainlet) (*MsgLaunchChainletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LaunchChainlet not implemented")
+func (m *MsgAddChainletMaintainerResponse) Reset()         { *m = MsgAddChainletMaintainerResponse{} }
+func (m *MsgAddChainletMaintainerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddChainletMaintainerResponse) ProtoMessage()    {}
+func (*MsgAddChainletMaintainerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{17}
 }
-func (*UnimplementedMsgServer) UpdateChainletStack(ctx context.Context, req *MsgUpdateChainletStack) (*MsgUpdateChainletStackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainletStack not implemented")
+func (m *MsgAddChainletMaintainerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DisableChainletStackVersion(ctx context.Context, req *MsgDisableChainletStackVersion) (*MsgDisableChainletStackVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableChainletStackVersion not implemented")
+func (m *MsgAddChainletMaintainerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddChainletMaintainerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateChainletStackFees(ctx context.Context, req *MsgUpdateChainletStackFees) (*MsgUpdateChainletStackFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainletStackFees not implemented")
+func (m *MsgAddChainletMaintainerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddChainletMaintainerResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpgradeChainlet(ctx context.Context, req *MsgUpgradeChainlet) (*MsgUpgradeChainletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpgradeChainlet not implemented")
+func (m *MsgAddChainletMaintainerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelChainletUpgrade(ctx context.Context, req *MsgCancelChainletUpgrade) (*MsgCancelChainletUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelChainletUpgrade not implemented")
+func (m *MsgAddChainletMaintainerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddChainletMaintainerResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgAddChainletMaintainerResponse proto.InternalMessageInfo
+
+type MsgRemoveChainletMaintainer struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Maintainer string `protobuf:"bytes,3,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
 }
 
-func _Msg_CreateChainletStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateChainletStack)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateChainletStack(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/CreateChainletStack",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateChainletStack(ctx, req.(*MsgCreateChainletStack))
+func (m *MsgRemoveChainletMaintainer) Reset()         { *m = MsgRemoveChainletMaintainer{} }
+func (m *MsgRemoveChainletMaintainer) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChainletMaintainer) ProtoMessage()    {}
+func (*MsgRemoveChainletMaintainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{18}
+}
+func (m *MsgRemoveChainletMaintainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChainletMaintainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChainletMaintainer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveChainletMaintainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChainletMaintainer.Merge(m, src)
+}
+func (m *MsgRemoveChainletMaintainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChainletMaintainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChainletMaintainer.DiscardUnknown(m)
 }
 
-func _Msg_LaunchChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLaunchChainlet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LaunchChainlet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/LaunchChainlet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LaunchChainlet(ctx, req.(*MsgLaunchChainlet))
+var xxx_messageInfo_MsgRemoveChainletMaintainer proto.InternalMessageInfo
+
+func (m *MsgRemoveChainletMaintainer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateChainletStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateChainletStack)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRemoveChainletMaintainer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateChainletStack(ctx, in)
+	return ""
+}
+
+func (m *MsgRemoveChainletMaintainer) GetMaintainer() string {
+	if m != nil {
+		return m.Maintainer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/UpdateChainletStack",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateChainletStack(ctx, req.(*MsgUpdateChainletStack))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DisableChainletStackVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisableChainletStackVersion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisableChainletStackVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/DisableChainletStackVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisableChainletStackVersion(ctx, req.(*MsgDisableChainletStackVersion))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRemoveChainletMaintainerResponse struct {
 }
 
-func _Msg_UpdateChainletStackFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateChainletStackFees)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateChainletStackFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/UpdateChainletStackFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateChainletStackFees(ctx, req.(*MsgUpdateChainletStackFees))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRemoveChainletMaintainerResponse) Reset()         { *m = MsgRemoveChainletMaintainerResponse{} }
+func (m *MsgRemoveChainletMaintainerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChainletMaintainerResponse) ProtoMessage()    {}
+func (*MsgRemoveChainletMaintainerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{19}
 }
-
-func _Msg_UpgradeChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpgradeChainlet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpgradeChainlet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/UpgradeChainlet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpgradeChainlet(ctx, req.(*MsgUpgradeChainlet))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRemoveChainletMaintainerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_CancelChainletUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelChainletUpgrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelChainletUpgrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ssc.chainlet.Msg/CancelChainletUpgrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelChainletUpgrade(ctx, req.(*MsgCancelChainletUpgrade))
+func (m *MsgRemoveChainletMaintainerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChainletMaintainerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ssc.chainlet.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateChainletStack",
-			Handler:    _Msg_CreateChainletStack_Handler,
-		},
-		{
-			MethodName: "LaunchChainlet",
-			Handler:    _Msg_LaunchChainlet_Handler,
-		},
-		{
-			MethodName: "UpdateChainletStack",
-			Handler:    _Msg_UpdateChainletStack_Handler,
-		},
-		{
-			MethodName: "DisableChainletStackVersion",
-			Handler:    _Msg_DisableChainletStackVersion_Handler,
-		},
-		{
-			MethodName: "UpdateChainletStackFees",
-			Handler:    _Msg_UpdateChainletStackFees_Handler,
-		},
-		{
-			MethodName: "UpgradeChainlet",
-			Handler:    _Msg_UpgradeChainlet_Handler,
-		},
-		{
-			MethodName: "CancelChainletUpgrade",
-			Handler:    _Msg_CancelChainletUpgrade_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ssc/chainlet/tx.proto",
+func (m *MsgRemoveChainletMaintainerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChainletMaintainerResponse.Merge(m, src)
 }
-
-func (m *MsgCreateChainletStack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveChainletMaintainerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChainletMaintainerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChainletMaintainerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateChainletStack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveChainletMaintainerResponse proto.InternalMessageInfo
+
+// MsgTransferChainletOwnership transfers the launcher role of a chainlet. If
+// requireAccept is set, the transfer only takes effect once the new launcher
+// sends MsgAcceptChainletOwnership. An empty newLauncher, or the current
+// launcher, cancels the pending transfer.
+type MsgTransferChainletOwnership struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId       string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	NewLauncher   string `protobuf:"bytes,3,opt,name=newLauncher,proto3" json:"newLauncher,omitempty"`
+	RequireAccept bool   `protobuf:"varint,4,opt,name=requireAccept,proto3" json:"requireAccept,omitempty"`
 }
 
-func (m *MsgCreateChainletStack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CcvConsumer {
-		i--
-		if m.CcvConsumer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTransferChainletOwnership) Reset()         { *m = MsgTransferChainletOwnership{} }
+func (m *MsgTransferChainletOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferChainletOwnership) ProtoMessage()    {}
+func (*MsgTransferChainletOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{20}
+}
+func (m *MsgTransferChainletOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferChainletOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferChainletOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Checksum) > 0 {
-		i -= len(m.Checksum)
-		copy(dAtA[i:], m.Checksum)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DisplayName) > 0 {
-		i -= len(m.DisplayName)
-		copy(dAtA[i:], m.DisplayName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferChainletOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferChainletOwnership.Merge(m, src)
+}
+func (m *MsgTransferChainletOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferChainletOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferChainletOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgCreateChainletStackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferChainletOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferChainletOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateChainletStackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferChainletOwnership) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgCreateChainletStackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferChainletOwnership) GetNewLauncher() string {
+	if m != nil {
+		return m.NewLauncher
+	}
+	return ""
 }
 
-func (m *MsgLaunchChainlet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferChainletOwnership) GetRequireAccept() bool {
+	if m != nil {
+		return m.RequireAccept
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgLaunchChainlet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTransferChainletOwnershipResponse struct {
 }
 
-func (m *MsgLaunchChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CustomLauncher) > 0 {
-		i -= len(m.CustomLauncher)
-		copy(dAtA[i:], m.CustomLauncher)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomLauncher)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Tags) > 0 {
-		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tags[iNdEx])
-			copy(dAtA[i:], m.Tags[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Tags[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.IsServiceChainlet {
-		i--
-		if m.IsServiceChainlet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.DisableAutomaticStackUpgrades {
-		i--
-		if m.DisableAutomaticStackUpgrades {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTransferChainletOwnershipResponse) Reset()         { *m = MsgTransferChainletOwnershipResponse{} }
+func (m *MsgTransferChainletOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferChainletOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferChainletOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{21}
+}
+func (m *MsgTransferChainletOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferChainletOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferChainletOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.Maintainers) > 0 {
-		for iNdEx := len(m.Maintainers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Maintainers[iNdEx])
-			copy(dAtA[i:], m.Maintainers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Maintainers[iNdEx])))
-			i--
-			dAtA[i] = 0x32
+}
+func (m *MsgTransferChainletOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferChainletOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferChainletOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferChainletOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferChainletOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferChainletOwnershipResponse proto.InternalMessageInfo
+
+type MsgAcceptChainletOwnership struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (m *MsgAcceptChainletOwnership) Reset()         { *m = MsgAcceptChainletOwnership{} }
+func (m *MsgAcceptChainletOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptChainletOwnership) ProtoMessage()    {}
+func (*MsgAcceptChainletOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{22}
+}
+func (m *MsgAcceptChainletOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptChainletOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptChainletOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ChainletStackVersion) > 0 {
-		i -= len(m.ChainletStackVersion)
-		copy(dAtA[i:], m.ChainletStackVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletStackVersion)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ChainletStackName) > 0 {
-		i -= len(m.ChainletStackName)
-		copy(dAtA[i:], m.ChainletStackName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletStackName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainletName) > 0 {
-		i -= len(m.ChainletName)
-		copy(dAtA[i:], m.ChainletName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptChainletOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptChainletOwnership.Merge(m, src)
+}
+func (m *MsgAcceptChainletOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptChainletOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptChainletOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgLaunchChainletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptChainletOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptChainletOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgLaunchChainletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptChainletOwnership) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgLaunchChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgAcceptChainletOwnershipResponse struct {
 }
 
-func (m *MsgUpdateChainletStack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptChainletOwnershipResponse) Reset()         { *m = MsgAcceptChainletOwnershipResponse{} }
+func (m *MsgAcceptChainletOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptChainletOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptChainletOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{23}
+}
+func (m *MsgAcceptChainletOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptChainletOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptChainletOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAcceptChainletOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptChainletOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptChainletOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptChainletOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptChainletOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateChainletStack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcceptChainletOwnershipResponse proto.InternalMessageInfo
+
+type MsgDecommissionChainlet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (m *MsgUpdateChainletStack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CcvConsumer {
-		i--
-		if m.CcvConsumer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgDecommissionChainlet) Reset()         { *m = MsgDecommissionChainlet{} }
+func (m *MsgDecommissionChainlet) String() string { return proto.CompactTextString(m) }
+func (*MsgDecommissionChainlet) ProtoMessage()    {}
+func (*MsgDecommissionChainlet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{24}
+}
+func (m *MsgDecommissionChainlet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecommissionChainlet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecommissionChainlet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Checksum) > 0 {
-		i -= len(m.Checksum)
-		copy(dAtA[i:], m.Checksum)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DisplayName) > 0 {
-		i -= len(m.DisplayName)
-		copy(dAtA[i:], m.DisplayName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDecommissionChainlet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecommissionChainlet.Merge(m, src)
+}
+func (m *MsgDecommissionChainlet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecommissionChainlet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecommissionChainlet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDecommissionChainlet proto.InternalMessageInfo
+
+func (m *MsgDecommissionChainlet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateChainletStackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDecommissionChainlet) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateChainletStackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDecommissionChainletResponse struct {
 }
 
-func (m *MsgUpdateChainletStackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDecommissionChainletResponse) Reset()         { *m = MsgDecommissionChainletResponse{} }
+func (m *MsgDecommissionChainletResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDecommissionChainletResponse) ProtoMessage()    {}
+func (*MsgDecommissionChainletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{25}
 }
-
-func (m *MsgDisableChainletStackVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDecommissionChainletResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDecommissionChainletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDecommissionChainletResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDecommissionChainletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDecommissionChainletResponse.Merge(m, src)
+}
+func (m *MsgDecommissionChainletResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDecommissionChainletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDecommissionChainletResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDisableChainletStackVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDecommissionChainletResponse proto.InternalMessageInfo
+
+type MsgSuspendChainlet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (m *MsgDisableChainletStackVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DisplayName) > 0 {
-		i -= len(m.DisplayName)
-		copy(dAtA[i:], m.DisplayName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSuspendChainlet) Reset()         { *m = MsgSuspendChainlet{} }
+func (m *MsgSuspendChainlet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendChainlet) ProtoMessage()    {}
+func (*MsgSuspendChainlet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{26}
+}
+func (m *MsgSuspendChainlet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendChainlet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendChainlet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSuspendChainlet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendChainlet.Merge(m, src)
+}
+func (m *MsgSuspendChainlet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendChainlet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendChainlet.DiscardUnknown(m)
 }
 
-func (m *MsgDisableChainletStackVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSuspendChainlet proto.InternalMessageInfo
+
+func (m *MsgSuspendChainlet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDisableChainletStackVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSuspendChainlet) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *MsgDisableChainletStackVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSuspendChainletResponse struct {
 }
 
-func (m *MsgUpgradeChainlet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSuspendChainletResponse) Reset()         { *m = MsgSuspendChainletResponse{} }
+func (m *MsgSuspendChainletResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendChainletResponse) ProtoMessage()    {}
+func (*MsgSuspendChainletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{27}
+}
+func (m *MsgSuspendChainletResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendChainletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendChainletResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSuspendChainletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendChainletResponse.Merge(m, src)
+}
+func (m *MsgSuspendChainletResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendChainletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendChainletResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpgradeChainlet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSuspendChainletResponse proto.InternalMessageInfo
+
+type MsgResumeChainlet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
 
-func (m *MsgUpgradeChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingPeriod != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UnbondingPeriod):])
-		if err3 != nil {
-			return 0, err3
+func (m *MsgResumeChainlet) Reset()         { *m = MsgResumeChainlet{} }
+func (m *MsgResumeChainlet) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeChainlet) ProtoMessage()    {}
+func (*MsgResumeChainlet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{28}
+}
+func (m *MsgResumeChainlet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeChainlet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeChainlet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n3
-		i = encodeVarintTx(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.HeightDelta != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.HeightDelta))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.StackVersion) > 0 {
-		i -= len(m.StackVersion)
-		copy(dAtA[i:], m.StackVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StackVersion)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgResumeChainlet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeChainlet.Merge(m, src)
+}
+func (m *MsgResumeChainlet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeChainlet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeChainlet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeChainlet proto.InternalMessageInfo
+
+func (m *MsgResumeChainlet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpgradeChainletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResumeChainlet) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpgradeChainletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgResumeChainletResponse struct {
 }
 
-func (m *MsgUpgradeChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgResumeChainletResponse) Reset()         { *m = MsgResumeChainletResponse{} }
+func (m *MsgResumeChainletResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeChainletResponse) ProtoMessage()    {}
+func (*MsgResumeChainletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e7ff960f25a570e, []int{29}
+}
+func (m *MsgResumeChainletResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeChainletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeChainletResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResumeChainletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeChainletResponse.Merge(m, src)
+}
+func (m *MsgResumeChainletResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeChainletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeChainletResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelChainletUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgResumeChainletResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateChainletStack)(nil), "ssc.chainlet.MsgCreateChainletStack")
+	proto.RegisterType((*MsgCreateChainletStackResponse)(nil), "ssc.chainlet.MsgCreateChainletStackResponse")
+	proto.RegisterType((*MsgLaunchChainlet)(nil), "ssc.chainlet.MsgLaunchChainlet")
+	proto.RegisterType((*MsgLaunchChainletResponse)(nil), "ssc.chainlet.MsgLaunchChainletResponse")
+	proto.RegisterType((*MsgUpdateChainletStack)(nil), "ssc.chainlet.MsgUpdateChainletStack")
+	proto.RegisterType((*MsgUpdateChainletStackResponse)(nil), "ssc.chainlet.MsgUpdateChainletStackResponse")
+	proto.RegisterType((*MsgDisableChainletStackVersion)(nil), "ssc.chainlet.MsgDisableChainletStackVersion")
+	proto.RegisterType((*MsgDisableChainletStackVersionResponse)(nil), "ssc.chainlet.MsgDisableChainletStackVersionResponse")
+	proto.RegisterType((*MsgUpgradeChainlet)(nil), "ssc.chainlet.MsgUpgradeChainlet")
+	proto.RegisterType((*MsgUpgradeChainletResponse)(nil), "ssc.chainlet.MsgUpgradeChainletResponse")
+	proto.RegisterType((*MsgCancelChainletUpgrade)(nil), "ssc.chainlet.MsgCancelChainletUpgrade")
+	proto.RegisterType((*MsgCancelChainletUpgradeResponse)(nil), "ssc.chainlet.MsgCancelChainletUpgradeResponse")
+	proto.RegisterType((*MsgUpdateChainletStackFees)(nil), "ssc.chainlet.MsgUpdateChainletStackFees")
+	proto.RegisterType((*MsgUpdateChainletStackFeesResponse)(nil), "ssc.chainlet.MsgUpdateChainletStackFeesResponse")
+	proto.RegisterType((*MsgUpdateChainletParams)(nil), "ssc.chainlet.MsgUpdateChainletParams")
+	proto.RegisterType((*MsgUpdateChainletParamsResponse)(nil), "ssc.chainlet.MsgUpdateChainletParamsResponse")
+	proto.RegisterType((*MsgAddChainletMaintainer)(nil), "ssc.chainlet.MsgAddChainletMaintainer")
+	proto.RegisterType((*MsgAddChainletMaintainerResponse)(nil), "ssc.chainlet.MsgAddChainletMaintainerResponse")
+	proto.RegisterType((*MsgRemoveChainletMaintainer)(nil), "ssc.chainlet.MsgRemoveChainletMaintainer")
+	proto.RegisterType((*MsgRemoveChainletMaintainerResponse)(nil), "ssc.chainlet.MsgRemoveChainletMaintainerResponse")
+	proto.RegisterType((*MsgTransferChainletOwnership)(nil), "ssc.chainlet.MsgTransferChainletOwnership")
+	proto.RegisterType((*MsgTransferChainletOwnershipResponse)(nil), "ssc.chainlet.MsgTransferChainletOwnershipResponse")
+	proto.RegisterType((*MsgAcceptChainletOwnership)(nil), "ssc.chainlet.MsgAcceptChainletOwnership")
+	proto.RegisterType((*MsgAcceptChainletOwnershipResponse)(nil), "ssc.chainlet.MsgAcceptChainletOwnershipResponse")
+	proto.RegisterType((*MsgDecommissionChainlet)(nil), "ssc.chainlet.MsgDecommissionChainlet")
+	proto.RegisterType((*MsgDecommissionChainletResponse)(nil), "ssc.chainlet.MsgDecommissionChainletResponse")
+	proto.RegisterType((*MsgSuspendChainlet)(nil), "ssc.chainlet.MsgSuspendChainlet")
+	proto.RegisterType((*MsgSuspendChainletResponse)(nil), "ssc.chainlet.MsgSuspendChainletResponse")
+	proto.RegisterType((*MsgResumeChainlet)(nil), "ssc.chainlet.MsgResumeChainlet")
+	proto.RegisterType((*MsgResumeChainletResponse)(nil), "ssc.chainlet.MsgResumeChainletResponse")
 }
 
-func (m *MsgCancelChainletUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("ssc/chainlet/tx.proto", fileDescriptor_7e7ff960f25a570e) }
+
+var fileDescriptor_7e7ff960f25a570e = []byte{
+	// 1314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x13, 0x37, 0x2d, 0x59, 0xb1, 0x47, 0xfa, 0x27, 0x08, 0xe3, 0x24, 0x0c, 0xed, 0xbf, 0xa4, 0xa8,
+	0x8e, 0xad, 0x1a, 0x8e, 0x94, 0xa8, 0xb9, 0x34, 0x37, 0x3f, 0x50, 0x20, 0x40, 0xd5, 0x06, 0x72,
+	0x9d, 0x43, 0x8a, 0xb6, 0x58, 0x93, 0x6b, 0x8a, 0xb0, 0xf8, 0x28, 0x97, 0x74, 0xec, 0xb6, 0x40,
+	0x1f, 0xe8, 0xa5, 0xb7, 0x1e, 0x7a, 0xe8, 0x47, 0xe8, 0x25, 0x40, 0x6e, 0xfd, 0x0a, 0x3e, 0xfa,
+	0xd8, 0x53, 0x5a, 0xd8, 0x87, 0x7c, 0x8c, 0x14, 0x5c, 0x92, 0x6b, 0x3e, 0x96, 0x32, 0xed, 0x18,
+	0xe8, 0xc9, 0xdc, 0xd9, 0xdf, 0xce, 0xcc, 0x6f, 0x1e, 0x3b, 0x6b, 0xc1, 0x4d, 0x42, 0x94, 0xae,
+	0x32, 0x44, 0xba, 0x39, 0xc2, 0x6e, 0xd7, 0xdd, 0xef, 0xd8, 0x8e, 0xe5, 0x5a, 0x62, 0x8d, 0x10,
+	0xa5, 0x13, 0x89, 0xe5, 0x59, 0xcd, 0xd2, 0x2c, 0xba, 0xd1, 0xf5, 0xbf, 0x02, 0x8c, 0x5c, 0xd7,
+	0x2c, 0x4b, 0x1b, 0xe1, 0x2e, 0x5d, 0x6d, 0x7b, 0x3b, 0x5d, 0xd5, 0x73, 0x90, 0xab, 0x5b, 0x66,
+	0xb8, 0x7f, 0x5b, 0xb1, 0x88, 0x61, 0x91, 0xae, 0x41, 0xb4, 0xee, 0xde, 0x43, 0xff, 0x4f, 0xb8,
+	0xd1, 0x4a, 0xd8, 0x8c, 0x3e, 0xbe, 0xb2, 0x91, 0x83, 0x0c, 0x12, 0x62, 0xee, 0xf2, 0x31, 0xc4,
+	0x45, 0xca, 0x6e, 0x00, 0x69, 0xbd, 0x9c, 0x84, 0x5b, 0x7d, 0xa2, 0xad, 0x3b, 0x18, 0xb9, 0x78,
+	0x3d, 0x44, 0x6c, 0xfa, 0x00, 0x51, 0x82, 0x2b, 0x8a, 0x2f, 0xb6, 0x1c, 0x49, 0x68, 0x0a, 0xed,
+	0x99, 0x41, 0xb4, 0x14, 0x9b, 0x50, 0x55, 0x75, 0x62, 0x8f, 0xd0, 0xc1, 0x27, 0xc8, 0xc0, 0xd2,
+	0x24, 0xdd, 0x8d, 0x8b, 0x28, 0x02, 0x13, 0xc5, 0xd1, 0x6d, 0x9f, 0x8b, 0x54, 0x0a, 0x11, 0xa7,
+	0x22, 0x71, 0x16, 0xa6, 0x74, 0x03, 0x69, 0x58, 0x2a, 0xd3, 0xbd, 0x60, 0xe1, 0xdb, 0xdc, 0xc3,
+	0x0e, 0xf1, 0xcf, 0x4c, 0x05, 0x36, 0xc3, 0xa5, 0x28, 0xc3, 0xb4, 0x32, 0xc4, 0xca, 0x2e, 0xf1,
+	0x0c, 0xa9, 0x42, 0xb7, 0xd8, 0x5a, 0xfc, 0x10, 0xca, 0x3b, 0x18, 0x13, 0xe9, 0x4a, 0x53, 0x68,
+	0x57, 0x7b, 0x8d, 0x4e, 0x3c, 0xee, 0x9d, 0x04, 0xa9, 0x8f, 0x30, 0x26, 0x6b, 0xe5, 0xc3, 0xd7,
+	0x8d, 0x89, 0x01, 0x3d, 0xe2, 0x3b, 0xaa, 0x28, 0x7b, 0xeb, 0x96, 0x49, 0x3c, 0x03, 0x3b, 0xd2,
+	0x74, 0x53, 0x68, 0x4f, 0x0f, 0xe2, 0xa2, 0xc7, 0xb5, 0x9f, 0xde, 0xbc, 0x5a, 0x8e, 0xa8, 0xb7,
+	0x9a, 0x50, 0xe7, 0x87, 0x6b, 0x80, 0x89, 0x6d, 0x99, 0x04, 0xb7, 0xde, 0x96, 0xe0, 0x7a, 0x9f,
+	0x68, 0x1f, 0x23, 0xcf, 0x54, 0x86, 0x11, 0x64, 0x4c, 0x30, 0x5b, 0x50, 0x8b, 0x7c, 0x8d, 0x45,
+	0x33, 0x21, 0xa3, 0xa7, 0xfd, 0xf5, 0x13, 0x35, 0x0c, 0x65, 0xb4, 0x14, 0x57, 0xe0, 0xba, 0x12,
+	0x77, 0x83, 0xaa, 0x08, 0x42, 0x9a, 0xdd, 0x10, 0x7b, 0x30, 0x9b, 0x10, 0x3e, 0x4b, 0xc4, 0x9a,
+	0xbb, 0xe7, 0x47, 0xc8, 0x40, 0xba, 0xe9, 0x22, 0xdd, 0xc4, 0x0e, 0x91, 0x2a, 0xcd, 0x92, 0x9f,
+	0xca, 0x98, 0xc8, 0x4f, 0xa5, 0x8a, 0x4d, 0xcb, 0xa0, 0xf1, 0x9f, 0x19, 0x04, 0x0b, 0xf1, 0x31,
+	0x54, 0x82, 0x62, 0xa4, 0x41, 0xad, 0xf6, 0xe6, 0xf9, 0x69, 0x79, 0x4a, 0x31, 0x61, 0x4e, 0xc2,
+	0x13, 0xe2, 0x06, 0xfc, 0x5f, 0xd5, 0x09, 0xda, 0x1e, 0xe1, 0x55, 0xcf, 0xb5, 0x0c, 0xe4, 0xea,
+	0x0a, 0xf5, 0x69, 0xcb, 0xd6, 0x1c, 0xa4, 0x62, 0x22, 0xcd, 0xd0, 0x3c, 0x8d, 0x07, 0xf9, 0xb1,
+	0xd1, 0xc9, 0x26, 0x76, 0xf6, 0x74, 0x85, 0xe5, 0x4a, 0x02, 0x7a, 0x32, 0xbb, 0x21, 0x8a, 0x50,
+	0x76, 0x91, 0x46, 0xa4, 0x2a, 0x25, 0x48, 0xbf, 0xc5, 0x45, 0xb8, 0xaa, 0x78, 0xc4, 0xb5, 0x8c,
+	0x20, 0x9b, 0xd8, 0x91, 0x6a, 0x94, 0x62, 0x4a, 0x9a, 0xaa, 0x91, 0x39, 0xb8, 0x93, 0x29, 0x00,
+	0x56, 0x1e, 0x47, 0x02, 0x6d, 0xb8, 0x2d, 0x5b, 0xbd, 0xd4, 0x86, 0x63, 0xed, 0x54, 0xca, 0x69,
+	0xa7, 0x72, 0x7e, 0x3b, 0x4d, 0xa5, 0xda, 0x29, 0xd5, 0x13, 0x95, 0x62, 0x3d, 0xc1, 0x61, 0xc4,
+	0x48, 0xff, 0x2c, 0x50, 0xc8, 0x46, 0x90, 0xae, 0x75, 0x5e, 0x99, 0xbd, 0x0b, 0xf9, 0x18, 0xcd,
+	0x52, 0x82, 0x66, 0xca, 0xd1, 0x36, 0x2c, 0x8e, 0xf7, 0x82, 0x39, 0xfc, 0xcb, 0x24, 0x88, 0x94,
+	0x13, 0x2d, 0xa5, 0x02, 0x5d, 0x1c, 0xeb, 0xd0, 0xc9, 0x64, 0x87, 0xb6, 0xa0, 0x46, 0xe2, 0xbd,
+	0x16, 0x78, 0x98, 0x90, 0xf9, 0x14, 0x87, 0x58, 0xd7, 0x86, 0xee, 0x06, 0x1e, 0xb9, 0x88, 0xe6,
+	0xaa, 0x3c, 0x88, 0x8b, 0xc4, 0x79, 0x98, 0x51, 0x86, 0xc8, 0x34, 0xf1, 0xe8, 0x89, 0x1a, 0x26,
+	0xec, 0x54, 0x20, 0xf6, 0xe1, 0x9a, 0x67, 0x6e, 0x5b, 0xa6, 0xaa, 0x9b, 0xda, 0x53, 0xec, 0xe8,
+	0x96, 0x4a, 0xb3, 0x56, 0xed, 0xdd, 0xe9, 0x04, 0xf3, 0xa5, 0x13, 0xcd, 0x97, 0xce, 0x46, 0x38,
+	0x5f, 0xd6, 0xa6, 0x0f, 0x5f, 0x37, 0x84, 0xdf, 0xff, 0x6e, 0x08, 0x83, 0xf4, 0xd9, 0x54, 0xd4,
+	0x1e, 0x81, 0x9c, 0x0d, 0x45, 0x14, 0x29, 0xf1, 0x16, 0x54, 0x02, 0x3f, 0x69, 0x44, 0xca, 0x83,
+	0x70, 0xd5, 0xfa, 0x4d, 0x00, 0xc9, 0xbf, 0x29, 0x91, 0xa9, 0xe0, 0x51, 0x74, 0x2a, 0x54, 0x72,
+	0xa1, 0x38, 0xe6, 0x26, 0x39, 0x19, 0x9b, 0x72, 0x2a, 0x36, 0x29, 0x32, 0x2d, 0x68, 0xe6, 0x79,
+	0xc5, 0x92, 0xff, 0x52, 0x00, 0x99, 0x5f, 0xd0, 0xfe, 0xf8, 0x18, 0xe3, 0x3c, 0xf7, 0x32, 0x9e,
+	0xcc, 0xbb, 0x8c, 0xa3, 0xa9, 0x55, 0x6a, 0x96, 0xce, 0x39, 0xb5, 0x52, 0x9c, 0x16, 0xa0, 0x95,
+	0xef, 0x2e, 0x63, 0xf5, 0xa7, 0x00, 0xb7, 0x33, 0xb0, 0xe0, 0xf6, 0xbd, 0x50, 0x3e, 0x4e, 0xef,
+	0xf7, 0xd2, 0xb9, 0xef, 0xf7, 0x3a, 0x80, 0x47, 0xfd, 0xe8, 0x23, 0xb2, 0x2b, 0x95, 0xe9, 0x8d,
+	0x1b, 0x93, 0xa4, 0xf8, 0xdd, 0x85, 0x46, 0x8e, 0xe3, 0x8c, 0xdc, 0x77, 0xb4, 0xd8, 0x56, 0x55,
+	0x35, 0xda, 0xef, 0xb3, 0xf9, 0x74, 0x21, 0x72, 0x75, 0x80, 0xd3, 0x09, 0x17, 0xd6, 0x5b, 0x4c,
+	0xc2, 0x2d, 0x2a, 0xae, 0x75, 0xe6, 0xe1, 0xf7, 0x30, 0xd7, 0x27, 0xda, 0x00, 0x1b, 0xd6, 0x1e,
+	0xfe, 0x4f, 0x9c, 0xbc, 0x07, 0xef, 0x8d, 0x71, 0x80, 0xf9, 0xf9, 0x87, 0x00, 0xf3, 0x7d, 0xa2,
+	0x7d, 0xe6, 0x20, 0x93, 0xec, 0x60, 0x27, 0x42, 0x7e, 0xfa, 0xc2, 0x1f, 0xf5, 0x43, 0xdd, 0xbe,
+	0x90, 0xa7, 0x4d, 0xa8, 0x9a, 0xf8, 0x05, 0x1b, 0xa2, 0xe1, 0x73, 0x30, 0x26, 0x12, 0x17, 0xe0,
+	0x7f, 0x0e, 0xfe, 0xda, 0xd3, 0x1d, 0xbc, 0xaa, 0x28, 0xd8, 0x76, 0x69, 0x1f, 0x4f, 0x0f, 0x92,
+	0xc2, 0x14, 0xa3, 0x45, 0x58, 0x18, 0xe7, 0x29, 0xa3, 0xf4, 0x25, 0x6d, 0xe7, 0x40, 0xc5, 0xa5,
+	0xf0, 0xe1, 0xf6, 0x5f, 0x8e, 0x7e, 0xe6, 0xc5, 0xe7, 0xb4, 0xfd, 0x36, 0xb0, 0x62, 0x19, 0x86,
+	0x4e, 0xfc, 0x8b, 0xeb, 0x5d, 0xc6, 0x0a, 0xb7, 0x45, 0x78, 0xca, 0x99, 0xfd, 0x67, 0x74, 0xa2,
+	0x6d, 0x7a, 0xc4, 0xc6, 0xa6, 0x7a, 0x89, 0xa6, 0xe7, 0x41, 0xce, 0xea, 0x65, 0x56, 0xb7, 0xe8,
+	0x63, 0x78, 0x80, 0xfd, 0x77, 0xc3, 0x25, 0x1a, 0x0d, 0x9e, 0x58, 0x49, 0xb5, 0x91, 0xcd, 0xde,
+	0xdb, 0x1a, 0x94, 0xfa, 0x44, 0x13, 0x75, 0xb8, 0xc1, 0xfb, 0xbf, 0x66, 0x21, 0x79, 0x51, 0xf1,
+	0x9f, 0xf3, 0xf2, 0x4a, 0x11, 0x14, 0x9b, 0x82, 0xcf, 0xe1, 0x6a, 0xea, 0xc1, 0xdf, 0xc8, 0x9c,
+	0x4f, 0x02, 0xe4, 0xa5, 0x33, 0x00, 0x4c, 0xb7, 0x0e, 0x37, 0x78, 0xaf, 0xc5, 0x2c, 0x0d, 0x0e,
+	0x4a, 0x5e, 0x29, 0x82, 0x62, 0xa6, 0x7e, 0x14, 0x60, 0x6e, 0xdc, 0x23, 0x2d, 0xab, 0x6d, 0x0c,
+	0x5a, 0x7e, 0x74, 0x1e, 0x34, 0xf3, 0xc1, 0x83, 0xdb, 0x79, 0x93, 0xb7, 0x5d, 0x84, 0x8c, 0x8f,
+	0x94, 0x1f, 0x14, 0x45, 0x32, 0xb3, 0x5f, 0xc0, 0xb5, 0xf4, 0x6b, 0xaf, 0xc9, 0x51, 0x92, 0x40,
+	0xc8, 0xed, 0xb3, 0x10, 0x4c, 0xbd, 0x05, 0x37, 0xf9, 0x4f, 0xa1, 0xc5, 0x6c, 0x9d, 0xf1, 0x70,
+	0x72, 0xa7, 0x18, 0x8e, 0x19, 0x1c, 0xc1, 0x2c, 0x77, 0xd4, 0xdf, 0x3b, 0x23, 0x32, 0x01, 0x4c,
+	0xbe, 0x5f, 0x08, 0x16, 0xa7, 0xc7, 0x1f, 0xbe, 0x59, 0x7a, 0x5c, 0x9c, 0xdc, 0x29, 0x86, 0x63,
+	0x06, 0xf7, 0x41, 0xca, 0x9d, 0xa5, 0xef, 0x67, 0x74, 0xe5, 0x41, 0xe5, 0x87, 0x85, 0xa1, 0xcc,
+	0xf2, 0xb7, 0x70, 0x27, 0x7f, 0x38, 0x2e, 0x67, 0xf4, 0xe5, 0x62, 0xe5, 0x5e, 0x71, 0x6c, 0xbc,
+	0x39, 0xf2, 0xe6, 0x58, 0xb6, 0x16, 0x73, 0x90, 0xf2, 0x83, 0xa2, 0xc8, 0x78, 0x31, 0x71, 0x07,
+	0x57, 0xb6, 0x98, 0x78, 0x30, 0xf9, 0x7e, 0x21, 0x58, 0xbc, 0x15, 0xd3, 0x63, 0x2a, 0xdb, 0x8a,
+	0x29, 0x84, 0xdc, 0x3e, 0x0b, 0x11, 0xbf, 0xab, 0x53, 0xf3, 0xa8, 0xc1, 0xa9, 0x82, 0x38, 0x40,
+	0x5e, 0x3a, 0x03, 0x10, 0xe9, 0x96, 0xa7, 0x7e, 0x78, 0xf3, 0x6a, 0x59, 0x58, 0x5b, 0x3d, 0x3c,
+	0xae, 0x0b, 0x47, 0xc7, 0x75, 0xe1, 0x9f, 0xe3, 0xba, 0xf0, 0xeb, 0x49, 0x7d, 0xe2, 0xe8, 0xa4,
+	0x3e, 0xf1, 0xd7, 0x49, 0x7d, 0xe2, 0xf9, 0x92, 0xa6, 0xbb, 0x43, 0x6f, 0xbb, 0xa3, 0x58, 0x46,
+	0x97, 0x20, 0x0d, 0xed, 0x1f, 0x7c, 0xd3, 0xf5, 0x7f, 0xa5, 0xdb, 0x8f, 0xfd, 0x7e, 0x78, 0x60,
+	0x63, 0xb2, 0x5d, 0xa1, 0xff, 0xb1, 0x7d, 0xf0, 0xef, 0x00, 0x91, 0x7d, 0xde, 0x23, 0x5c, 0x14,
+	0x00, 0x00,
 }
 
-func (m *MsgCancelChainletUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateChainletStack(ctx context.Context, in *MsgCreateChainletStack, opts ...grpc.CallOption) (*MsgCreateChainletStackResponse, error)
+	LaunchChainlet(ctx context.Context, in *MsgLaunchChainlet, opts ...grpc.CallOption) (*MsgLaunchChainletResponse, error)
+	UpdateChainletStack(ctx context.Context, in *MsgUpdateChainletStack, opts ...grpc.CallOption) (*MsgUpdateChainletStackResponse, error)
+	DisableChainletStackVersion(ctx context.Context, in *MsgDisableChainletStackVersion, opts ...grpc.CallOption) (*MsgDisableChainletStackVersionResponse, error)
+	UpdateChainletStackFees(ctx context.Context, in *MsgUpdateChainletStackFees, opts ...grpc.CallOption) (*MsgUpdateChainletStackFeesResponse, error)
+	UpgradeChainlet(ctx context.Context, in *MsgUpgradeChainlet, opts ...grpc.CallOption) (*MsgUpgradeChainletResponse, error)
+	CancelChainletUpgrade(ctx context.Context, in *MsgCancelChainletUpgrade, opts ...grpc.CallOption) (*MsgCancelChainletUpgradeResponse, error)
+	UpdateChainletParams(ctx context.Context, in *MsgUpdateChainletParams, opts ...grpc.CallOption) (*MsgUpdateChainletParamsResponse, error)
+	AddChainletMaintainer(ctx context.Context, in *MsgAddChainletMaintainer, opts ...grpc.CallOption) (*MsgAddChainletMaintainerResponse, error)
+	RemoveChainletMaintainer(ctx context.Context, in *MsgRemoveChainletMaintainer, opts ...grpc.CallOption) (*MsgRemoveChainletMaintainerResponse, error)
+	TransferChainletOwnership(ctx context.Context, in *MsgTransferChainletOwnership, opts ...grpc.CallOption) (*MsgTransferChainletOwnershipResponse, error)
+	AcceptChainletOwnership(ctx context.Context, in *MsgAcceptChainletOwnership, opts ...grpc.CallOption) (*MsgAcceptChainletOwnershipResponse, error)
+	DecommissionChainlet(ctx context.Context, in *MsgDecommissionChainlet, opts ...grpc.CallOption) (*MsgDecommissionChainletResponse, error)
+	SuspendChainlet(ctx context.Context, in *MsgSuspendChainlet, opts ...grpc.CallOption) (*MsgSuspendChainletResponse, error)
+	ResumeChainlet(ctx context.Context, in *MsgResumeChainlet, opts ...grpc.CallOption) (*MsgResumeChainletResponse, error)
 }
 
-func (m *MsgCancelChainletUpgradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateChainletStack(ctx context.Context, in *MsgCreateChainletStack, opts ...grpc.CallOption) (*MsgCreateChainletStackResponse, error) {
+	out := new(MsgCreateChainletStackResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/CreateChainletStack", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelChainletUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LaunchChainlet(ctx context.Context, in *MsgLaunchChainlet, opts ...grpc.CallOption) (*MsgLaunchChainletResponse, error) {
+	out := new(MsgLaunchChainletResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/LaunchChainlet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelChainletUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateChainletStack(ctx context.Context, in *MsgUpdateChainletStack, opts ...grpc.CallOption) (*MsgUpdateChainletStackResponse, error) {
+	out := new(MsgUpdateChainletStackResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpdateChainletStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateChainletStackFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DisableChainletStackVersion(ctx context.Context, in *MsgDisableChainletStackVersion, opts ...grpc.CallOption) (*MsgDisableChainletStackVersionResponse, error) {
+	out := new(MsgDisableChainletStackVersionResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/DisableChainletStackVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateChainletStackFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateChainletStackFees(ctx context.Context, in *MsgUpdateChainletStackFees, opts ...grpc.CallOption) (*MsgUpdateChainletStackFeesResponse, error) {
+	out := new(MsgUpdateChainletStackFeesResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpdateChainletStackFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateChainletStackFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.ChainletStackName) > 0 {
-		i -= len(m.ChainletStackName)
-		copy(dAtA[i:], m.ChainletStackName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletStackName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpgradeChainlet(ctx context.Context, in *MsgUpgradeChainlet, opts ...grpc.CallOption) (*MsgUpgradeChainletResponse, error) {
+	out := new(MsgUpgradeChainletResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpgradeChainlet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateChainletStackFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelChainletUpgrade(ctx context.Context, in *MsgCancelChainletUpgrade, opts ...grpc.CallOption) (*MsgCancelChainletUpgradeResponse, error) {
+	out := new(MsgCancelChainletUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/CancelChainletUpgrade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateChainletStackFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateChainletParams(ctx context.Context, in *MsgUpdateChainletParams, opts ...grpc.CallOption) (*MsgUpdateChainletParamsResponse, error) {
+	out := new(MsgUpdateChainletParamsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/UpdateChainletParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateChainletStackFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) AddChainletMaintainer(ctx context.Context, in *MsgAddChainletMaintainer, opts ...grpc.CallOption) (*MsgAddChainletMaintainerResponse, error) {
+	out := new(MsgAddChainletMaintainerResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/AddChainletMaintainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) RemoveChainletMaintainer(ctx context.Context, in *MsgRemoveChainletMaintainer, opts ...grpc.CallOption) (*MsgRemoveChainletMaintainerResponse, error) {
+	out := new(MsgRemoveChainletMaintainerResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/RemoveChainletMaintainer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateChainletStack) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DisplayName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) TransferChainletOwnership(ctx context.Context, in *MsgTransferChainletOwnership, opts ...grpc.CallOption) (*MsgTransferChainletOwnershipResponse, error) {
+	out := new(MsgTransferChainletOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/TransferChainletOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Fees.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.CcvConsumer {
-		n += 2
+	return out, nil
+}
+
+func (c *msgClient) AcceptChainletOwnership(ctx context.Context, in *MsgAcceptChainletOwnership, opts ...grpc.CallOption) (*MsgAcceptChainletOwnershipResponse, error) {
+	out := new(MsgAcceptChainletOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/AcceptChainletOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateChainletStackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DecommissionChainlet(ctx context.Context, in *MsgDecommissionChainlet, opts ...grpc.CallOption) (*MsgDecommissionChainletResponse, error) {
+	out := new(MsgDecommissionChainletResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/DecommissionChainlet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgLaunchChainlet) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SuspendChainlet(ctx context.Context, in *MsgSuspendChainlet, opts ...grpc.CallOption) (*MsgSuspendChainletResponse, error) {
+	out := new(MsgSuspendChainletResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/SuspendChainlet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ResumeChainlet(ctx context.Context, in *MsgResumeChainlet, opts ...grpc.CallOption) (*MsgResumeChainletResponse, error) {
+	out := new(MsgResumeChainletResponse)
+	err := c.cc.Invoke(ctx, "/ssc.chainlet.Msg/ResumeChainlet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainletName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainletStackName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateChainletStack(context.Context, *MsgCreateChainletStack) (*MsgCreateChainletStackResponse, error)
+	LaunchChainlet(context.Context, *MsgLaunchChainlet) (*MsgLaunchChainletResponse, error)
+	UpdateChainletStack(context.Context, *MsgUpdateChainletStack) (*MsgUpdateChainletStackResponse, error)
+	DisableChainletStackVersion(context.Context, *MsgDisableChainletStackVersion) (*MsgDisableChainletStackVersionResponse, error)
+	UpdateChainletStackFees(context.Context, *MsgUpdateChainletStackFees) (*MsgUpdateChainletStackFeesResponse, error)
+	UpgradeChainlet(context.Context, *MsgUpgradeChainlet) (*MsgUpgradeChainletResponse, error)
+	CancelChainletUpgrade(context.Context, *MsgCancelChainletUpgrade) (*MsgCancelChainletUpgradeResponse, error)
+	UpdateChainletParams(context.Context, *MsgUpdateChainletParams) (*MsgUpdateChainletParamsResponse, error)
+	AddChainletMaintainer(context.Context, *MsgAddChainletMaintainer) (*MsgAddChainletMaintainerResponse, error)
+	RemoveChainletMaintainer(context.Context, *MsgRemoveChainletMaintainer) (*MsgRemoveChainletMaintainerResponse, error)
+	TransferChainletOwnership(context.Context, *MsgTransferChainletOwnership) (*MsgTransferChainletOwnershipResponse, error)
+	AcceptChainletOwnership(context.Context, *MsgAcceptChainletOwnership) (*MsgAcceptChainletOwnershipResponse, error)
+	DecommissionChainlet(context.Context, *MsgDecommissionChainlet) (*MsgDecommissionChainletResponse, error)
+	SuspendChainlet(context.Context, *MsgSuspendChainlet) (*MsgSuspendChainletResponse, error)
+	ResumeChainlet(context.Context, *MsgResumeChainlet) (*MsgResumeChainletResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateChainletStack(ctx context.Context, req *MsgCreateChainletStack) (*MsgCreateChainletStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChainletStack not implemented")
+}
+func (*UnimplementedMsgServer) LaunchChainlet(ctx context.Context, req *MsgLaunchChainlet) (*MsgLaunchChainletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaunchChainlet not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChainletStack(ctx context.Context, req *MsgUpdateChainletStack) (*MsgUpdateChainletStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainletStack not implemented")
+}
+func (*UnimplementedMsgServer) DisableChainletStackVersion(ctx context.Context, req *MsgDisableChainletStackVersion) (*MsgDisableChainletStackVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableChainletStackVersion not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChainletStackFees(ctx context.Context, req *MsgUpdateChainletStackFees) (*MsgUpdateChainletStackFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainletStackFees not implemented")
+}
+func (*UnimplementedMsgServer) UpgradeChainlet(ctx context.Context, req *MsgUpgradeChainlet) (*MsgUpgradeChainletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeChainlet not implemented")
+}
+func (*UnimplementedMsgServer) CancelChainletUpgrade(ctx context.Context, req *MsgCancelChainletUpgrade) (*MsgCancelChainletUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelChainletUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChainletParams(ctx context.Context, req *MsgUpdateChainletParams) (*MsgUpdateChainletParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainletParams not implemented")
+}
+func (*UnimplementedMsgServer) AddChainletMaintainer(ctx context.Context, req *MsgAddChainletMaintainer) (*MsgAddChainletMaintainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChainletMaintainer not implemented")
+}
+func (*UnimplementedMsgServer) RemoveChainletMaintainer(ctx context.Context, req *MsgRemoveChainletMaintainer) (*MsgRemoveChainletMaintainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChainletMaintainer not implemented")
+}
+func (*UnimplementedMsgServer) TransferChainletOwnership(ctx context.Context, req *MsgTransferChainletOwnership) (*MsgTransferChainletOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferChainletOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptChainletOwnership(ctx context.Context, req *MsgAcceptChainletOwnership) (*MsgAcceptChainletOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptChainletOwnership not implemented")
+}
+func (*UnimplementedMsgServer) DecommissionChainlet(ctx context.Context, req *MsgDecommissionChainlet) (*MsgDecommissionChainletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionChainlet not implemented")
+}
+func (*UnimplementedMsgServer) SuspendChainlet(ctx context.Context, req *MsgSuspendChainlet) (*MsgSuspendChainletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendChainlet not implemented")
+}
+func (*UnimplementedMsgServer) ResumeChainlet(ctx context.Context, req *MsgResumeChainlet) (*MsgResumeChainletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeChainlet not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateChainletStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateChainletStack)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ChainletStackVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateChainletStack(ctx, in)
 	}
-	if len(m.Maintainers) > 0 {
-		for _, s := range m.Maintainers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/CreateChainletStack",
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateChainletStack(ctx, req.(*MsgCreateChainletStack))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DisableAutomaticStackUpgrades {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LaunchChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLaunchChainlet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IsServiceChainlet {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).LaunchChainlet(ctx, in)
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/LaunchChainlet",
 	}
-	l = len(m.CustomLauncher)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LaunchChainlet(ctx, req.(*MsgLaunchChainlet))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLaunchChainletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateChainletStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainletStack)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainletStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/UpdateChainletStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainletStack(ctx, req.(*MsgUpdateChainletStack))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateChainletStack) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DisableChainletStackVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableChainletStackVersion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DisableChainletStackVersion(ctx, in)
 	}
-	l = len(m.DisplayName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/DisableChainletStackVersion",
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableChainletStackVersion(ctx, req.(*MsgDisableChainletStackVersion))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateChainletStackFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainletStackFees)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainletStackFees(ctx, in)
 	}
-	if m.CcvConsumer {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/UpdateChainletStackFees",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainletStackFees(ctx, req.(*MsgUpdateChainletStackFees))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateChainletStackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpgradeChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpgradeChainlet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpgradeChainlet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/UpgradeChainlet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpgradeChainlet(ctx, req.(*MsgUpgradeChainlet))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDisableChainletStackVersion) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelChainletUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelChainletUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelChainletUpgrade(ctx, in)
 	}
-	l = len(m.DisplayName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/CancelChainletUpgrade",
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelChainletUpgrade(ctx, req.(*MsgCancelChainletUpgrade))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDisableChainletStackVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateChainletParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainletParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainletParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/UpdateChainletParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainletParams(ctx, req.(*MsgUpdateChainletParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpgradeChainlet) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddChainletMaintainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddChainletMaintainer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddChainletMaintainer(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/AddChainletMaintainer",
 	}
-	l = len(m.StackVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddChainletMaintainer(ctx, req.(*MsgAddChainletMaintainer))
 	}
-	if m.HeightDelta != 0 {
-		n += 1 + sovTx(uint64(m.HeightDelta))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveChainletMaintainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChainletMaintainer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChainletMaintainer(ctx, in)
 	}
-	if m.UnbondingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UnbondingPeriod)
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/RemoveChainletMaintainer",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChainletMaintainer(ctx, req.(*MsgRemoveChainletMaintainer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpgradeChainletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferChainletOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferChainletOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferChainletOwnership(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/TransferChainletOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferChainletOwnership(ctx, req.(*MsgTransferChainletOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelChainletUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptChainletOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptChainletOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptChainletOwnership(ctx, in)
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/AcceptChainletOwnership",
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptChainletOwnership(ctx, req.(*MsgAcceptChainletOwnership))
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecommissionChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecommissionChainlet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DecommissionChainlet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/DecommissionChainlet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecommissionChainlet(ctx, req.(*MsgDecommissionChainlet))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelChainletUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SuspendChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendChainlet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendChainlet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/SuspendChainlet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendChainlet(ctx, req.(*MsgSuspendChainlet))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateChainletStackFees) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResumeChainlet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeChainlet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeChainlet(ctx, in)
 	}
-	l = len(m.ChainletStackName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.chainlet.Msg/ResumeChainlet",
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeChainlet(ctx, req.(*MsgResumeChainlet))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateChainletStackFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ssc.chainlet.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChainletStack",
+			Handler:    _Msg_CreateChainletStack_Handler,
+		},
+		{
+			MethodName: "LaunchChainlet",
+			Handler:    _Msg_LaunchChainlet_Handler,
+		},
+		{
+			MethodName: "UpdateChainletStack",
+			Handler:    _Msg_UpdateChainletStack_Handler,
+		},
+		{
+			MethodName: "DisableChainletStackVersion",
+			Handler:    _Msg_DisableChainletStackVersion_Handler,
+		},
+		{
+			MethodName: "UpdateChainletStackFees",
+			Handler:    _Msg_UpdateChainletStackFees_Handler,
+		},
+		{
+			MethodName: "UpgradeChainlet",
+			Handler:    _Msg_UpgradeChainlet_Handler,
+		},
+		{
+			MethodName: "CancelChainletUpgrade",
+			Handler:    _Msg_CancelChainletUpgrade_Handler,
+		},
+		{
+			MethodName: "UpdateChainletParams",
+			Handler:    _Msg_UpdateChainletParams_Handler,
+		},
+		{
+			MethodName: "AddChainletMaintainer",
+			Handler:    _Msg_AddChainletMaintainer_Handler,
+		},
+		{
+			MethodName: "RemoveChainletMaintainer",
+			Handler:    _Msg_RemoveChainletMaintainer_Handler,
+		},
+		{
+			MethodName: "TransferChainletOwnership",
+			Handler:    _Msg_TransferChainletOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptChainletOwnership",
+			Handler:    _Msg_AcceptChainletOwnership_Handler,
+		},
+		{
+			MethodName: "DecommissionChainlet",
+			Handler:    _Msg_DecommissionChainlet_Handler,
+		},
+		{
+			MethodName: "SuspendChainlet",
+			Handler:    _Msg_SuspendChainlet_Handler,
+		},
+		{
+			MethodName: "ResumeChainlet",
+			Handler:    _Msg_ResumeChainlet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ssc/chainlet/tx.proto",
+}
+
+func (m *MsgCreateChainletStack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateChainletStack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateChainletStack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
+	if m.CcvConsumer {
+		i--
+		if m.CcvConsumer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateChainletStackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateChainletStackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateChainletStackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLaunchChainlet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLaunchChainlet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLaunchChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomLauncher) > 0 {
+		i -= len(m.CustomLauncher)
+		copy(dAtA[i:], m.CustomLauncher)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomLauncher)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.IsServiceChainlet {
+		i--
+		if m.IsServiceChainlet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DisableAutomaticStackUpgrades {
+		i--
+		if m.DisableAutomaticStackUpgrades {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Maintainers) > 0 {
+		for iNdEx := len(m.Maintainers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Maintainers[iNdEx])
+			copy(dAtA[i:], m.Maintainers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Maintainers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChainletStackVersion) > 0 {
+		i -= len(m.ChainletStackVersion)
+		copy(dAtA[i:], m.ChainletStackVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletStackVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainletStackName) > 0 {
+		i -= len(m.ChainletStackName)
+		copy(dAtA[i:], m.ChainletStackName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletStackName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainletName) > 0 {
+		i -= len(m.ChainletName)
+		copy(dAtA[i:], m.ChainletName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLaunchChainletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLaunchChainletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLaunchChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainletStack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainletStack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainletStack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CcvConsumer {
+		i--
+		if m.CcvConsumer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainletStackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainletStackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainletStackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableChainletStackVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableChainletStackVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableChainletStackVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableChainletStackVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableChainletStackVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableChainletStackVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradeChainlet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradeChainlet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradeChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingPeriod != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UnbondingPeriod):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HeightDelta != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HeightDelta))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StackVersion) > 0 {
+		i -= len(m.StackVersion)
+		copy(dAtA[i:], m.StackVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StackVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpgradeChainletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpgradeChainletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpgradeChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelChainletUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelChainletUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelChainletUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelChainletUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelChainletUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelChainletUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainletStackFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainletStackFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainletStackFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainletStackName) > 0 {
+		i -= len(m.ChainletStackName)
+		copy(dAtA[i:], m.ChainletStackName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainletStackName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainletStackFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainletStackFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainletStackFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainletParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainletParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainletParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainletParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainletParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainletParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddChainletMaintainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddChainletMaintainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddChainletMaintainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Maintainer) > 0 {
+		i -= len(m.Maintainer)
+		copy(dAtA[i:], m.Maintainer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Maintainer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddChainletMaintainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddChainletMaintainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddChainletMaintainerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChainletMaintainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChainletMaintainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChainletMaintainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Maintainer) > 0 {
+		i -= len(m.Maintainer)
+		copy(dAtA[i:], m.Maintainer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Maintainer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChainletMaintainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChainletMaintainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChainletMaintainerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferChainletOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferChainletOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferChainletOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireAccept {
+		i--
+		if m.RequireAccept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewLauncher) > 0 {
+		i -= len(m.NewLauncher)
+		copy(dAtA[i:], m.NewLauncher)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewLauncher)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferChainletOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferChainletOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferChainletOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptChainletOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptChainletOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptChainletOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptChainletOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptChainletOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptChainletOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecommissionChainlet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecommissionChainlet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecommissionChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecommissionChainletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecommissionChainletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecommissionChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendChainlet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendChainlet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendChainletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendChainletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeChainlet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeChainlet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeChainlet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeChainletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeChainletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeChainletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateChainletStack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CcvConsumer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateChainletStackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLaunchChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainletName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainletStackName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainletStackVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Maintainers) > 0 {
+		for _, s := range m.Maintainers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DisableAutomaticStackUpgrades {
+		n += 2
+	}
+	if m.IsServiceChainlet {
+		n += 2
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.CustomLauncher)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLaunchChainletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChainletStack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CcvConsumer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateChainletStackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableChainletStackVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableChainletStackVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpgradeChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StackVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HeightDelta != 0 {
+		n += 1 + sovTx(uint64(m.HeightDelta))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UnbondingPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpgradeChainletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCancelChainletUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelChainletUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChainletStackFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainletStackName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateChainletStackFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChainletParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateChainletParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddChainletMaintainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Maintainer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddChainletMaintainerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveChainletMaintainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Maintainer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveChainletMaintainerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferChainletOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewLauncher)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequireAccept {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTransferChainletOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptChainletOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptChainletOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecommissionChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDecommissionChainletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuspendChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuspendChainletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeChainlet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeChainletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateChainletStack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateChainletStack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvConsumer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CcvConsumer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateChainletStackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateChainletStackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateChainletStackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLaunchChainlet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLaunchChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainletName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainletName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainletStackName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainletStackName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainletStackVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainletStackVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutomaticStackUpgrades", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutomaticStackUpgrades = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsServiceChainlet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsServiceChainlet = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomLauncher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomLauncher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLaunchChainletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLaunchChainletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLaunchChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainletStack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainletStack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvConsumer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CcvConsumer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainletStackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainletStackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainletStackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableChainletStackVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableChainletStackVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableChainletStackVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableChainletStackVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableChainletStackVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
+func (m *MsgUpgradeChainlet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2248,10 +5377,10 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateChainletStack: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpgradeChainlet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateChainletStack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpgradeChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2288,7 +5417,7 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2316,11 +5445,11 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2348,11 +5477,30 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.StackVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightDelta", wireType)
+			}
+			m.HeightDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightDelta |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2380,11 +5528,166 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnbondingPeriod == nil {
+				m.UnbondingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpgradeChainletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpgradeChainletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpgradeChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelChainletUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelChainletUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2412,11 +5715,11 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2444,13 +5747,13 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2460,30 +5763,29 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvConsumer", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2493,12 +5795,24 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CcvConsumer = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2520,7 +5834,7 @@ func (m *MsgCreateChainletStack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateChainletStackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelChainletUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2543,10 +5857,10 @@ func (m *MsgCreateChainletStackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateChainletStackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelChainletUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateChainletStackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelChainletUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2570,7 +5884,7 @@ func (m *MsgCreateChainletStackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainletStackFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2593,10 +5907,10 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLaunchChainlet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainletStackFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLaunchChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainletStackFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2633,7 +5947,7 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainletName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainletStackName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2661,13 +5975,13 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainletName = string(dAtA[iNdEx:postIndex])
+			m.ChainletStackName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2677,91 +5991,129 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainletStackName", wireType)
+			m.Fees = append(m.Fees, ChainletStackFees{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainletStackFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainletStackName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainletStackVersion", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainletStackFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainletStackFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainletParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainletStackVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainletParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainletParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2789,11 +6141,11 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2821,9 +6173,9 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
@@ -2845,92 +6197,20 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutomaticStackUpgrades", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableAutomaticStackUpgrades = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsServiceChainlet", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsServiceChainlet = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomLauncher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2958,7 +6238,7 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomLauncher = string(dAtA[iNdEx:postIndex])
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2981,7 +6261,7 @@ func (m *MsgLaunchChainlet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLaunchChainletResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainletParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,10 +6284,10 @@ func (m *MsgLaunchChainletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLaunchChainletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainletParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLaunchChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainletParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3031,7 +6311,7 @@ func (m *MsgLaunchChainletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
+func (m *MsgAddChainletMaintainer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3054,10 +6334,10 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainletStack: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddChainletMaintainer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainletStack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddChainletMaintainer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3094,7 +6374,7 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3122,11 +6402,11 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3154,11 +6434,111 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Maintainer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddChainletMaintainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddChainletMaintainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddChainletMaintainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainletMaintainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainletMaintainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainletMaintainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3186,11 +6566,11 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3218,13 +6598,13 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvConsumer", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3234,12 +6614,24 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CcvConsumer = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maintainer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3261,7 +6653,7 @@ func (m *MsgUpdateChainletStack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainletStackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveChainletMaintainerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3284,10 +6676,10 @@ func (m *MsgUpdateChainletStackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainletStackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveChainletMaintainerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainletStackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveChainletMaintainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3311,7 +6703,7 @@ func (m *MsgUpdateChainletStackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferChainletOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3334,10 +6726,10 @@ func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableChainletStackVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferChainletOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableChainletStackVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferChainletOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3374,7 +6766,7 @@ func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3402,11 +6794,11 @@ func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLauncher", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3434,8 +6826,28 @@ func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.NewLauncher = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAccept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAccept = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3457,7 +6869,7 @@ func (m *MsgDisableChainletStackVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableChainletStackVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferChainletOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3480,10 +6892,10 @@ func (m *MsgDisableChainletStackVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableChainletStackVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferChainletOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableChainletStackVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferChainletOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3507,7 +6919,7 @@ func (m *MsgDisableChainletStackVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgradeChainlet) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptChainletOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3524,85 +6936,21 @@ func (m *MsgUpgradeChainlet) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgradeChainlet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgradeChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptChainletOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptChainletOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3630,30 +6978,11 @@ func (m *MsgUpgradeChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StackVersion = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeightDelta", wireType)
-			}
-			m.HeightDelta = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HeightDelta |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3681,43 +7010,7 @@ func (m *MsgUpgradeChainlet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UnbondingPeriod == nil {
-				m.UnbondingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3740,7 +7033,7 @@ func (m *MsgUpgradeChainlet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpgradeChainletResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptChainletOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3763,31 +7056,12 @@ func (m *MsgUpgradeChainletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpgradeChainletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptChainletOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpgradeChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptChainletOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3809,7 +7083,7 @@ func (m *MsgUpgradeChainletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgDecommissionChainlet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3832,10 +7106,10 @@ func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelChainletUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDecommissionChainlet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelChainletUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDecommissionChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3902,9 +7176,109 @@ func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecommissionChainletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecommissionChainletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecommissionChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuspendChainlet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuspendChainlet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuspendChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3932,11 +7306,11 @@ func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3964,7 +7338,7 @@ func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3987,7 +7361,7 @@ func (m *MsgCancelChainletUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelChainletUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuspendChainletResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4010,10 +7384,10 @@ func (m *MsgCancelChainletUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelChainletUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuspendChainletResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelChainletUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuspendChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4037,7 +7411,7 @@ func (m *MsgCancelChainletUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainletStackFees) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeChainlet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4060,10 +7434,10 @@ func (m *MsgUpdateChainletStackFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainletStackFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeChainlet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainletStackFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeChainlet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4100,7 +7474,7 @@ func (m *MsgUpdateChainletStackFees) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainletStackName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4128,41 +7502,7 @@ func (m *MsgUpdateChainletStackFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainletStackName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fees = append(m.Fees, ChainletStackFees{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4185,7 +7525,7 @@ func (m *MsgUpdateChainletStackFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainletStackFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeChainletResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4208,10 +7548,10 @@ func (m *MsgUpdateChainletStackFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainletStackFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeChainletResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainletStackFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeChainletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
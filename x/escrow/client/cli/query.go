@@ -11,6 +11,11 @@ import (
 	"github.com/sagaxyz/ssc/x/escrow/types"
 )
 
+const (
+	flagTopN  = "top-n"
+	flagDenom = "denom"
+)
+
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group escrow queries under a subcommand.
@@ -30,10 +35,17 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdGetPools())
 	cmd.AddCommand(CmdGetFunders())
 	cmd.AddCommand(CmdGetFunder())
+	cmd.AddCommand(CmdPoolLedger())
 
 	// reverse-index convenience
 	cmd.AddCommand(CmdGetFunderBalance())
 
+	// aggregates
+	cmd.AddCommand(CmdEscrowStats())
+
+	// launcher settings
+	cmd.AddCommand(CmdGetFunderPolicy())
+
 	// this line is used by starport scaffolding # 1
 	return cmd
 }
@@ -154,6 +166,40 @@ func CmdGetFunders() *cobra.Command {
 	return cmd
 }
 
+// ---------------------
+// Pool ledger (per {chainlet, denom})
+// ---------------------
+func CmdPoolLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-ledger [chain-id] [denom]",
+		Short: "List the balance movements of a specific {chain-id, denom} pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID, denom := args[0], args[1]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.PoolLedger(cmd.Context(), &types.QueryPoolLedgerRequest{
+				ChainId:    chainID,
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "pool-ledger")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // ---------------------
 // Single funder shares
 // ---------------------
@@ -215,3 +261,59 @@ func CmdGetFunderBalance() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// ---------------------
+// Aggregate statistics
+// ---------------------
+func CmdEscrowStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show the total value locked, active pools and funders, and the largest chainlet escrows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			topN, _ := cmd.Flags().GetUint32(flagTopN)
+			denom, _ := cmd.Flags().GetString(flagDenom)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			q := types.NewQueryClient(clientCtx)
+
+			res, err := q.EscrowStats(cmd.Context(), &types.QueryEscrowStatsRequest{
+				TopN:  topN,
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint32(flagTopN, 0, "number of chainlets to rank per denom (default 10)")
+	cmd.Flags().String(flagDenom, "", "only rank the chainlets of this denom")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ---------------------
+// Funder policy
+// ---------------------
+func CmdGetFunderPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funder-policy [chain-id]",
+		Short: "Show who may fund the escrow of a chainlet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			q := types.NewQueryClient(clientCtx)
+
+			res, err := q.GetFunderPolicy(cmd.Context(), &types.QueryFunderPolicyRequest{
+				ChainId: chainID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -27,6 +27,10 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdDeposit())
 	cmd.AddCommand(CmdWithdraw())
+	cmd.AddCommand(CmdTransferEscrowPosition())
+	cmd.AddCommand(CmdCreateTopUpSubscription())
+	cmd.AddCommand(CmdCancelTopUpSubscription())
+	cmd.AddCommand(CmdSetFunderPolicy())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateTopUpSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-top-up [chainId] [amount] [interval-epochs] [cap]",
+		Short: "Broadcast message create-top-up-subscription",
+		Long:  "Deposit amount into the chainlet escrow every interval-epochs billing epochs, until cap has been deposited",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argAmount := args[1]
+			argInterval, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argCap := args[3]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTopUpSubscription(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				argAmount,
+				argInterval,
+				argCap,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
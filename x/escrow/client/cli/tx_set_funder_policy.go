@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+	"github.com/spf13/cobra"
+)
+
+var funderModes = map[string]types.FunderMode{
+	"open":          types.FunderMode_FUNDER_MODE_OPEN,
+	"allowlist":     types.FunderMode_FUNDER_MODE_ALLOWLIST,
+	"launcher-only": types.FunderMode_FUNDER_MODE_LAUNCHER_ONLY,
+}
+
+func CmdSetFunderPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-funder-policy [chainId] [open|allowlist|launcher-only]",
+		Short: "Broadcast message set-funder-policy",
+		Long:  "Set who may fund the escrow of a chainlet, with the allowed addresses in --allowlist and an optional cap on the share of a single funder in --max-funder-share",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			mode, ok := funderModes[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid funder mode %s", args[1])
+			}
+			allowlist, _ := cmd.Flags().GetStringSlice("allowlist")
+			maxFunderShare, _ := cmd.Flags().GetString("max-funder-share")
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFunderPolicy(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				mode,
+				allowlist,
+				maxFunderShare,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice("allowlist", nil, "addresses allowed to fund the chainlet in allowlist mode")
+	cmd.Flags().String("max-funder-share", "", "maximum fraction of a pool a single funder may hold, e.g. 0.25")
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdTransferEscrowPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-position [chainId] [denom] [recipient]",
+		Short: "Broadcast message transfer-escrow-position",
+		Long:  "Transfer the escrow position of a denom to another address, in part with --shares",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argDenom := args[1]
+			argRecipient := args[2]
+			shares, _ := cmd.Flags().GetString("shares")
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferEscrowPosition(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				argDenom,
+				argRecipient,
+				shares,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String("shares", "", "number of shares to transfer, the whole position if not set")
+
+	return cmd
+}
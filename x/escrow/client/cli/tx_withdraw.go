@@ -12,9 +12,13 @@ func CmdWithdraw() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw [chainId]",
 		Short: "Broadcast message withdraw",
+		Long:  "Withdraw all positions on a chainlet, or with --denom a single denom position, in part with --amount or --fraction",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argChainId := args[0]
+			denom, _ := cmd.Flags().GetString("denom")
+			amount, _ := cmd.Flags().GetString("amount")
+			fraction, _ := cmd.Flags().GetString("fraction")
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -24,6 +28,9 @@ func CmdWithdraw() *cobra.Command {
 			msg := types.NewMsgWithdraw(
 				clientCtx.GetFromAddress().String(),
 				argChainId,
+				denom,
+				amount,
+				fraction,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -33,6 +40,9 @@ func CmdWithdraw() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String("denom", "", "only withdraw the position of this denom")
+	cmd.Flags().String("amount", "", "amount of the denom to withdraw")
+	cmd.Flags().String("fraction", "", "fraction of the denom position to withdraw, e.g. 0.5")
 
 	return cmd
 }
@@ -26,6 +26,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.ImportFunder(ctx, gf.ChainId, gf.Denom, gf.Address, gf.Funder)
 	}
 
+	// Import top-up subscriptions
+	for _, sub := range genState.TopUpSubscriptions {
+		k.ImportTopUpSubscription(ctx, sub)
+	}
+
+	// Import funder policies
+	for _, policy := range genState.FunderPolicies {
+		k.ImportFunderPolicy(ctx, policy)
+	}
+
+	// Import pool ledgers
+	for _, entry := range genState.LedgerEntries {
+		k.ImportLedgerEntry(ctx, entry)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 }
 
@@ -43,6 +58,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export funders
 	genesis.Funders = k.ExportFunders(ctx)
 
+	// Export top-up subscriptions
+	genesis.TopUpSubscriptions = k.ExportTopUpSubscriptions(ctx)
+
+	// Export funder policies
+	genesis.FunderPolicies = k.ExportFunderPolicies(ctx)
+
+	// Export pool ledgers
+	genesis.LedgerEntries = k.ExportLedgerEntries(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
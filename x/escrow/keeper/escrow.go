@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	cosmossdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/sagaxyz/ssc/x/escrow/types"
 )
@@ -40,10 +42,20 @@ func (k Keeper) getPool(ctx sdk.Context, chainID, denom string) (types.DenomPool
 }
 
 func (k Keeper) setPool(ctx sdk.Context, p types.DenomPool) {
+	old, _ := k.getPool(ctx, p.ChainId, p.Denom)
+	k.updatePoolStats(ctx, p.ChainId, p.Denom, poolBalance(old), poolBalance(p))
+
 	bz := k.cdc.MustMarshal(&p)
 	ctx.KVStore(k.storeKey).Set(types.PoolKey(p.ChainId, p.Denom), bz)
 }
 
+func (k Keeper) deletePool(ctx sdk.Context, chainID, denom string) {
+	old, _ := k.getPool(ctx, chainID, denom)
+	k.updatePoolStats(ctx, chainID, denom, poolBalance(old), math.ZeroInt())
+
+	ctx.KVStore(k.storeKey).Delete(types.PoolKey(chainID, denom))
+}
+
 func (k Keeper) getFunder(ctx sdk.Context, chainID, denom, addr string) (types.Funder, bool) {
 	bz := ctx.KVStore(k.storeKey).Get(types.FunderKey(chainID, denom, addr))
 	if bz == nil {
@@ -56,6 +68,9 @@ func (k Keeper) getFunder(ctx sdk.Context, chainID, denom, addr string) (types.F
 
 func (k Keeper) setFunder(ctx sdk.Context, chainID, denom, addr string, f types.Funder) {
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.ByFunderKey(addr, chainID, denom)) && !k.hasPositions(ctx, addr) {
+		k.addCounter(ctx, types.KeyActiveFunders, 1)
+	}
 	store.Set(types.FunderKey(chainID, denom, addr), k.cdc.MustMarshal(&f))
 	// optional reverse index for "my positions"
 	store.Set(types.ByFunderKey(addr, chainID, denom), []byte{})
@@ -63,9 +78,13 @@ func (k Keeper) setFunder(ctx sdk.Context, chainID, denom, addr string, f types.
 
 func (k Keeper) deleteFunder(ctx sdk.Context, chainID, denom, addr string) {
 	store := ctx.KVStore(k.storeKey)
+	existed := store.Has(types.ByFunderKey(addr, chainID, denom))
 	store.Delete(types.FunderKey(chainID, denom, addr))
 	// optional reverse index cleanup
 	store.Delete(types.ByFunderKey(addr, chainID, denom))
+	if existed && !k.hasPositions(ctx, addr) {
+		k.addCounter(ctx, types.KeyActiveFunders, -1)
+	}
 }
 
 // clearPoolFunders removes all funder records for a specific (chainID, denom) pool.
@@ -85,8 +104,7 @@ func (k Keeper) clearPoolFunders(ctx sdk.Context, chainID, denom string) {
 
 	// Delete all funder records and their reverse indexes
 	for _, addr := range addrs {
-		store.Delete(types.FunderKey(chainID, denom, addr))
-		store.Delete(types.ByFunderKey(addr, chainID, denom))
+		k.deleteFunder(ctx, chainID, denom, addr)
 	}
 }
 
@@ -176,6 +194,9 @@ func (k Keeper) deposit(ctx sdk.Context, addr sdk.AccAddress, chainID string, am
 	if _, ok := k.getChainlet(ctx, chainID); !ok {
 		return cosmossdkerrors.Wrapf(types.ErrChainletAccountNotFound, "chainlet %s not found", chainID)
 	}
+	if err := k.assertAllowedFunder(ctx, chainID, addr.String()); err != nil {
+		return err
+	}
 
 	// move funds in
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
@@ -183,6 +204,7 @@ func (k Keeper) deposit(ctx sdk.Context, addr sdk.AccAddress, chainID string, am
 	}
 
 	pool, ok := k.getPool(ctx, chainID, denom)
+	bootstrap := !ok || !pool.Shares.IsPositive()
 	if !ok {
 		// bootstrap pool
 		dec, err := sdk.ParseDecCoin(amount.String())
@@ -224,7 +246,16 @@ func (k Keeper) deposit(ctx sdk.Context, addr sdk.AccAddress, chainID string, am
 		k.setFunder(ctx, chainID, denom, addr.String(), types.Funder{Shares: dec.Amount})
 	}
 
+	// The first deposit into a pool without shares is not capped, the funder holds all of them
+	f, _ := k.getFunder(ctx, chainID, denom, addr.String())
+	if !bootstrap {
+		if err := k.assertFunderShareCap(ctx, chainID, addr.String(), f.Shares, pool.Shares); err != nil {
+			return err
+		}
+	}
+
 	k.setPool(ctx, pool)
+	k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT, addr.String(), amount, pool)
 
 	// optional: billing hook
 	if err := k.billingKeeper.BillAndRestartChainlet(ctx, chainID); err != nil {
@@ -240,6 +271,11 @@ func (k Keeper) deposit(ctx sdk.Context, addr sdk.AccAddress, chainID string, am
 	})
 }
 
+// Deposit into a chainlet pool on behalf of addr, for deposits that do not come from MsgDeposit.
+func (k Keeper) Deposit(ctx sdk.Context, addr sdk.AccAddress, chainID string, amount sdk.Coin) error {
+	return k.deposit(ctx, addr, chainID, amount)
+}
+
 // Withdraw the entire position for {chainID, denom} (or adapt to partials if needed).
 // Withdraw all positions (all denoms) a user has on a given chainlet.
 func (k Keeper) WithdrawAll(ctx sdk.Context, addr sdk.AccAddress, chainID string) error {
@@ -282,6 +318,7 @@ func (k Keeper) WithdrawAll(ctx sdk.Context, addr sdk.AccAddress, chainID string
 			total = total.Add(coinOut)
 			foundAny = true
 			k.setPool(ctx, pool)
+			k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAW, addrStr, coinOut, pool)
 			_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
 				User:      addrStr,
 				Chainlet:  chainID,
@@ -299,7 +336,7 @@ func (k Keeper) WithdrawAll(ctx sdk.Context, addr sdk.AccAddress, chainID string
 	return nil
 }
 
-// OPTIONAL: allow withdrawing a single denom position, if you keep a denom-specific Msg later.
+// Withdraw the entire position of a single denom.
 func (k Keeper) WithdrawDenom(ctx sdk.Context, addr sdk.AccAddress, chainID, denom string) error {
 	addrStr := addr.String()
 
@@ -312,12 +349,81 @@ func (k Keeper) WithdrawDenom(ctx sdk.Context, addr sdk.AccAddress, chainID, den
 		return cosmossdkerrors.Wrap(types.ErrFunderNotFound, addrStr)
 	}
 
-	_, err := k.withdrawOne(ctx, addr, &pool, chainID, denom, f)
+	coinOut, err := k.withdrawOne(ctx, addr, &pool, chainID, denom, f)
+	if err != nil {
+		return err
+	}
+
+	k.setPool(ctx, pool)
+	if coinOut.IsPositive() {
+		k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAW, addrStr, coinOut, pool)
+	}
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
+		User:      addrStr,
+		Chainlet:  chainID,
+		Denom:     denom,
+		Remaining: pool.Balance.String(),
+	})
+	return nil
+}
+
+// WithdrawPartial withdraws a part of a single denom position, given either as an amount of
+// tokens or as a fraction of the position. Shares are burned at the pool's scaling factor.
+func (k Keeper) WithdrawPartial(ctx sdk.Context, addr sdk.AccAddress, chainID, denom string, amount math.Int, fraction math.LegacyDec) error {
+	addrStr := addr.String()
+
+	pool, ok := k.getPool(ctx, chainID, denom)
+	if !ok {
+		return cosmossdkerrors.Wrapf(types.ErrChainletAccountNotFound, "pool %s/%s not found", chainID, denom)
+	}
+	f, exists := k.getFunder(ctx, chainID, denom, addrStr)
+	if !exists || f.Shares.IsZero() {
+		return cosmossdkerrors.Wrap(types.ErrFunderNotFound, addrStr)
+	}
+
+	sf := ScalingFactor(pool) // shares -> tokens scale (S / T)
+	var shares math.LegacyDec
+	if !fraction.IsNil() {
+		shares = f.Shares.Mul(fraction)
+		amount = shares.QuoTruncate(sf).TruncateInt()
+	} else {
+		if !amount.IsPositive() {
+			return cosmossdkerrors.Wrapf(types.ErrInvalidCoin, "withdrawal of %s%s is too small", amount, denom)
+		}
+		if amount.GT(pool.Balance.Amount) {
+			return cosmossdkerrors.Wrapf(types.ErrInsufficientBalance, "pool %s/%s holds less than %s%s", chainID, denom, amount, denom)
+		}
+		// Round the burned shares up so that withdrawals cannot take more than their shares are worth
+		shares = pool.Shares.MulInt(amount).QuoRoundUp(math.LegacyNewDecFromInt(pool.Balance.Amount))
+		if shares.GT(f.Shares) {
+			return cosmossdkerrors.Wrapf(types.ErrInsufficientBalance, "position of %s holds less than %s%s", addrStr, amount, denom)
+		}
+	}
+
+	// Withdrawing the whole position pays out any dust as well
+	if shares.Equal(f.Shares) {
+		return k.WithdrawDenom(ctx, addr, chainID, denom)
+	}
+	if !amount.IsPositive() {
+		return cosmossdkerrors.Wrapf(types.ErrInvalidCoin, "withdrawal of %s%s is too small", amount, denom)
+	}
+
+	coin := sdk.NewCoin(denom, amount)
+	newBal, err := pool.Balance.SafeSub(coin)
 	if err != nil {
+		return cosmossdkerrors.Wrap(types.ErrInsufficientBalance, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(coin)); err != nil {
 		return err
 	}
 
+	f.Shares = f.Shares.Sub(shares)
+	k.setFunder(ctx, chainID, denom, addrStr, f)
+	pool.Balance = newBal
+	pool.Shares = pool.Shares.Sub(shares)
 	k.setPool(ctx, pool)
+	k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAW, addrStr, coin, pool)
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
 		User:      addrStr,
 		Chainlet:  chainID,
@@ -327,6 +433,106 @@ func (k Keeper) WithdrawDenom(ctx sdk.Context, addr sdk.AccAddress, chainID, den
 	return nil
 }
 
+// TransferPosition moves shares of a denom position to another funder, the whole position if
+// shares is nil. The pool balance and total shares are left untouched.
+func (k Keeper) TransferPosition(ctx sdk.Context, from, to sdk.AccAddress, chainID, denom string, shares math.LegacyDec) error {
+	fromStr, toStr := from.String(), to.String()
+	if fromStr == toStr {
+		return cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "recipient must differ from sender")
+	}
+
+	pool, ok := k.getPool(ctx, chainID, denom)
+	if !ok {
+		return cosmossdkerrors.Wrapf(types.ErrChainletAccountNotFound, "pool %s/%s not found", chainID, denom)
+	}
+	if err := k.assertAllowedFunder(ctx, chainID, toStr); err != nil {
+		return err
+	}
+	f, exists := k.getFunder(ctx, chainID, denom, fromStr)
+	if !exists || f.Shares.IsZero() {
+		return cosmossdkerrors.Wrap(types.ErrFunderNotFound, fromStr)
+	}
+	if shares.IsNil() {
+		shares = f.Shares
+	}
+	if !shares.IsPositive() {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "shares must be positive (%s)", shares)
+	}
+	if shares.GT(f.Shares) {
+		return cosmossdkerrors.Wrapf(types.ErrInsufficientBalance, "position of %s holds %s shares, less than %s", fromStr, f.Shares, shares)
+	}
+
+	recipient, exists := k.getFunder(ctx, chainID, denom, toStr)
+	if exists {
+		recipient.Shares = recipient.Shares.Add(shares)
+	} else {
+		recipient = types.Funder{Shares: shares}
+	}
+	if err := k.assertFunderShareCap(ctx, chainID, toStr, recipient.Shares, pool.Shares); err != nil {
+		return err
+	}
+
+	if shares.Equal(f.Shares) {
+		k.deleteFunder(ctx, chainID, denom, fromStr)
+	} else {
+		f.Shares = f.Shares.Sub(shares)
+		k.setFunder(ctx, chainID, denom, fromStr, f)
+	}
+	k.setFunder(ctx, chainID, denom, toStr, recipient)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTransferPosition{
+		Sender:    fromStr,
+		Recipient: toStr,
+		Chainlet:  chainID,
+		Denom:     denom,
+		Shares:    shares.String(),
+	})
+}
+
+// canPayNextEpoch reports whether any pool of a billed chainlet covers the epoch fee of its denom.
+func (k Keeper) canPayNextEpoch(ctx sdk.Context, chainID string) bool {
+	chainlet, err := k.chainletKeeper.GetChainletInfo(ctx, chainID)
+	if err != nil || chainlet.IsServiceChainlet || !chainlet.Status.IsActive() {
+		return false
+	}
+	stack, err := k.chainletKeeper.GetChainletStackInfo(ctx, chainID)
+	if err != nil {
+		return false
+	}
+	for _, fee := range stack.Fees {
+		epochFee, err := sdk.ParseCoinNormalized(fee.EpochFee)
+		if err != nil {
+			continue
+		}
+		pool, ok := k.getPool(ctx, chainID, epochFee.Denom)
+		if ok && pool.Balance.IsGTE(epochFee) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSoleFunder reports whether addr is the only funder across all pools of the chainlet.
+func (k Keeper) isSoleFunder(ctx sdk.Context, addr sdk.AccAddress, chainID string) bool {
+	_, pools, err := k.GetChainletWithPools(ctx, chainID)
+	if err != nil {
+		return false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, pool := range pools {
+		it := prefix.NewStore(store, types.FunderPrefix(chainID, pool.Denom)).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			if string(it.Key()) != addr.String() {
+				it.Close()
+				return false
+			}
+		}
+		it.Close()
+	}
+	return true
+}
+
 // Helper: withdraw ENTIRE position for a single denom.
 // Returns the coin paid out for this denom and mutates 'pool' in place.
 func (k Keeper) withdrawOne(
@@ -391,6 +597,78 @@ func (k Keeper) withdrawOne(
 	return coin, nil
 }
 
+// RefundChainlet pays out every funder of every pool of the chainlet pro-rata to
+// their shares and deletes the chainlet account, its top-up subscriptions and its funder
+// policy. Used when a chainlet is decommissioned.
+func (k Keeper) RefundChainlet(ctx sdk.Context, chainID string) error {
+	k.deleteFunderPolicy(ctx, chainID)
+
+	_, pools, err := k.GetChainletWithPools(ctx, chainID)
+	if errors.Is(err, types.ErrChainletAccountNotFound) {
+		// Nothing to refund (e.g. service chainlets)
+		return nil
+	}
+	if err != nil {
+		return cosmossdkerrors.Wrapf(err, "cannot refund chainlet %s", chainID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	drained := true
+	for _, pool := range pools {
+		// Collect all funders first to avoid iterator invalidation during deletion
+		var addrs []string
+		var funders []types.Funder
+		pfx := prefix.NewStore(store, types.FunderPrefix(chainID, pool.Denom))
+		it := pfx.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			var f types.Funder
+			k.cdc.MustUnmarshal(it.Value(), &f)
+			addrs = append(addrs, string(it.Key()))
+			funders = append(funders, f)
+		}
+		it.Close()
+
+		// withdrawOne pays the remaining balance to the last funder, so no dust is left behind
+		for i, addrStr := range addrs {
+			addr, err := sdk.AccAddressFromBech32(addrStr)
+			if err != nil {
+				return err
+			}
+			coinOut, err := k.withdrawOne(ctx, addr, pool, chainID, pool.Denom, funders[i])
+			if err != nil {
+				return err
+			}
+			if coinOut.IsZero() {
+				// Burn the shares as well so that the last funder still collects the dust
+				pool.Shares = pool.Shares.Sub(funders[i].Shares)
+				k.deleteFunder(ctx, chainID, pool.Denom, addrStr)
+				continue
+			}
+			k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_REFUND, addrStr, coinOut, *pool)
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventRefund{
+				User:     addrStr,
+				Chainlet: chainID,
+				Denom:    pool.Denom,
+				Amount:   coinOut.String(),
+			})
+		}
+		if pool.Balance.IsPositive() {
+			// Keep the pool so that the module balance stays accounted for
+			ctx.Logger().Error(fmt.Sprintf("pool %s/%s has %s left without funders after refund", chainID, pool.Denom, pool.Balance))
+			k.setPool(ctx, *pool)
+			drained = false
+			continue
+		}
+		k.deletePool(ctx, chainID, pool.Denom)
+	}
+	k.deleteTopUps(ctx, chainID)
+	if drained {
+		store.Delete(types.ChainletKey(chainID))
+	}
+
+	return nil
+}
+
 // SetChainletAccount (compat) — uses protobuf codec.
 func (k Keeper) SetChainletAccount(ctx sdk.Context, chainlet types.ChainletAccount) error {
 	k.setChainlet(ctx, chainlet)
@@ -431,6 +709,28 @@ func (k Keeper) BillAccount(ctx sdk.Context, amount sdk.Coin, chainID, toModule
 	}
 
 	k.setPool(ctx, pool)
+	k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_BILL, toModule, amount, pool)
+	return nil
+}
+
+// RefundBilling returns a part of a billed amount from a module to the pool it was billed from.
+// The pool has to still have funders to credit it to.
+func (k Keeper) RefundBilling(ctx sdk.Context, amount sdk.Coin, chainID, fromModule string) error {
+	pool, ok := k.getPool(ctx, chainID, amount.Denom)
+	if !ok {
+		return cosmossdkerrors.Wrapf(types.ErrChainletAccountNotFound, "pool %s/%s not found", chainID, amount.Denom)
+	}
+	if !pool.Shares.IsPositive() {
+		return cosmossdkerrors.Wrapf(types.ErrFunderNotFound, "pool %s/%s has no funders", chainID, amount.Denom)
+	}
+	// move funds
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return cosmossdkerrors.Wrap(types.ErrBankFailure, err.Error())
+	}
+	// update pool, the funders' shares are worth more
+	pool.Balance = pool.Balance.Add(amount)
+	k.setPool(ctx, pool)
+	k.recordLedgerEntry(ctx, types.LedgerEntryType_LEDGER_ENTRY_TYPE_BILLING_REFUND, fromModule, amount, pool)
 	return nil
 }
 
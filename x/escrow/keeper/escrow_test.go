@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+	"sort"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -15,8 +17,11 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
 	"github.com/sagaxyz/ssc/x/escrow/types"
 )
 
@@ -603,3 +608,331 @@ func TestDepositIntoPoolWithZeroBalance(t *testing.T) {
 	t.Logf("  New funder shares: %s (100%% ownership)", newFunder.Shares.String())
 }
 
+
+func TestRefundChainlet(t *testing.T) {
+	k, ctx := testKeeper(t)
+	bk := escrowtestutil.NewMockBankKeeper(gomock.NewController(t))
+	k.bankKeeper = bk
+
+	chainID := "test_1-1"
+	denom := "utoken"
+	funders := []sdk.AccAddress{
+		sdk.AccAddress("funder1"),
+		sdk.AccAddress("funder2"),
+		sdk.AccAddress("funder3"),
+	}
+
+	// 1000 tokens backed by 3000 shares split 1:1:1, with 1 token of dust
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(1000)),
+		Shares:  math.LegacyNewDec(3000),
+	})
+	for _, addr := range funders {
+		k.setFunder(ctx, chainID, denom, addr.String(), types.Funder{Shares: math.LegacyNewDec(1000)})
+	}
+	// Other chainlets are not touched
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: "test_1-10"})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: "test_1-10",
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(1000)),
+		Shares:  math.LegacyNewDec(1000),
+	})
+	k.setFunder(ctx, "test_1-10", denom, funders[0].String(), types.Funder{Shares: math.LegacyNewDec(1000)})
+
+	paid := math.ZeroInt()
+	bk.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+			require.True(t, amt.AmountOf(denom).GTE(math.NewInt(333)))
+			paid = paid.Add(amt.AmountOf(denom))
+			return nil
+		}).
+		Times(len(funders))
+
+	require.NoError(t, k.RefundChainlet(ctx, chainID))
+	require.Equal(t, math.NewInt(1000), paid)
+
+	_, found := k.getChainlet(ctx, chainID)
+	require.False(t, found)
+	_, found = k.getPool(ctx, chainID, denom)
+	require.False(t, found)
+	for _, addr := range funders {
+		_, found = k.getFunder(ctx, chainID, denom, addr.String())
+		require.False(t, found)
+		require.False(t, ctx.KVStore(k.storeKey).Has(types.ByFunderKey(addr.String(), chainID, denom)))
+	}
+
+	_, found = k.getChainlet(ctx, "test_1-10")
+	require.True(t, found)
+	_, found = k.getFunder(ctx, "test_1-10", denom, funders[0].String())
+	require.True(t, found)
+
+	// Chainlets without an escrow account have nothing to refund
+	require.NoError(t, k.RefundChainlet(ctx, "test_2-1"))
+}
+
+func TestRefundChainletZeroPayoutFunder(t *testing.T) {
+	k, ctx := testKeeper(t)
+	bk := escrowtestutil.NewMockBankKeeper(gomock.NewController(t))
+	k.bankKeeper = bk
+
+	chainID := "test_1-1"
+	denom := "utoken"
+	addrs := []string{
+		sdk.AccAddress("funder1").String(),
+		sdk.AccAddress("funder2").String(),
+		sdk.AccAddress("funder3").String(),
+	}
+	sort.Strings(addrs)
+
+	// The first funder refunded holds too few shares to be paid anything
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(1000)),
+		Shares:  math.LegacyNewDec(3001),
+	})
+	k.setFunder(ctx, chainID, denom, addrs[0], types.Funder{Shares: math.LegacyNewDec(1)})
+	k.setFunder(ctx, chainID, denom, addrs[1], types.Funder{Shares: math.LegacyNewDec(1500)})
+	k.setFunder(ctx, chainID, denom, addrs[2], types.Funder{Shares: math.LegacyNewDec(1500)})
+
+	paid := math.ZeroInt()
+	bk.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+			paid = paid.Add(amt.AmountOf(denom))
+			return nil
+		}).
+		Times(2)
+
+	// The last funder collects the dust so that the pool is drained
+	require.NoError(t, k.RefundChainlet(ctx, chainID))
+	require.Equal(t, math.NewInt(1000), paid)
+	_, found := k.getPool(ctx, chainID, denom)
+	require.False(t, found)
+	_, found = k.getChainlet(ctx, chainID)
+	require.False(t, found)
+}
+
+func TestWithdrawPartial(t *testing.T) {
+	k, ctx := testKeeper(t)
+	bk := escrowtestutil.NewMockBankKeeper(gomock.NewController(t))
+	k.bankKeeper = bk
+
+	chainID := "test_1-1"
+	denom := "utoken"
+	funder1 := sdk.AccAddress("funder1")
+	funder2 := sdk.AccAddress("funder2")
+
+	// 1000 tokens backed by 2000 shares, split 3:1
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(1000)),
+		Shares:  math.LegacyNewDec(2000),
+	})
+	k.setFunder(ctx, chainID, denom, funder1.String(), types.Funder{Shares: math.LegacyNewDec(1500)})
+	k.setFunder(ctx, chainID, denom, funder2.String(), types.Funder{Shares: math.LegacyNewDec(500)})
+
+	// Amount burns shares at the scaling factor
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, funder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))).Return(nil)
+	require.NoError(t, k.WithdrawPartial(ctx, funder1, chainID, denom, math.NewInt(100), math.LegacyDec{}))
+	f, _ := k.getFunder(ctx, chainID, denom, funder1.String())
+	require.Equal(t, math.LegacyNewDec(1300), f.Shares)
+	pool, _ := k.getPool(ctx, chainID, denom)
+	require.Equal(t, math.NewInt(900), pool.Balance.Amount)
+	require.Equal(t, math.LegacyNewDec(1800), pool.Shares)
+
+	// Fraction of the position
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, funder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 125))).Return(nil)
+	require.NoError(t, k.WithdrawPartial(ctx, funder2, chainID, denom, math.Int{}, math.LegacyNewDecWithPrec(5, 1)))
+	f, _ = k.getFunder(ctx, chainID, denom, funder2.String())
+	require.Equal(t, math.LegacyNewDec(250), f.Shares)
+	pool, _ = k.getPool(ctx, chainID, denom)
+	require.Equal(t, math.NewInt(775), pool.Balance.Amount)
+	require.Equal(t, math.LegacyNewDec(1550), pool.Shares)
+
+	// Amounts above the position are rejected
+	err := k.WithdrawPartial(ctx, funder2, chainID, denom, math.NewInt(126), math.LegacyDec{})
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	// The whole position removes the funder
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, funder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 125))).Return(nil)
+	require.NoError(t, k.WithdrawPartial(ctx, funder2, chainID, denom, math.Int{}, math.LegacyOneDec()))
+	_, found := k.getFunder(ctx, chainID, denom, funder2.String())
+	require.False(t, found)
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.ByFunderKey(funder2.String(), chainID, denom)))
+	pool, _ = k.getPool(ctx, chainID, denom)
+	require.Equal(t, math.NewInt(650), pool.Balance.Amount)
+	require.Equal(t, math.LegacyNewDec(1300), pool.Shares)
+}
+
+func TestWithdrawPartialRoundsSharesUp(t *testing.T) {
+	k, ctx := testKeeper(t)
+	bk := escrowtestutil.NewMockBankKeeper(gomock.NewController(t))
+	k.bankKeeper = bk
+
+	chainID := "test_1-1"
+	denom := "utoken"
+	funder1 := sdk.AccAddress("funder1")
+	funder2 := sdk.AccAddress("funder2")
+
+	// 3 tokens backed by 10 shares, a token is worth 3.33... shares
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(3)),
+		Shares:  math.LegacyNewDec(10),
+	})
+	k.setFunder(ctx, chainID, denom, funder1.String(), types.Funder{Shares: math.LegacyNewDec(5)})
+	k.setFunder(ctx, chainID, denom, funder2.String(), types.Funder{Shares: math.LegacyMustNewDecFromStr("3.333333333333333333")})
+
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, funder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))).Return(nil)
+	require.NoError(t, k.WithdrawPartial(ctx, funder1, chainID, denom, math.NewInt(1), math.LegacyDec{}))
+	burned := math.LegacyMustNewDecFromStr("3.333333333333333334")
+	f, _ := k.getFunder(ctx, chainID, denom, funder1.String())
+	require.Equal(t, math.LegacyNewDec(5).Sub(burned), f.Shares)
+	pool, _ := k.getPool(ctx, chainID, denom)
+	require.Equal(t, math.LegacyNewDec(10).Sub(burned), pool.Shares)
+
+	// A position just short of the rounded up shares cannot withdraw the token
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(3)),
+		Shares:  math.LegacyNewDec(10),
+	})
+	err := k.WithdrawPartial(ctx, funder2, chainID, denom, math.NewInt(1), math.LegacyDec{})
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	// Amounts above the pool balance are rejected
+	err = k.WithdrawPartial(ctx, funder1, chainID, denom, math.NewInt(4), math.LegacyDec{})
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+}
+
+func TestWithdrawRunwayGuard(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.bankKeeper = bk
+	k.chainletKeeper = ck
+	msgServer := NewMsgServerImpl(*k)
+
+	chainID := "test_1-1"
+	denom := "utoken"
+	funder1 := sdk.AccAddress("funder1")
+	funder2 := sdk.AccAddress("funder2")
+
+	ck.EXPECT().GetChainletInfo(gomock.Any(), chainID).Return(&chainlettypes.Chainlet{
+		ChainId: chainID,
+		Status:  chainlettypes.Status_STATUS_ONLINE,
+	}, nil).AnyTimes()
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{{Denom: denom, EpochFee: "100" + denom}},
+	}, nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(300)),
+		Shares:  math.LegacyNewDec(300),
+	})
+	k.setFunder(ctx, chainID, denom, funder1.String(), types.Funder{Shares: math.LegacyNewDec(250)})
+	k.setFunder(ctx, chainID, denom, funder2.String(), types.Funder{Shares: math.LegacyNewDec(50)})
+
+	// Leaving 50 tokens cannot pay the next epoch
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.Withdraw(cacheCtx, types.NewMsgWithdraw(funder1.String(), chainID, "", "", ""))
+	require.ErrorIs(t, err, types.ErrInsufficientRunway)
+
+	// Leaving 100 tokens can
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.Withdraw(cacheCtx, types.NewMsgWithdraw(funder1.String(), chainID, denom, "200", ""))
+	require.NoError(t, err)
+
+	// The only funder can withdraw everything
+	k.deleteFunder(ctx, chainID, denom, funder2.String())
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(250)),
+		Shares:  math.LegacyNewDec(250),
+	})
+	_, err = msgServer.Withdraw(ctx, types.NewMsgWithdraw(funder1.String(), chainID, "", "", ""))
+	require.NoError(t, err)
+}
+
+func TestTransferPosition(t *testing.T) {
+	k, ctx := testKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+
+	chainID := "test_1-1"
+	denom := "utoken"
+	funder1 := sdk.AccAddress("funder1")
+	funder2 := sdk.AccAddress("funder2")
+	funder3 := sdk.AccAddress("funder3")
+
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(1000)),
+		Shares:  math.LegacyNewDec(2000),
+	})
+	k.setFunder(ctx, chainID, denom, funder1.String(), types.Funder{Shares: math.LegacyNewDec(1500)})
+	k.setFunder(ctx, chainID, denom, funder2.String(), types.Funder{Shares: math.LegacyNewDec(500)})
+
+	requirePoolUnchanged := func() {
+		pool, _ := k.getPool(ctx, chainID, denom)
+		require.Equal(t, math.NewInt(1000), pool.Balance.Amount)
+		require.Equal(t, math.LegacyNewDec(2000), pool.Shares)
+	}
+	requireShares := func(addr sdk.AccAddress, shares int64) {
+		f, found := k.getFunder(ctx, chainID, denom, addr.String())
+		require.True(t, found)
+		require.Equal(t, math.LegacyNewDec(shares), f.Shares)
+		require.True(t, ctx.KVStore(k.storeKey).Has(types.ByFunderKey(addr.String(), chainID, denom)))
+	}
+
+	// Part of a position to a new funder
+	_, err := msgServer.TransferEscrowPosition(ctx, types.NewMsgTransferEscrowPosition(funder1.String(), chainID, denom, funder3.String(), "400"))
+	require.NoError(t, err)
+	requireShares(funder1, 1100)
+	requireShares(funder3, 400)
+	requirePoolUnchanged()
+
+	// More shares than the position holds
+	_, err = msgServer.TransferEscrowPosition(ctx, types.NewMsgTransferEscrowPosition(funder2.String(), chainID, denom, funder3.String(), "501"))
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	// The whole position to an existing funder
+	_, err = msgServer.TransferEscrowPosition(ctx, types.NewMsgTransferEscrowPosition(funder2.String(), chainID, denom, funder3.String(), ""))
+	require.NoError(t, err)
+	requireShares(funder3, 900)
+	_, found := k.getFunder(ctx, chainID, denom, funder2.String())
+	require.False(t, found)
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.ByFunderKey(funder2.String(), chainID, denom)))
+	requirePoolUnchanged()
+
+	var transfers int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "ssc.escrow.EventTransferPosition" {
+			transfers++
+		}
+	}
+	require.Equal(t, 2, transfers)
+
+	// Unknown pool
+	_, err = msgServer.TransferEscrowPosition(ctx, types.NewMsgTransferEscrowPosition(funder1.String(), chainID, "uother", funder3.String(), ""))
+	require.ErrorIs(t, err, types.ErrChainletAccountNotFound)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"slices"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k Keeper) getFunderPolicy(ctx sdk.Context, chainID string) (types.FunderPolicy, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FunderPolicyKey(chainID))
+	if bz == nil {
+		return types.FunderPolicy{ChainId: chainID, MaxFunderShare: math.LegacyZeroDec()}, false
+	}
+	var policy types.FunderPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+func (k Keeper) setFunderPolicy(ctx sdk.Context, policy types.FunderPolicy) {
+	// an open policy without a cap is the default
+	if policy.Mode == types.FunderMode_FUNDER_MODE_OPEN && len(policy.Allowlist) == 0 && !policy.MaxFunderShare.IsPositive() {
+		k.deleteFunderPolicy(ctx, policy.ChainId)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.FunderPolicyKey(policy.ChainId), k.cdc.MustMarshal(&policy))
+}
+
+func (k Keeper) deleteFunderPolicy(ctx sdk.Context, chainID string) {
+	ctx.KVStore(k.storeKey).Delete(types.FunderPolicyKey(chainID))
+}
+
+// SetChainletFunderPolicy sets who may fund the pools of a chainlet. Only its launcher can set it.
+func (k Keeper) SetChainletFunderPolicy(ctx sdk.Context, addr sdk.AccAddress, policy types.FunderPolicy) error {
+	chainlet, err := k.chainletKeeper.GetChainletInfo(ctx, policy.ChainId)
+	if err != nil {
+		return err
+	}
+	if chainlet.Launcher != addr.String() {
+		return cosmossdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the launcher of chainlet %s", addr, policy.ChainId)
+	}
+	k.setFunderPolicy(ctx, policy)
+	return nil
+}
+
+// isLauncher reports whether the address launched the chainlet. The launcher is not
+// restricted by the funder policy.
+func (k Keeper) isLauncher(ctx sdk.Context, chainID, addr string) (bool, error) {
+	chainlet, err := k.chainletKeeper.GetChainletInfo(ctx, chainID)
+	if err != nil {
+		return false, err
+	}
+	return chainlet.Launcher == addr, nil
+}
+
+// assertAllowedFunder checks the funder mode of the chainlet allows the address to fund it.
+func (k Keeper) assertAllowedFunder(ctx sdk.Context, chainID, addr string) error {
+	policy, found := k.getFunderPolicy(ctx, chainID)
+	if !found || policy.Mode == types.FunderMode_FUNDER_MODE_OPEN {
+		return nil
+	}
+	launcher, err := k.isLauncher(ctx, chainID, addr)
+	if err != nil || launcher {
+		return err
+	}
+	if policy.Mode == types.FunderMode_FUNDER_MODE_ALLOWLIST && slices.Contains(policy.Allowlist, addr) {
+		return nil
+	}
+	return cosmossdkerrors.Wrapf(types.ErrFunderNotAllowed, "%s cannot fund chainlet %s in mode %s", addr, chainID, policy.Mode)
+}
+
+// assertFunderShareCap checks the funder shares do not exceed the cap of the chainlet on the
+// share of a single funder in the pool.
+func (k Keeper) assertFunderShareCap(ctx sdk.Context, chainID, addr string, funderShares, poolShares math.LegacyDec) error {
+	policy, found := k.getFunderPolicy(ctx, chainID)
+	if !found || !policy.MaxFunderShare.IsPositive() || !poolShares.IsPositive() {
+		return nil
+	}
+	if funderShares.Quo(poolShares).LTE(policy.MaxFunderShare) {
+		return nil
+	}
+	launcher, err := k.isLauncher(ctx, chainID, addr)
+	if err != nil || launcher {
+		return err
+	}
+	return cosmossdkerrors.Wrapf(types.ErrFunderShareCap, "%s would hold %s of %s shares, above %s", addr, funderShares, poolShares, policy.MaxFunderShare)
+}
+
+// ExportFunderPolicies exports all funder policies from the store
+func (k Keeper) ExportFunderPolicies(ctx sdk.Context) []types.FunderPolicy {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.KeyFunderPolicyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var policies []types.FunderPolicy
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.FunderPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// ImportFunderPolicy imports a single funder policy into the store
+func (k Keeper) ImportFunderPolicy(ctx sdk.Context, policy types.FunderPolicy) {
+	k.setFunderPolicy(ctx, policy)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestFunderPolicy(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.bankKeeper = bk
+	k.billingKeeper = bik
+	k.chainletKeeper = ck
+	msgServer := NewMsgServerImpl(*k)
+
+	chainID := "test_1-1"
+	denom := "utsaga"
+	launcher := sdk.AccAddress("launcher")
+	friend := sdk.AccAddress("friend")
+	stranger := sdk.AccAddress("stranger")
+
+	ck.EXPECT().GetChainletInfo(gomock.Any(), chainID).Return(&chainlettypes.Chainlet{
+		ChainId:  chainID,
+		Launcher: launcher.String(),
+		Status:   chainlettypes.Status_STATUS_ONLINE,
+	}, nil).AnyTimes()
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{{Denom: denom, EpochFee: "10" + denom}},
+	}, nil).AnyTimes()
+	bik.EXPECT().BillAndRestartChainlet(gomock.Any(), chainID).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(100)),
+		Shares:  math.LegacyNewDec(100),
+	})
+	k.setFunder(ctx, chainID, denom, launcher.String(), types.Funder{Shares: math.LegacyNewDec(100)})
+
+	// Failed transactions leave no state behind
+	deposit := func(addr sdk.AccAddress, amount string) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := msgServer.Deposit(cacheCtx, types.NewMsgDeposit(addr.String(), amount+denom, chainID))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	setPolicy := func(addr sdk.AccAddress, mode types.FunderMode, allowlist []string, maxFunderShare string) error {
+		_, err := msgServer.SetFunderPolicy(ctx, types.NewMsgSetFunderPolicy(addr.String(), chainID, mode, allowlist, maxFunderShare))
+		return err
+	}
+
+	// Only the launcher sets the policy
+	require.ErrorIs(t, setPolicy(stranger, types.FunderMode_FUNDER_MODE_LAUNCHER_ONLY, nil, ""), types.ErrUnauthorized)
+
+	require.NoError(t, setPolicy(launcher, types.FunderMode_FUNDER_MODE_LAUNCHER_ONLY, nil, ""))
+	require.ErrorIs(t, deposit(friend, "10"), types.ErrFunderNotAllowed)
+	require.NoError(t, deposit(launcher, "10"))
+
+	require.NoError(t, setPolicy(launcher, types.FunderMode_FUNDER_MODE_ALLOWLIST, []string{friend.String()}, "0.25"))
+	require.ErrorIs(t, deposit(stranger, "10"), types.ErrFunderNotAllowed)
+	// 60 of 170 is above the cap
+	require.ErrorIs(t, deposit(friend, "60"), types.ErrFunderShareCap)
+	require.NoError(t, deposit(friend, "30"))
+	// The launcher is not capped
+	require.NoError(t, deposit(launcher, "1000"))
+
+	// Positions cannot be transferred around the policy
+	_, err := msgServer.TransferEscrowPosition(ctx, types.NewMsgTransferEscrowPosition(friend.String(), chainID, denom, stranger.String(), ""))
+	require.ErrorIs(t, err, types.ErrFunderNotAllowed)
+	_, err = msgServer.TransferEscrowPosition(ctx, types.NewMsgTransferEscrowPosition(launcher.String(), chainID, denom, friend.String(), "500"))
+	require.ErrorIs(t, err, types.ErrFunderShareCap)
+
+	res, err := k.GetFunderPolicy(ctx, &types.QueryFunderPolicyRequest{ChainId: chainID})
+	require.NoError(t, err)
+	require.Equal(t, types.FunderMode_FUNDER_MODE_ALLOWLIST, res.Policy.Mode)
+	require.Equal(t, []string{friend.String()}, res.Policy.Allowlist)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), res.Policy.MaxFunderShare)
+
+	// Opening the chainlet again removes the policy
+	require.NoError(t, setPolicy(launcher, types.FunderMode_FUNDER_MODE_OPEN, nil, ""))
+	require.False(t, ctx.KVStore(k.storeKey).Has(types.FunderPolicyKey(chainID)))
+	require.NoError(t, deposit(stranger, "10"))
+}
+
+func TestFunderShareCapBootstrap(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.bankKeeper = bk
+	k.billingKeeper = bik
+	k.chainletKeeper = ck
+
+	chainID := "test_1-1"
+	denom := "utsaga"
+	launcher := sdk.AccAddress("launcher")
+	friend := sdk.AccAddress("friend")
+	stranger := sdk.AccAddress("stranger")
+
+	ck.EXPECT().GetChainletInfo(gomock.Any(), chainID).Return(&chainlettypes.Chainlet{
+		ChainId:  chainID,
+		Launcher: launcher.String(),
+	}, nil).AnyTimes()
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{{Denom: denom, EpochFee: "10" + denom}},
+	}, nil).AnyTimes()
+	bik.EXPECT().BillAndRestartChainlet(gomock.Any(), chainID).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	require.NoError(t, k.SetChainletFunderPolicy(ctx, launcher, types.FunderPolicy{
+		ChainId:        chainID,
+		MaxFunderShare: math.LegacyNewDecWithPrec(5, 1),
+	}))
+
+	// Failed transactions leave no state behind
+	deposit := func(addr sdk.AccAddress, amount int64) error {
+		cacheCtx, write := ctx.CacheContext()
+		err := k.deposit(cacheCtx, addr, chainID, sdk.NewInt64Coin(denom, amount))
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// The first deposit into a pool holds all of its shares
+	require.NoError(t, deposit(friend, 100))
+	// Later deposits are capped
+	require.ErrorIs(t, deposit(friend, 1), types.ErrFunderShareCap)
+	require.NoError(t, deposit(stranger, 100))
+
+	// A drained pool without shares can be bootstrapped again
+	require.NoError(t, k.WithdrawDenom(ctx, friend, chainID, denom))
+	require.NoError(t, k.WithdrawDenom(ctx, stranger, chainID, denom))
+	pool, found := k.getPool(ctx, chainID, denom)
+	require.True(t, found)
+	require.True(t, pool.Shares.IsZero())
+	require.NoError(t, deposit(friend, 50))
+
+	// Decommissioning deletes the policy
+	require.NoError(t, k.RefundChainlet(ctx, chainID))
+	_, found = k.getFunderPolicy(ctx, chainID)
+	require.False(t, found)
+}
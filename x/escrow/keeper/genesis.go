@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"bytes"
-
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -50,18 +48,16 @@ func (k Keeper) ExportFunders(ctx sdk.Context) []types.GenesisFunder {
 		var funder types.Funder
 		k.cdc.MustUnmarshal(iterator.Value(), &funder)
 
-		// Parse the key to extract chainId, denom, and address
-		// Key format: {chainId}/{denom}/{addr}
-		key := iterator.Key()
-		parts := bytes.SplitN(key, []byte{'/'}, 3)
-		if len(parts) != 3 {
+		// Key format: {chainId}/{denom}/{addr}, the denom can contain '/' (ibc/<hash>)
+		chainID, denom, addr, ok := parseFunderKey(iterator.Key())
+		if !ok {
 			continue
 		}
 
 		funders = append(funders, types.GenesisFunder{
-			ChainId: string(parts[0]),
-			Denom:   string(parts[1]),
-			Address: string(parts[2]),
+			ChainId: chainID,
+			Denom:   denom,
+			Address: addr,
 			Funder:  funder,
 		})
 	}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestGenesisFundersRoundTrip(t *testing.T) {
+	k, ctx := testKeeper(t)
+
+	chainID := "test_1-1"
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	alice := sdk.AccAddress("alice").String()
+	bob := sdk.AccAddress("bob").String()
+
+	for _, denom := range []string{"utsaga", ibcDenom} {
+		k.setPool(ctx, types.DenomPool{
+			ChainId: chainID,
+			Denom:   denom,
+			Balance: sdk.NewInt64Coin(denom, 30),
+			Shares:  math.LegacyNewDec(30),
+		})
+		k.setFunder(ctx, chainID, denom, alice, types.Funder{Shares: math.LegacyNewDec(20)})
+		k.setFunder(ctx, chainID, denom, bob, types.Funder{Shares: math.LegacyNewDec(10)})
+	}
+
+	funders := k.ExportFunders(ctx)
+	require.Len(t, funders, 4)
+	var ibcFunders int
+	for _, gf := range funders {
+		require.Equal(t, chainID, gf.ChainId)
+		require.Contains(t, []string{alice, bob}, gf.Address)
+		if gf.Denom == ibcDenom {
+			ibcFunders++
+		}
+	}
+	require.Equal(t, 2, ibcFunders)
+
+	// Importing the export into a fresh store restores the same positions and funder index
+	imported, importedCtx := testKeeper(t)
+	for _, pool := range k.ExportPools(ctx) {
+		imported.ImportPool(importedCtx, pool)
+	}
+	for _, gf := range funders {
+		imported.ImportFunder(importedCtx, gf.ChainId, gf.Denom, gf.Address, gf.Funder)
+	}
+	require.Equal(t, funders, imported.ExportFunders(importedCtx))
+	require.Equal(t, k.ExportPools(ctx), imported.ExportPools(importedCtx))
+
+	funder, found := imported.getFunder(importedCtx, chainID, ibcDenom, alice)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(20), funder.Shares)
+	msg, broken := FunderIndexInvariant(*imported)(importedCtx)
+	require.False(t, broken, msg)
+}
@@ -186,3 +186,87 @@ func (k Keeper) GetFunderBalance(ctx context.Context, req *types.QueryFunderPosi
 		Pagination: pageRes,
 	}, nil
 }
+
+// ---------------------------------------
+// Funder policy
+// ---------------------------------------
+
+func (k Keeper) GetFunderPolicy(ctx context.Context, req *types.QueryFunderPolicyRequest) (*types.QueryFunderPolicyResponse, error) {
+	if req == nil || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chainId required")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	policy, _ := k.getFunderPolicy(sdkCtx, req.ChainId)
+	return &types.QueryFunderPolicyResponse{Policy: policy}, nil
+}
+
+// ---------------------------------------
+// Pool ledger
+// ---------------------------------------
+
+func (k Keeper) PoolLedger(ctx context.Context, req *types.QueryPoolLedgerRequest) (*types.QueryPoolLedgerResponse, error) {
+	if req == nil || req.ChainId == "" || req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "chainId and denom required")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := sdkCtx.KVStore(k.storeKey)
+	pfx := prefix.NewStore(store, types.LedgerPrefix(req.ChainId, req.Denom))
+
+	var entries []types.LedgerEntry
+	pageRes, err := query.Paginate(pfx, req.Pagination, func(_, value []byte) error {
+		var entry types.LedgerEntry
+		k.cdc.MustUnmarshal(value, &entry)
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolLedgerResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ---------------------------------------
+// Aggregate statistics
+// ---------------------------------------
+
+const (
+	defaultStatsTopN = 10
+	maxStatsTopN     = 100
+)
+
+func (k Keeper) EscrowStats(ctx context.Context, req *types.QueryEscrowStatsRequest) (*types.QueryEscrowStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.TopN > maxStatsTopN {
+		return nil, status.Errorf(codes.InvalidArgument, "top_n must not exceed %d", maxStatsTopN)
+	}
+	topN := int(req.TopN)
+	if topN == 0 {
+		topN = defaultStatsTopN
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tvl := k.totalValueLocked(sdkCtx)
+	denoms := []string{req.Denom}
+	if req.Denom == "" {
+		denoms = tvl.Denoms()
+	}
+	var top []types.ChainletBalance
+	for _, denom := range denoms {
+		top = append(top, k.topChainlets(sdkCtx, denom, topN)...)
+	}
+
+	return &types.QueryEscrowStatsResponse{
+		Tvl:           tvl,
+		ActivePools:   k.getCounter(sdkCtx, types.KeyActivePools),
+		ActiveFunders: k.getCounter(sdkCtx, types.KeyActiveFunders),
+		TopChainlets:  top,
+	}, nil
+}
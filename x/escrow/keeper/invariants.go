@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+// RegisterInvariants registers all escrow invariants.
+//
+//nolint:staticcheck // sdk.InvariantRegistry is deprecated but still used by the simulations
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "funder-shares", FunderSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "funder-index", FunderIndexInvariant(k))
+}
+
+// AllInvariants runs all escrow invariants.
+//
+//nolint:staticcheck // sdk.Invariant is deprecated but still used by the simulations
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			FunderSharesInvariant(k),
+			ModuleBalanceInvariant(k),
+			FunderIndexInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// FunderSharesInvariant checks that the shares of the funders of each pool add up to the pool shares.
+//
+//nolint:staticcheck // sdk.Invariant is deprecated but still used by the simulations
+func FunderSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		sums := make(map[string]math.LegacyDec)
+		it := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyFunderPrefix).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			chainID, denom, _, ok := parseFunderKey(it.Key())
+			if !ok {
+				continue
+			}
+			var f types.Funder
+			k.cdc.MustUnmarshal(it.Value(), &f)
+
+			key := string(types.PoolKey(chainID, denom))
+			if sum, ok := sums[key]; ok {
+				sums[key] = sum.Add(f.Shares)
+			} else {
+				sums[key] = f.Shares
+			}
+		}
+		it.Close()
+
+		for _, pool := range k.ExportPools(ctx) {
+			key := string(types.PoolKey(pool.ChainId, pool.Denom))
+			sum, ok := sums[key]
+			if !ok {
+				sum = math.LegacyZeroDec()
+			}
+			delete(sums, key)
+			if !sum.Equal(pool.Shares) {
+				broken++
+				msg += fmt.Sprintf("\tpool %s/%s has %s shares, funders hold %s\n", pool.ChainId, pool.Denom, pool.Shares, sum)
+			}
+		}
+		// funders of pools that no longer exist
+		for key, sum := range sums {
+			broken++
+			msg += fmt.Sprintf("\tfunders hold %s shares of missing pool %X\n", sum, key)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "funder-shares",
+			fmt.Sprintf("%d pools with mismatched funder shares found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// ModuleBalanceInvariant checks that the pool balances of each denom add up to the balance of the
+// escrow module account.
+//
+//nolint:staticcheck // sdk.Invariant is deprecated but still used by the simulations
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, pool := range k.ExportPools(ctx) {
+			expected = expected.Add(pool.Balance)
+		}
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+		broken := !expected.Equal(balance)
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("\tsum of pool balances: %s\n\tescrow module balance: %s\n", expected, balance),
+		), broken
+	}
+}
+
+// FunderIndexInvariant checks that every funder record has a reverse index entry and that
+// every reverse index entry points to a funder record.
+//
+//nolint:staticcheck // sdk.Invariant is deprecated but still used by the simulations
+func FunderIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		store := ctx.KVStore(k.storeKey)
+
+		it := prefix.NewStore(store, types.KeyFunderPrefix).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			chainID, denom, addr, ok := parseFunderKey(it.Key())
+			if !ok || !store.Has(types.ByFunderKey(addr, chainID, denom)) {
+				broken++
+				msg += fmt.Sprintf("\tfunder %q has no reverse index entry\n", it.Key())
+			}
+		}
+		it.Close()
+
+		it = prefix.NewStore(store, types.KeyByFunderPrefix).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			// key = "{addr}/{chainId}/{denom}", the denom may contain '/'
+			parts := bytes.SplitN(it.Key(), []byte{'/'}, 3)
+			if len(parts) != 3 || !store.Has(types.FunderKey(string(parts[1]), string(parts[2]), string(parts[0]))) {
+				broken++
+				msg += fmt.Sprintf("\treverse index entry %q has no funder\n", it.Key())
+			}
+		}
+		it.Close()
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "funder-index",
+			fmt.Sprintf("%d mismatched funder index entries found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// parseFunderKey splits a funder key "{chainId}/{denom}/{addr}". Neither chain IDs nor
+// addresses contain '/', so any further separators belong to the denom.
+func parseFunderKey(key []byte) (chainID, denom, addr string, ok bool) {
+	first := bytes.IndexByte(key, '/')
+	last := bytes.LastIndexByte(key, '/')
+	if first < 0 || first == last {
+		return "", "", "", false
+	}
+	return string(key[:first]), string(key[first+1 : last]), string(key[last+1:]), true
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestInvariants(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	k.bankKeeper = bk
+
+	chainID := "test_1-1"
+	denom := "utsaga"
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	alice := sdk.AccAddress("alice").String()
+	bob := sdk.AccAddress("bob").String()
+
+	moduleBalance := sdk.NewCoins(sdk.NewInt64Coin(denom, 150), sdk.NewInt64Coin(ibcDenom, 20))
+	bk.EXPECT().GetAllBalances(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName)).DoAndReturn(
+		func(_ any, _ sdk.AccAddress) sdk.Coins { return moduleBalance },
+	).AnyTimes()
+
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewInt64Coin(denom, 150),
+		Shares:  math.LegacyNewDec(150),
+	})
+	k.setFunder(ctx, chainID, denom, alice, types.Funder{Shares: math.LegacyNewDec(100)})
+	k.setFunder(ctx, chainID, denom, bob, types.Funder{Shares: math.LegacyNewDec(50)})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   ibcDenom,
+		Balance: sdk.NewInt64Coin(ibcDenom, 20),
+		Shares:  math.LegacyNewDec(20),
+	})
+	k.setFunder(ctx, chainID, ibcDenom, alice, types.Funder{Shares: math.LegacyNewDec(20)})
+
+	requireBroken := func(inv sdk.Invariant, broken bool) { //nolint:staticcheck
+		t.Helper()
+		msg, stop := inv(ctx)
+		require.Equal(t, broken, stop, msg)
+	}
+	requireBroken(AllInvariants(*k), false)
+
+	t.Run("funder shares", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		k.setFunder(cacheCtx, chainID, denom, bob, types.Funder{Shares: math.LegacyNewDec(49)})
+		msg, broken := FunderSharesInvariant(*k)(cacheCtx)
+		require.True(t, broken, msg)
+
+		// funders of a missing pool
+		cacheCtx, _ = ctx.CacheContext()
+		k.setFunder(cacheCtx, "other_2-1", denom, bob, types.Funder{Shares: math.LegacyNewDec(1)})
+		msg, broken = FunderSharesInvariant(*k)(cacheCtx)
+		require.True(t, broken, msg)
+	})
+
+	t.Run("module balance", func(t *testing.T) {
+		moduleBalance = sdk.NewCoins(sdk.NewInt64Coin(denom, 151), sdk.NewInt64Coin(ibcDenom, 20))
+		defer func() {
+			moduleBalance = sdk.NewCoins(sdk.NewInt64Coin(denom, 150), sdk.NewInt64Coin(ibcDenom, 20))
+		}()
+		requireBroken(ModuleBalanceInvariant(*k), true)
+	})
+
+	t.Run("funder index", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx.KVStore(k.storeKey).Delete(types.ByFunderKey(alice, chainID, ibcDenom))
+		msg, broken := FunderIndexInvariant(*k)(cacheCtx)
+		require.True(t, broken, msg)
+
+		cacheCtx, _ = ctx.CacheContext()
+		cacheCtx.KVStore(k.storeKey).Set(types.ByFunderKey(bob, chainID, ibcDenom), []byte{})
+		msg, broken = FunderIndexInvariant(*k)(cacheCtx)
+		require.True(t, broken, msg)
+	})
+
+	requireBroken(AllInvariants(*k), false)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k Keeper) nextLedgerSequence(ctx sdk.Context, chainID, denom string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LedgerSeqKey(chainID, denom))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextLedgerSequence(ctx sdk.Context, chainID, denom string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LedgerSeqKey(chainID, denom), binary.BigEndian.AppendUint64(nil, sequence))
+}
+
+// recordLedgerEntry appends a balance movement to the ledger of a pool, given the pool state
+// after the movement, and prunes the entries beyond the retention.
+func (k Keeper) recordLedgerEntry(ctx sdk.Context, entryType types.LedgerEntryType, counterparty string, amount sdk.Coin, pool types.DenomPool) {
+	retention := k.GetParams(ctx).LedgerRetention
+	if retention == 0 {
+		return
+	}
+
+	sharePrice := math.LegacyZeroDec()
+	if pool.Shares.IsPositive() {
+		sharePrice = math.LegacyNewDecFromInt(pool.Balance.Amount).Quo(pool.Shares)
+	}
+
+	sequence := k.nextLedgerSequence(ctx, pool.ChainId, pool.Denom)
+	entry := types.LedgerEntry{
+		ChainId:      pool.ChainId,
+		Denom:        pool.Denom,
+		Sequence:     sequence,
+		Type:         entryType,
+		Counterparty: counterparty,
+		Amount:       amount,
+		Balance:      pool.Balance,
+		SharePrice:   sharePrice,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+	}
+	ctx.KVStore(k.storeKey).Set(types.LedgerKey(pool.ChainId, pool.Denom, sequence), k.cdc.MustMarshal(&entry))
+	k.setNextLedgerSequence(ctx, pool.ChainId, pool.Denom, sequence+1)
+
+	if sequence+1 > retention {
+		k.pruneLedger(ctx, pool.ChainId, pool.Denom, sequence+1-retention)
+	}
+}
+
+// pruneLedger deletes the ledger entries of a pool older than the given sequence.
+func (k Keeper) pruneLedger(ctx sdk.Context, chainID, denom string, before uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect keys first to avoid iterator invalidation during deletion
+	var keys [][]byte
+	it := store.Iterator(types.LedgerPrefix(chainID, denom), types.LedgerKey(chainID, denom, before))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ExportLedgerEntries exports the ledger entries of all pools
+func (k Keeper) ExportLedgerEntries(ctx sdk.Context) []types.LedgerEntry {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.KeyLedgerPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var entries []types.LedgerEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.LedgerEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// ImportLedgerEntry imports a ledger entry during genesis, new entries of the pool follow it
+func (k Keeper) ImportLedgerEntry(ctx sdk.Context, entry types.LedgerEntry) {
+	ctx.KVStore(k.storeKey).Set(types.LedgerKey(entry.ChainId, entry.Denom, entry.Sequence), k.cdc.MustMarshal(&entry))
+	if entry.Sequence >= k.nextLedgerSequence(ctx, entry.ChainId, entry.Denom) {
+		k.setNextLedgerSequence(ctx, entry.ChainId, entry.Denom, entry.Sequence+1)
+	}
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestPoolLedger(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.bankKeeper = bk
+	k.billingKeeper = bik
+	k.chainletKeeper = ck
+	msgServer := NewMsgServerImpl(*k)
+
+	chainID := "test_1-1"
+	denom := "utsaga"
+	funder := sdk.AccAddress("funder")
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{{Denom: denom, EpochFee: "10" + denom}},
+	}, nil).AnyTimes()
+	ck.EXPECT().GetChainletInfo(gomock.Any(), chainID).Return(&chainlettypes.Chainlet{
+		ChainId:  chainID,
+		Launcher: funder.String(),
+	}, nil).AnyTimes()
+	bik.EXPECT().BillAndRestartChainlet(gomock.Any(), chainID).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), funder, types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, funder, gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "billing", gomock.Any()).Return(nil).AnyTimes()
+
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+
+	_, err := msgServer.Deposit(ctx, types.NewMsgDeposit(funder.String(), "100"+denom, chainID))
+	require.NoError(t, err)
+	require.NoError(t, k.BillAccount(ctx, sdk.NewInt64Coin(denom, 20), chainID, "billing"))
+	require.NoError(t, k.WithdrawPartial(ctx, funder, chainID, denom, math.NewInt(40), math.LegacyDec{}))
+	require.NoError(t, k.RefundChainlet(ctx, chainID))
+
+	res, err := k.PoolLedger(ctx, &types.QueryPoolLedgerRequest{ChainId: chainID, Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 4)
+
+	expected := []struct {
+		entryType    types.LedgerEntryType
+		counterparty string
+		amount       int64
+		balance      int64
+		sharePrice   string
+	}{
+		{types.LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT, funder.String(), 100, 100, "1"},
+		{types.LedgerEntryType_LEDGER_ENTRY_TYPE_BILL, "billing", 20, 80, "0.8"},
+		{types.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAW, funder.String(), 40, 40, "0.8"},
+		{types.LedgerEntryType_LEDGER_ENTRY_TYPE_REFUND, funder.String(), 40, 0, "0"},
+	}
+	for i, e := range expected {
+		entry := res.Entries[i]
+		require.Equal(t, uint64(i), entry.Sequence)
+		require.Equal(t, e.entryType, entry.Type)
+		require.Equal(t, e.counterparty, entry.Counterparty)
+		require.Equal(t, sdk.NewInt64Coin(denom, e.amount), entry.Amount)
+		require.Equal(t, sdk.NewInt64Coin(denom, e.balance), entry.Balance)
+		require.Equal(t, math.LegacyMustNewDecFromStr(e.sharePrice), entry.SharePrice)
+		require.Equal(t, int64(10), entry.Height)
+		require.Equal(t, ctx.BlockTime(), entry.Time)
+	}
+
+	// Paginated
+	res, err = k.PoolLedger(ctx, &types.QueryPoolLedgerRequest{
+		ChainId:    chainID,
+		Denom:      denom,
+		Pagination: &query.PageRequest{Limit: 3, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, uint64(3), res.Entries[0].Sequence)
+
+	// Only the last entries within the retention are kept
+	params := k.GetParams(ctx)
+	params.LedgerRetention = 3
+	k.SetParams(ctx, params)
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	_, err = msgServer.Deposit(ctx, types.NewMsgDeposit(funder.String(), "10"+denom, chainID))
+	require.NoError(t, err)
+
+	res, err = k.PoolLedger(ctx, &types.QueryPoolLedgerRequest{ChainId: chainID, Denom: denom})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, uint64(2), res.Entries[0].Sequence)
+	require.Equal(t, uint64(4), res.Entries[2].Sequence)
+
+	// The ledger is disabled without retention
+	params.LedgerRetention = 0
+	k.SetParams(ctx, params)
+	_, err = msgServer.Deposit(ctx, types.NewMsgDeposit(funder.String(), "10"+denom, chainID))
+	require.NoError(t, err)
+	require.Len(t, k.ExportLedgerEntries(ctx), 3)
+
+	// Genesis round trip keeps the sequence going
+	k2, ctx2 := testKeeper(t)
+	for _, entry := range k.ExportLedgerEntries(ctx) {
+		k2.ImportLedgerEntry(ctx2, entry)
+	}
+	require.Equal(t, k.ExportLedgerEntries(ctx), k2.ExportLedgerEntries(ctx2))
+	require.Equal(t, uint64(5), k2.nextLedgerSequence(ctx2, chainID, denom))
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestMigrate1to2Params(t *testing.T) {
+	k, ctx := testKeeper(t)
+
+	// Version 1 only stored the supported denoms
+	params := types.DefaultParams()
+	params.SupportedDenoms = []string{"ufoo"}
+	k.SetParams(ctx, params)
+	prefix.NewStore(ctx.KVStore(k.storeKey), []byte("EscrowParams/")).Delete([]byte("LedgerRetention"))
+	require.Zero(t, k.GetParams(ctx).LedgerRetention)
+
+	require.NoError(t, NewMigrator(*k).Migrate1to2(ctx))
+
+	params = k.GetParams(ctx)
+	require.Equal(t, types.DefaultLedgerRetention, params.LedgerRetention)
+	require.Equal(t, []string{"ufoo"}, params.SupportedDenoms)
+}
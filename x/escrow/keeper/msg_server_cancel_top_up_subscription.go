@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k msgServer) CancelTopUpSubscription(goCtx context.Context, msg *types.MsgCancelTopUpSubscription) (*types.MsgCancelTopUpSubscriptionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgCancelTopUpSubscriptionResponse{}, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgCancelTopUpSubscriptionResponse{}, err
+	}
+
+	err = k.CancelTopUp(ctx, addr, msg.ChainId, msg.Denom)
+	if err != nil {
+		return &types.MsgCancelTopUpSubscriptionResponse{}, err
+	}
+
+	return &types.MsgCancelTopUpSubscriptionResponse{}, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k msgServer) CreateTopUpSubscription(goCtx context.Context, msg *types.MsgCreateTopUpSubscription) (*types.MsgCreateTopUpSubscriptionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgCreateTopUpSubscriptionResponse{}, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgCreateTopUpSubscriptionResponse{}, err
+	}
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return &types.MsgCreateTopUpSubscriptionResponse{}, err
+	}
+	capAmount, err := sdk.ParseCoinNormalized(msg.Cap)
+	if err != nil {
+		return &types.MsgCreateTopUpSubscriptionResponse{}, err
+	}
+
+	err = k.CreateTopUp(ctx, addr, msg.ChainId, amount, msg.IntervalEpochs, capAmount)
+	if err != nil {
+		return &types.MsgCreateTopUpSubscriptionResponse{}, err
+	}
+
+	return &types.MsgCreateTopUpSubscriptionResponse{}, nil
+}
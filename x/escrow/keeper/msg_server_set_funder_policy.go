@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k msgServer) SetFunderPolicy(goCtx context.Context, msg *types.MsgSetFunderPolicy) (*types.MsgSetFunderPolicyResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgSetFunderPolicyResponse{}, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgSetFunderPolicyResponse{}, err
+	}
+	maxFunderShare, _ := msg.GetMaxFunderShareDec()
+
+	err = k.SetChainletFunderPolicy(ctx, addr, types.FunderPolicy{
+		ChainId:        msg.ChainId,
+		Mode:           msg.Mode,
+		Allowlist:      msg.Allowlist,
+		MaxFunderShare: maxFunderShare,
+	})
+	if err != nil {
+		return &types.MsgSetFunderPolicyResponse{}, err
+	}
+
+	return &types.MsgSetFunderPolicyResponse{}, nil
+}
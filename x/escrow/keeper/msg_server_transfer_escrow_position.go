@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k msgServer) TransferEscrowPosition(goCtx context.Context, msg *types.MsgTransferEscrowPosition) (*types.MsgTransferEscrowPositionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return &types.MsgTransferEscrowPositionResponse{}, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgTransferEscrowPositionResponse{}, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return &types.MsgTransferEscrowPositionResponse{}, err
+	}
+	shares, _ := msg.GetSharesDec()
+
+	err = k.TransferPosition(ctx, from, to, msg.ChainId, msg.Denom, shares)
+	if err != nil {
+		return &types.MsgTransferEscrowPositionResponse{}, err
+	}
+
+	return &types.MsgTransferEscrowPositionResponse{}, nil
+}
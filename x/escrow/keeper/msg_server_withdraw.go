@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	cosmossdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sagaxyz/ssc/x/escrow/types"
 )
@@ -14,17 +15,32 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	_ = ctx
-
 	addr, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return &types.MsgWithdrawResponse{}, err
 	}
+	amount, _ := msg.GetAmountInt()
+	fraction, _ := msg.GetFractionDec()
+
+	// A withdrawal may not leave the chainlet unable to pay its next epoch, unless the
+	// sender funds it alone
+	guarded := k.canPayNextEpoch(ctx, msg.ChainId) && !k.isSoleFunder(ctx, addr, msg.ChainId)
 
-	err = k.WithdrawAll(ctx, addr, msg.ChainId)
+	switch {
+	case msg.Denom == "":
+		err = k.WithdrawAll(ctx, addr, msg.ChainId)
+	case amount.IsNil() && fraction.IsNil():
+		err = k.WithdrawDenom(ctx, addr, msg.ChainId, msg.Denom)
+	default:
+		err = k.WithdrawPartial(ctx, addr, msg.ChainId, msg.Denom, amount, fraction)
+	}
 	if err != nil {
 		return &types.MsgWithdrawResponse{}, err
 	}
 
+	if guarded && !k.canPayNextEpoch(ctx, msg.ChainId) {
+		return &types.MsgWithdrawResponse{}, cosmossdkerrors.Wrapf(types.ErrInsufficientRunway, "withdrawal from chainlet %s", msg.ChainId)
+	}
+
 	return &types.MsgWithdrawResponse{}, nil
 }
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+// The aggregates are kept up to date by setPool, deletePool, setFunder and deleteFunder so that
+// the stats query does not have to scan the pools.
+
+func (k Keeper) getCounter(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) addCounter(ctx sdk.Context, key []byte, delta int) {
+	n := k.getCounter(ctx, key)
+	if delta < 0 && n < uint64(-delta) {
+		// should not happen, keep the counter usable
+		n = 0
+	} else {
+		n = uint64(int64(n) + int64(delta))
+	}
+	ctx.KVStore(k.storeKey).Set(key, binary.BigEndian.AppendUint64(nil, n))
+}
+
+func (k Keeper) getTVL(ctx sdk.Context, denom string) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TVLKey(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setTVL(ctx sdk.Context, denom string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amount.IsPositive() {
+		store.Delete(types.TVLKey(denom))
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.TVLKey(denom), bz)
+}
+
+func poolBalance(p types.DenomPool) math.Int {
+	if p.Balance.Amount.IsNil() {
+		return math.ZeroInt()
+	}
+	return p.Balance.Amount
+}
+
+// updatePoolStats moves the aggregates of a pool from its old to its new balance.
+func (k Keeper) updatePoolStats(ctx sdk.Context, chainID, denom string, oldBalance, newBalance math.Int) {
+	if oldBalance.Equal(newBalance) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+
+	k.setTVL(ctx, denom, k.getTVL(ctx, denom).Add(newBalance).Sub(oldBalance))
+
+	if oldBalance.IsPositive() {
+		store.Delete(types.BalanceRankKey(denom, oldBalance, chainID))
+	}
+	if newBalance.IsPositive() {
+		store.Set(types.BalanceRankKey(denom, newBalance, chainID), []byte{})
+	}
+
+	switch {
+	case !oldBalance.IsPositive() && newBalance.IsPositive():
+		k.addCounter(ctx, types.KeyActivePools, 1)
+	case oldBalance.IsPositive() && !newBalance.IsPositive():
+		k.addCounter(ctx, types.KeyActivePools, -1)
+	}
+}
+
+// hasPositions reports whether the address funds any pool.
+func (k Keeper) hasPositions(ctx sdk.Context, addr string) bool {
+	it := prefix.NewStore(ctx.KVStore(k.storeKey), types.ByFunderPrefix(addr)).Iterator(nil, nil)
+	defer it.Close()
+	return it.Valid()
+}
+
+// topChainlets returns the chainlets with the largest pools of a denom, largest first.
+func (k Keeper) topChainlets(ctx sdk.Context, denom string, n int) []types.ChainletBalance {
+	it := prefix.NewStore(ctx.KVStore(k.storeKey), types.BalanceRankPrefix(denom)).ReverseIterator(nil, nil)
+	defer it.Close()
+
+	var out []types.ChainletBalance
+	for ; it.Valid() && len(out) < n; it.Next() {
+		balance, chainID, ok := types.ParseBalanceRankKey(it.Key())
+		if !ok {
+			continue
+		}
+		out = append(out, types.ChainletBalance{
+			ChainId: chainID,
+			Balance: sdk.NewCoin(denom, balance),
+		})
+	}
+	return out
+}
+
+// totalValueLocked returns the balance of all pools per denom.
+func (k Keeper) totalValueLocked(ctx sdk.Context) sdk.Coins {
+	it := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTVLPrefix).Iterator(nil, nil)
+	defer it.Close()
+
+	tvl := sdk.NewCoins()
+	for ; it.Valid(); it.Next() {
+		tvl = tvl.Add(sdk.NewCoin(string(it.Key()), k.getTVL(ctx, string(it.Key()))))
+	}
+	return tvl
+}
+
+// rebuildStats recomputes the aggregates from the pools and funders.
+func (k Keeper) rebuildStats(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, pfx := range [][]byte{types.KeyTVLPrefix, types.KeyBalanceRankPrefix} {
+		var keys [][]byte
+		it := prefix.NewStore(store, pfx).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, append(bytes.Clone(pfx), it.Key()...))
+		}
+		it.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	store.Delete(types.KeyActivePools)
+	store.Delete(types.KeyActiveFunders)
+
+	for _, pool := range k.ExportPools(ctx) {
+		k.updatePoolStats(ctx, pool.ChainId, pool.Denom, math.ZeroInt(), poolBalance(pool))
+	}
+
+	funders := make(map[string]bool)
+	it := prefix.NewStore(store, types.KeyByFunderPrefix).Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		// key = "{addr}/{chainId}/{denom}"
+		addr, _, _ := bytes.Cut(it.Key(), []byte{'/'})
+		if !funders[string(addr)] {
+			funders[string(addr)] = true
+			k.addCounter(ctx, types.KeyActiveFunders, 1)
+		}
+	}
+	it.Close()
+}
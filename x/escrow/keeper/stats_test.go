@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestEscrowStats(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.bankKeeper = bk
+	k.billingKeeper = bik
+	k.chainletKeeper = ck
+	msgServer := NewMsgServerImpl(*k)
+
+	chainA, chainB, chainC := "a_1-1", "b_1-1", "c_1-1"
+	alice := sdk.AccAddress("alice")
+	bob := sdk.AccAddress("bob")
+
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), gomock.Any()).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{
+			{Denom: "utsaga", EpochFee: "10utsaga"},
+			{Denom: "utagas", EpochFee: "10utagas"},
+		},
+	}, nil).AnyTimes()
+	ck.EXPECT().GetChainletInfo(gomock.Any(), gomock.Any()).Return(&chainlettypes.Chainlet{}, nil).AnyTimes()
+	bik.EXPECT().BillAndRestartChainlet(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bk.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "billing", gomock.Any()).Return(nil).AnyTimes()
+
+	for _, chainID := range []string{chainA, chainB, chainC} {
+		k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	}
+	deposit := func(addr sdk.AccAddress, amount, chainID string) {
+		_, err := msgServer.Deposit(ctx, types.NewMsgDeposit(addr.String(), amount, chainID))
+		require.NoError(t, err)
+	}
+	stats := func(topN uint32, denom string) *types.QueryEscrowStatsResponse {
+		res, err := k.EscrowStats(ctx, &types.QueryEscrowStatsRequest{TopN: topN, Denom: denom})
+		require.NoError(t, err)
+		return res
+	}
+
+	deposit(alice, "100utsaga", chainA)
+	deposit(bob, "50utsaga", chainA)
+	deposit(alice, "300utsaga", chainB)
+	deposit(bob, "1000utagas", chainB)
+	deposit(bob, "20utsaga", chainC)
+
+	res := stats(0, "")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 470), sdk.NewInt64Coin("utagas", 1000)), res.Tvl)
+	require.Equal(t, uint64(4), res.ActivePools)
+	require.Equal(t, uint64(2), res.ActiveFunders)
+	require.Equal(t, []types.ChainletBalance{
+		{ChainId: chainB, Balance: sdk.NewInt64Coin("utagas", 1000)},
+		{ChainId: chainB, Balance: sdk.NewInt64Coin("utsaga", 300)},
+		{ChainId: chainA, Balance: sdk.NewInt64Coin("utsaga", 150)},
+		{ChainId: chainC, Balance: sdk.NewInt64Coin("utsaga", 20)},
+	}, res.TopChainlets)
+
+	res = stats(2, "utsaga")
+	require.Equal(t, []types.ChainletBalance{
+		{ChainId: chainB, Balance: sdk.NewInt64Coin("utsaga", 300)},
+		{ChainId: chainA, Balance: sdk.NewInt64Coin("utsaga", 150)},
+	}, res.TopChainlets)
+
+	_, err := k.EscrowStats(ctx, &types.QueryEscrowStatsRequest{TopN: 101})
+	require.Error(t, err)
+
+	// Bills, withdrawals and refunds move the aggregates
+	require.NoError(t, k.BillAccount(ctx, sdk.NewInt64Coin("utsaga", 250), chainB, "billing"))
+	require.NoError(t, k.WithdrawDenom(ctx, bob, chainB, "utagas"))
+	require.NoError(t, k.WithdrawPartial(ctx, alice, chainA, "utsaga", math.NewInt(40), math.LegacyDec{}))
+	require.NoError(t, k.RefundChainlet(ctx, chainC))
+
+	res = stats(0, "")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 160)), res.Tvl)
+	require.Equal(t, uint64(2), res.ActivePools)
+	require.Equal(t, uint64(2), res.ActiveFunders)
+	require.Equal(t, []types.ChainletBalance{
+		{ChainId: chainA, Balance: sdk.NewInt64Coin("utsaga", 110)},
+		{ChainId: chainB, Balance: sdk.NewInt64Coin("utsaga", 50)},
+	}, res.TopChainlets)
+
+	// Bob has no positions left once he leaves chainlet A
+	require.NoError(t, k.WithdrawAll(ctx, bob, chainA))
+	require.Equal(t, uint64(1), stats(0, "").ActiveFunders)
+
+	// The migration computes the same aggregates from scratch
+	before := stats(0, "")
+	ctx.KVStore(k.storeKey).Set(types.KeyActivePools, []byte{0, 0, 0, 0, 0, 0, 0, 9})
+	k.setTVL(ctx, "utagas", math.NewInt(7))
+	require.NoError(t, NewMigrator(*k).Migrate1to2(ctx))
+	require.Equal(t, before, stats(0, ""))
+}
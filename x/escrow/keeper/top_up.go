@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func (k Keeper) getTopUp(ctx sdk.Context, funder, chainID, denom string) (types.TopUpSubscription, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TopUpKey(chainID, funder, denom))
+	if bz == nil {
+		return types.TopUpSubscription{}, false
+	}
+	var sub types.TopUpSubscription
+	k.cdc.MustUnmarshal(bz, &sub)
+	return sub, true
+}
+
+func (k Keeper) setTopUp(ctx sdk.Context, sub types.TopUpSubscription) {
+	bz := k.cdc.MustMarshal(&sub)
+	ctx.KVStore(k.storeKey).Set(types.TopUpKey(sub.ChainId, sub.Funder, sub.Amount.Denom), bz)
+}
+
+func (k Keeper) deleteTopUp(ctx sdk.Context, funder, chainID, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.TopUpKey(chainID, funder, denom))
+}
+
+// deleteTopUps removes all top-up subscriptions of a chainlet.
+func (k Keeper) deleteTopUps(ctx sdk.Context, chainID string) {
+	pfx := prefix.NewStore(ctx.KVStore(k.storeKey), types.TopUpPrefix(chainID))
+	it := pfx.Iterator(nil, nil)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		pfx.Delete(key)
+	}
+}
+
+// CreateTopUp authorizes a recurring deposit of amount into the chainlet pool every interval
+// billing epochs, until capAmount has been deposited.
+func (k Keeper) CreateTopUp(ctx sdk.Context, addr sdk.AccAddress, chainID string, amount sdk.Coin, interval uint64, capAmount sdk.Coin) error {
+	if err := k.assertSupportedDenom(ctx, amount.Denom); err != nil {
+		return err
+	}
+	if _, ok := k.getChainlet(ctx, chainID); !ok {
+		return cosmossdkerrors.Wrapf(types.ErrChainletAccountNotFound, "chainlet %s not found", chainID)
+	}
+	if _, found := k.getTopUp(ctx, addr.String(), chainID, amount.Denom); found {
+		return cosmossdkerrors.Wrapf(types.ErrSubscriptionExists, "%s already tops up %s with %s", addr, chainID, amount.Denom)
+	}
+
+	k.setTopUp(ctx, types.TopUpSubscription{
+		Funder:         addr.String(),
+		ChainId:        chainID,
+		Amount:         amount,
+		IntervalEpochs: interval,
+		Cap:            capAmount,
+		Deposited:      sdk.NewCoin(amount.Denom, math.ZeroInt()),
+	})
+	return nil
+}
+
+// CancelTopUp removes a top-up subscription.
+func (k Keeper) CancelTopUp(ctx sdk.Context, addr sdk.AccAddress, chainID, denom string) error {
+	if _, found := k.getTopUp(ctx, addr.String(), chainID, denom); !found {
+		return cosmossdkerrors.Wrapf(types.ErrSubscriptionNotFound, "%s does not top up %s with %s", addr, chainID, denom)
+	}
+	k.deleteTopUp(ctx, addr.String(), chainID, denom)
+	return nil
+}
+
+// maxTopUpFailures is the number of consecutive failed top-ups after which a subscription is cancelled
+const maxTopUpFailures = 3
+
+// processTopUps advances all subscriptions by one billing epoch and deposits the ones that are
+// due. A failed top-up is retried at the next interval until it failed maxTopUpFailures times
+// in a row, a subscription that reached its cap is removed.
+func (k Keeper) processTopUps(ctx sdk.Context) {
+	subs := k.ExportTopUpSubscriptions(ctx)
+	for _, sub := range subs {
+		sub.ElapsedEpochs++
+		if sub.ElapsedEpochs < sub.IntervalEpochs {
+			k.setTopUp(ctx, sub)
+			continue
+		}
+		sub.ElapsedEpochs = 0
+
+		amount := sub.Amount
+		if remaining := sub.Cap.Sub(sub.Deposited); remaining.IsLT(amount) {
+			amount = remaining
+		}
+		err := k.topUp(ctx, sub, amount)
+		if err != nil {
+			sub.FailedAttempts++
+			cancelled := sub.FailedAttempts >= maxTopUpFailures
+			ctx.Logger().Info(fmt.Sprintf("top-up of %s from %s failed: %s", sub.ChainId, sub.Funder, err))
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventTopUpFailed{
+				Funder:    sub.Funder,
+				Chainlet:  sub.ChainId,
+				Amount:    amount.String(),
+				Reason:    err.Error(),
+				Cancelled: cancelled,
+			})
+			if cancelled {
+				k.deleteTopUp(ctx, sub.Funder, sub.ChainId, sub.Amount.Denom)
+				continue
+			}
+			k.setTopUp(ctx, sub)
+			continue
+		}
+
+		sub.FailedAttempts = 0
+		sub.Deposited = sub.Deposited.Add(amount)
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventTopUp{
+			Funder:    sub.Funder,
+			Chainlet:  sub.ChainId,
+			Amount:    amount.String(),
+			Deposited: sub.Deposited.String(),
+			Cap:       sub.Cap.String(),
+		})
+		if sub.Deposited.IsGTE(sub.Cap) {
+			k.deleteTopUp(ctx, sub.Funder, sub.ChainId, sub.Amount.Denom)
+			continue
+		}
+		k.setTopUp(ctx, sub)
+	}
+}
+
+// topUp deposits on behalf of the funder, leaving the state untouched if the deposit fails.
+func (k Keeper) topUp(ctx sdk.Context, sub types.TopUpSubscription, amount sdk.Coin) error {
+	addr, err := sdk.AccAddressFromBech32(sub.Funder)
+	if err != nil {
+		return err
+	}
+	cacheCtx, write := ctx.CacheContext()
+	err = k.deposit(cacheCtx, addr, sub.ChainId, amount)
+	if err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// ExportTopUpSubscriptions exports all top-up subscriptions from the store
+func (k Keeper) ExportTopUpSubscriptions(ctx sdk.Context) []types.TopUpSubscription {
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, types.KeyTopUpPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var subs []types.TopUpSubscription
+	for ; iterator.Valid(); iterator.Next() {
+		var sub types.TopUpSubscription
+		k.cdc.MustUnmarshal(iterator.Value(), &sub)
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// ImportTopUpSubscription imports a single top-up subscription into the store
+func (k Keeper) ImportTopUpSubscription(ctx sdk.Context, sub types.TopUpSubscription) {
+	k.setTopUp(ctx, sub)
+}
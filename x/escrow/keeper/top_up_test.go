@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+func TestTopUpSubscription(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.bankKeeper = bk
+	k.billingKeeper = bik
+	k.chainletKeeper = ck
+	msgServer := NewMsgServerImpl(*k)
+
+	chainID := "test_1-1"
+	denom := "utsaga"
+	funder := sdk.AccAddress("funder")
+
+	bik.EXPECT().GetBillingEpoch(gomock.Any()).Return("day").AnyTimes()
+	bik.EXPECT().BillAndRestartChainlet(gomock.Any(), chainID).Return(nil).AnyTimes()
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{{Denom: denom, EpochFee: "10" + denom}},
+	}, nil).AnyTimes()
+
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	k.setPool(ctx, types.DenomPool{
+		ChainId: chainID,
+		Denom:   denom,
+		Balance: sdk.NewCoin(denom, math.NewInt(100)),
+		Shares:  math.LegacyNewDec(100),
+	})
+	k.setFunder(ctx, chainID, denom, funder.String(), types.Funder{Shares: math.LegacyNewDec(100)})
+
+	// 40 every 2 epochs, at most 100
+	_, err := msgServer.CreateTopUpSubscription(ctx, types.NewMsgCreateTopUpSubscription(funder.String(), chainID, "40"+denom, 2, "100"+denom))
+	require.NoError(t, err)
+	_, err = msgServer.CreateTopUpSubscription(ctx, types.NewMsgCreateTopUpSubscription(funder.String(), chainID, "40"+denom, 2, "100"+denom))
+	require.ErrorIs(t, err, types.ErrSubscriptionExists)
+
+	balance := func() math.Int {
+		pool, _ := k.getPool(ctx, chainID, denom)
+		return pool.Balance.Amount
+	}
+	countEvents := func(eventType string) int {
+		var n int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Other epochs are ignored
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "hour", 1))
+	sub, found := k.getTopUp(ctx, funder.String(), chainID, denom)
+	require.True(t, found)
+	require.Equal(t, uint64(0), sub.ElapsedEpochs)
+
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 1))
+	require.Equal(t, math.NewInt(100), balance())
+
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), funder, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))).Return(nil)
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 2))
+	require.Equal(t, math.NewInt(140), balance())
+	require.Equal(t, 1, countEvents("ssc.escrow.EventTopUp"))
+
+	// A failed top-up is retried at the next interval
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), funder, types.ModuleName, gomock.Any()).Return(fmt.Errorf("insufficient funds"))
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 3))
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 4))
+	require.Equal(t, math.NewInt(140), balance())
+	require.Equal(t, 1, countEvents("ssc.escrow.EventTopUpFailed"))
+	sub, _ = k.getTopUp(ctx, funder.String(), chainID, denom)
+	require.Equal(t, uint64(1), sub.FailedAttempts)
+
+	// A successful top-up resets the failed attempts
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), funder, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))).Return(nil)
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 5))
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 6))
+	require.Equal(t, math.NewInt(180), balance())
+	sub, _ = k.getTopUp(ctx, funder.String(), chainID, denom)
+	require.Equal(t, uint64(0), sub.FailedAttempts)
+
+	// The last top-up is limited by the cap and ends the subscription
+	bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), funder, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))).Return(nil)
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 7))
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 8))
+	require.Equal(t, math.NewInt(200), balance())
+	require.Equal(t, 3, countEvents("ssc.escrow.EventTopUp"))
+	_, found = k.getTopUp(ctx, funder.String(), chainID, denom)
+	require.False(t, found)
+
+	// Cancelling
+	_, err = msgServer.CreateTopUpSubscription(ctx, types.NewMsgCreateTopUpSubscription(funder.String(), chainID, "40"+denom, 1, "100"+denom))
+	require.NoError(t, err)
+	_, err = msgServer.CancelTopUpSubscription(ctx, types.NewMsgCancelTopUpSubscription(funder.String(), chainID, denom))
+	require.NoError(t, err)
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", 9))
+	require.Equal(t, math.NewInt(200), balance())
+	_, err = msgServer.CancelTopUpSubscription(ctx, types.NewMsgCancelTopUpSubscription(funder.String(), chainID, denom))
+	require.ErrorIs(t, err, types.ErrSubscriptionNotFound)
+}
+
+func TestTopUpCancelledAfterFailures(t *testing.T) {
+	k, ctx := testKeeper(t)
+	ctrl := gomock.NewController(t)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k.billingKeeper = bik
+	k.chainletKeeper = ck
+
+	chainID := "test_1-1"
+	denom := "utsaga"
+	funder := sdk.AccAddress("funder")
+
+	bik.EXPECT().GetBillingEpoch(gomock.Any()).Return("day").AnyTimes()
+	k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+	require.NoError(t, k.CreateTopUp(ctx, funder, chainID, sdk.NewInt64Coin(denom, 40), 1, sdk.NewInt64Coin(denom, 100)))
+
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(nil, fmt.Errorf("stack not found")).Times(maxTopUpFailures)
+	for epoch := int64(1); epoch < maxTopUpFailures; epoch++ {
+		require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", epoch))
+		_, found := k.getTopUp(ctx, funder.String(), chainID, denom)
+		require.True(t, found)
+	}
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", maxTopUpFailures))
+	_, found := k.getTopUp(ctx, funder.String(), chainID, denom)
+	require.False(t, found)
+
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, "ssc.escrow.EventTopUpFailed", event.Type)
+	cancelled, ok := event.GetAttribute("cancelled")
+	require.True(t, ok)
+	require.Equal(t, "true", cancelled.Value)
+
+	// Nothing is attempted any more
+	require.NoError(t, k.Hooks().BeforeEpochStart(ctx, "day", maxTopUpFailures+1))
+}
+
+func TestRefundChainletDeletesTopUps(t *testing.T) {
+	k, ctx := testKeeper(t)
+	bk := escrowtestutil.NewMockBankKeeper(gomock.NewController(t))
+	k.bankKeeper = bk
+
+	denom := "utsaga"
+	funder1 := sdk.AccAddress("funder1")
+	funder2 := sdk.AccAddress("funder2")
+
+	// test_1-10 shares the test_1-1 prefix and keeps its subscriptions
+	for _, chainID := range []string{"test_1-1", "test_1-10"} {
+		k.setChainlet(ctx, types.ChainletAccount{ChainId: chainID})
+		require.NoError(t, k.CreateTopUp(ctx, funder1, chainID, sdk.NewInt64Coin(denom, 40), 1, sdk.NewInt64Coin(denom, 100)))
+		require.NoError(t, k.CreateTopUp(ctx, funder2, chainID, sdk.NewInt64Coin(denom, 10), 2, sdk.NewInt64Coin(denom, 100)))
+	}
+
+	require.NoError(t, k.RefundChainlet(ctx, "test_1-1"))
+	_, found := k.getChainlet(ctx, "test_1-1")
+	require.False(t, found)
+	subs := k.ExportTopUpSubscriptions(ctx)
+	require.Len(t, subs, 2)
+	for _, sub := range subs {
+		require.Equal(t, "test_1-10", sub.ChainId)
+	}
+}
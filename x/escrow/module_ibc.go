@@ -0,0 +1,196 @@
+package escrow
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/sagaxyz/ssc/x/escrow/keeper"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+// Memo is attached in ICS20 packet memo field to deposit the transferred tokens into the
+// escrow of a chainlet
+type Memo struct {
+	Escrow *DepositMemo `json:"escrow"`
+}
+
+type DepositMemo struct {
+	ChainId  string `json:"chain_id"`
+	Receiver string `json:"receiver"`
+}
+
+// IBCModule wraps the transfer stack and deposits incoming transfers carrying an escrow memo
+// into the chainlet pool on behalf of the receiver.
+type IBCModule struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+func NewIBCModule(app porttypes.IBCModule, k keeper.Keeper) IBCModule {
+	return IBCModule{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, channelVersion, modulePacket, relayer)
+	}
+
+	var memo Memo
+	if err := json.Unmarshal([]byte(data.GetMemo()), &memo); err != nil || memo.Escrow == nil {
+		return im.app.OnRecvPacket(ctx, channelVersion, modulePacket, relayer)
+	}
+	ctx.Logger().Debug(fmt.Sprintf("Got escrow deposit: %v", memo.Escrow))
+
+	receiver, err := sdk.AccAddressFromBech32(memo.Escrow.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(cosmossdkerrors.Wrapf(transfertypes.ErrInvalidMemo, "invalid escrow receiver (%s)", err))
+	}
+	if memo.Escrow.Receiver != data.Receiver {
+		return channeltypes.NewErrorAcknowledgement(cosmossdkerrors.Wrapf(transfertypes.ErrInvalidMemo, "escrow receiver %s does not match packet receiver %s", memo.Escrow.Receiver, data.Receiver))
+	}
+	if memo.Escrow.ChainId == "" {
+		return channeltypes.NewErrorAcknowledgement(cosmossdkerrors.Wrap(transfertypes.ErrInvalidMemo, "missing escrow chain_id"))
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(cosmossdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount))
+	}
+
+	denom := receivedDenom(modulePacket, data.Denom)
+	if !slices.Contains(im.keeper.GetSupportedDenoms(ctx), denom) {
+		return channeltypes.NewErrorAcknowledgement(cosmossdkerrors.Wrapf(types.ErrInvalidDenom, "unsupported denom %s", denom))
+	}
+
+	// Only commit the transfer together with the deposit
+	cacheCtx, write := ctx.CacheContext()
+	ack := im.app.OnRecvPacket(cacheCtx, channelVersion, modulePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	err = im.keeper.Deposit(cacheCtx, receiver, memo.Escrow.ChainId, sdk.NewCoin(denom, amount))
+	if err != nil {
+		ctx.Logger().Info(fmt.Sprintf("escrow deposit into %s failed: %s", memo.Escrow.ChainId, err))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	write()
+
+	return ack
+}
+
+// receivedDenom returns the denom of the tokens the transfer module credits for the packet.
+func receivedDenom(packet channeltypes.Packet, fullPath string) string {
+	denom := transfertypes.ExtractDenomFromPath(fullPath)
+	if denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		// tokens return to this chain
+		denom.Trace = denom.Trace[1:]
+	} else {
+		// vouchers are minted with the destination prefix
+		trace := []transfertypes.Hop{transfertypes.NewHop(packet.GetDestPort(), packet.GetDestChannel())}
+		denom.Trace = append(trace, denom.Trace...)
+	}
+	return denom.IBCDenom()
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, channelVersion, modulePacket, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, channelVersion, modulePacket, relayer)
+}
@@ -0,0 +1,222 @@
+package escrow_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/sagaxyz/ssc/testutil/sample"
+	chainlettypes "github.com/sagaxyz/ssc/x/chainlet/types"
+	"github.com/sagaxyz/ssc/x/escrow"
+	"github.com/sagaxyz/ssc/x/escrow/keeper"
+	escrowtestutil "github.com/sagaxyz/ssc/x/escrow/testutil"
+	"github.com/sagaxyz/ssc/x/escrow/types"
+)
+
+type mockTransferModule struct {
+	called    bool
+	returnAck ibcexported.Acknowledgement
+}
+
+func (m *mockTransferModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	m.called = true
+	if m.returnAck != nil {
+		return m.returnAck
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *mockTransferModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return "", nil
+}
+
+func (m *mockTransferModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", nil
+}
+
+func (m *mockTransferModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyChannelID,
+	counterpartyVersion string,
+) error {
+	return nil
+}
+
+func (m *mockTransferModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+func (m *mockTransferModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+func (m *mockTransferModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+func (m *mockTransferModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return nil
+}
+
+func (m *mockTransferModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return nil
+}
+
+func escrowKeeperWithMocks(t *testing.T, bk types.BankKeeper, billingKeeper types.BillingKeeper, chainletKeeper types.ChainletKeeper) (*keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsSubspace := typesparams.NewSubspace(cdc, types.Amino, storeKey, memStoreKey, "EscrowParams")
+
+	k := keeper.NewKeeper(cdc, storeKey, paramsSubspace, bk, billingKeeper, chainletKeeper, nil)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+	return k, ctx
+}
+
+func makeEscrowPacket(t *testing.T, denom, receiver, memo string) channeltypes.Packet {
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    denom,
+		Amount:   "100",
+		Sender:   "sender",
+		Receiver: receiver,
+		Memo:     memo,
+	}
+	bz, err := types.ModuleCdc.MarshalJSON(&data)
+	require.NoError(t, err)
+	return channeltypes.Packet{
+		Data:               bz,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-7",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+}
+
+func TestOnRecvPacketEscrowDeposit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	bk := escrowtestutil.NewMockBankKeeper(ctrl)
+	bik := escrowtestutil.NewMockBillingKeeper(ctrl)
+	ck := escrowtestutil.NewMockChainletKeeper(ctrl)
+	k, ctx := escrowKeeperWithMocks(t, bk, bik, ck)
+
+	chainID := "test_1-1"
+	receiver := sample.AccAddress()
+	receiverAddr := sdk.MustAccAddressFromBech32(receiver)
+	memo := `{"escrow":{"chain_id":"` + chainID + `","receiver":"` + receiver + `"}}`
+
+	// utsaga returning to this chain
+	require.NoError(t, k.SetChainletAccount(ctx, types.ChainletAccount{ChainId: chainID}))
+	ck.EXPECT().GetChainletStackInfo(gomock.Any(), chainID).Return(&chainlettypes.ChainletStack{
+		Fees: []chainlettypes.ChainletStackFees{{Denom: "utsaga", EpochFee: "10utsaga"}},
+	}, nil).AnyTimes()
+	bik.EXPECT().BillAndRestartChainlet(gomock.Any(), chainID).Return(nil).AnyTimes()
+
+	t.Run("no escrow memo", func(t *testing.T) {
+		mock := &mockTransferModule{}
+		mod := escrow.NewIBCModule(mock, *k)
+		ack := mod.OnRecvPacket(ctx, transfertypes.V1, makeEscrowPacket(t, "transfer/channel-7/utsaga", receiver, `{"forward":{}}`), nil)
+		require.True(t, mock.called)
+		require.True(t, ack.Success())
+	})
+
+	t.Run("deposit", func(t *testing.T) {
+		mock := &mockTransferModule{}
+		mod := escrow.NewIBCModule(mock, *k)
+		bk.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), receiverAddr, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("utsaga", 100))).Return(nil)
+		ack := mod.OnRecvPacket(ctx, transfertypes.V1, makeEscrowPacket(t, "transfer/channel-7/utsaga", receiver, memo), nil)
+		require.True(t, mock.called)
+		require.True(t, ack.Success())
+
+		_, pools, err := k.GetChainletWithPools(ctx, chainID)
+		require.NoError(t, err)
+		require.Len(t, pools, 1)
+		require.Equal(t, math.NewInt(100), pools[0].Balance.Amount)
+	})
+
+	t.Run("unsupported denom", func(t *testing.T) {
+		mock := &mockTransferModule{}
+		mod := escrow.NewIBCModule(mock, *k)
+		// uatom sent from its source chain arrives as a voucher
+		ack := mod.OnRecvPacket(ctx, transfertypes.V1, makeEscrowPacket(t, "uatom", receiver, memo), nil)
+		require.False(t, mock.called)
+		require.False(t, ack.Success())
+	})
+
+	t.Run("receiver mismatch", func(t *testing.T) {
+		mock := &mockTransferModule{}
+		mod := escrow.NewIBCModule(mock, *k)
+		ack := mod.OnRecvPacket(ctx, transfertypes.V1, makeEscrowPacket(t, "transfer/channel-7/utsaga", sample.AccAddress(), memo), nil)
+		require.False(t, mock.called)
+		require.False(t, ack.Success())
+	})
+
+	t.Run("failed deposit", func(t *testing.T) {
+		mock := &mockTransferModule{}
+		mod := escrow.NewIBCModule(mock, *k)
+		unknown := `{"escrow":{"chain_id":"unknown_1-1","receiver":"` + receiver + `"}}`
+		ck.EXPECT().GetChainletStackInfo(gomock.Any(), "unknown_1-1").Return(&chainlettypes.ChainletStack{
+			Fees: []chainlettypes.ChainletStackFees{{Denom: "utsaga", EpochFee: "10utsaga"}},
+		}, nil)
+		ack := mod.OnRecvPacket(ctx, transfertypes.V1, makeEscrowPacket(t, "transfer/channel-7/utsaga", receiver, unknown), nil)
+		require.True(t, mock.called)
+		require.False(t, ack.Success())
+	})
+}
@@ -29,6 +29,22 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgWithdraw int = 100
 
+	opWeightMsgTransferEscrowPosition = "op_weight_msg_transfer_escrow_position"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferEscrowPosition int = 100
+
+	opWeightMsgCreateTopUpSubscription = "op_weight_msg_create_top_up_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateTopUpSubscription int = 100
+
+	opWeightMsgCancelTopUpSubscription = "op_weight_msg_cancel_top_up_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelTopUpSubscription int = 100
+
+	opWeightMsgSetFunderPolicy = "op_weight_msg_set_funder_policy"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetFunderPolicy int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -76,6 +92,50 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		escrowsimulation.SimulateMsgWithdraw(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgTransferEscrowPosition int
+	simState.AppParams.GetOrGenerate(opWeightMsgTransferEscrowPosition, &weightMsgTransferEscrowPosition, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferEscrowPosition = defaultWeightMsgTransferEscrowPosition
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferEscrowPosition,
+		escrowsimulation.SimulateMsgTransferEscrowPosition(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCreateTopUpSubscription int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateTopUpSubscription, &weightMsgCreateTopUpSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateTopUpSubscription = defaultWeightMsgCreateTopUpSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateTopUpSubscription,
+		escrowsimulation.SimulateMsgCreateTopUpSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancelTopUpSubscription int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelTopUpSubscription, &weightMsgCancelTopUpSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelTopUpSubscription = defaultWeightMsgCancelTopUpSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelTopUpSubscription,
+		escrowsimulation.SimulateMsgCancelTopUpSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetFunderPolicy int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetFunderPolicy, &weightMsgSetFunderPolicy, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetFunderPolicy = defaultWeightMsgSetFunderPolicy
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetFunderPolicy,
+		escrowsimulation.SimulateMsgSetFunderPolicy(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -124,6 +124,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetAllBalances mocks base method.
+func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetAllBalances indicates an expected call of GetAllBalances.
+func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
+}
+
 // SendCoinsFromAccountToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -217,6 +231,20 @@ func (mr *MockBillingKeeperMockRecorder) BillAndRestartChainlet(ctx, chainId int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillAndRestartChainlet", reflect.TypeOf((*MockBillingKeeper)(nil).BillAndRestartChainlet), ctx, chainId)
 }
 
+// GetBillingEpoch mocks base method.
+func (m *MockBillingKeeper) GetBillingEpoch(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillingEpoch", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetBillingEpoch indicates an expected call of GetBillingEpoch.
+func (mr *MockBillingKeeperMockRecorder) GetBillingEpoch(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillingEpoch", reflect.TypeOf((*MockBillingKeeper)(nil).GetBillingEpoch), ctx)
+}
+
 // MockChainletKeeper is a mock of ChainletKeeper interface.
 type MockChainletKeeper struct {
 	ctrl     *gomock.Controller
@@ -240,6 +268,21 @@ func (m *MockChainletKeeper) EXPECT() *MockChainletKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetChainletInfo mocks base method.
+func (m *MockChainletKeeper) GetChainletInfo(ctx types.Context, chainId string) (*types0.Chainlet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainletInfo", ctx, chainId)
+	ret0, _ := ret[0].(*types0.Chainlet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChainletInfo indicates an expected call of GetChainletInfo.
+func (mr *MockChainletKeeperMockRecorder) GetChainletInfo(ctx, chainId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainletInfo", reflect.TypeOf((*MockChainletKeeper)(nil).GetChainletInfo), ctx, chainId)
+}
+
 // GetChainletStackInfo mocks base method.
 func (m *MockChainletKeeper) GetChainletStackInfo(ctx types.Context, chainId string) (*types0.ChainletStack, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,10 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "escrow/Deposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "escrow/Withdraw", nil)
+	cdc.RegisterConcrete(&MsgTransferEscrowPosition{}, "escrow/TransferEscrowPosition", nil)
+	cdc.RegisterConcrete(&MsgCreateTopUpSubscription{}, "escrow/CreateTopUpSubscription", nil)
+	cdc.RegisterConcrete(&MsgCancelTopUpSubscription{}, "escrow/CancelTopUpSubscription", nil)
+	cdc.RegisterConcrete(&MsgSetFunderPolicy{}, "escrow/SetFunderPolicy", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -20,6 +24,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWithdraw{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferEscrowPosition{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateTopUpSubscription{},
+		&MsgCancelTopUpSubscription{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFunderPolicy{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
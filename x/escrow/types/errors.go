@@ -16,4 +16,9 @@ var (
 	ErrChainletAccountNotFound = cosmossdkerrors.Register(ModuleName, 5704, "Chainlet account not found.")
 	ErrUnauthorized            = cosmossdkerrors.Register(ModuleName, 5705, "Unauthorized action.")
 	ErrInvalidParams           = cosmossdkerrors.Register(ModuleName, 5706, "Invalid parameters.")
+	ErrInsufficientRunway      = cosmossdkerrors.Register(ModuleName, 5707, "Chainlet would be unable to pay its next epoch.")
+	ErrSubscriptionExists      = cosmossdkerrors.Register(ModuleName, 5708, "Top-up subscription already exists.")
+	ErrSubscriptionNotFound    = cosmossdkerrors.Register(ModuleName, 5709, "Top-up subscription not found.")
+	ErrFunderNotAllowed        = cosmossdkerrors.Register(ModuleName, 5710, "Funder not allowed by the chainlet launcher.")
+	ErrFunderShareCap          = cosmossdkerrors.Register(ModuleName, 5711, "Funder share of the pool exceeds the cap.")
 )
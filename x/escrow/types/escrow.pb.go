@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +30,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type FunderMode int32
+
+const (
+	// Anyone can fund the chainlet
+	FunderMode_FUNDER_MODE_OPEN FunderMode = 0
+	// Only the launcher and the allowlisted addresses can fund the chainlet
+	FunderMode_FUNDER_MODE_ALLOWLIST FunderMode = 1
+	// Only the launcher can fund the chainlet
+	FunderMode_FUNDER_MODE_LAUNCHER_ONLY FunderMode = 2
+)
+
+var FunderMode_name = map[int32]string{
+	0: "FUNDER_MODE_OPEN",
+	1: "FUNDER_MODE_ALLOWLIST",
+	2: "FUNDER_MODE_LAUNCHER_ONLY",
+}
+
+var FunderMode_value = map[string]int32{
+	"FUNDER_MODE_OPEN":          0,
+	"FUNDER_MODE_ALLOWLIST":     1,
+	"FUNDER_MODE_LAUNCHER_ONLY": 2,
+}
+
+func (x FunderMode) String() string {
+	return proto.EnumName(FunderMode_name, int32(x))
+}
+
+func (FunderMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bc2843f63262f3b7, []int{0}
+}
+
+// Kind of balance movement recorded in the pool ledger
+type LedgerEntryType int32
+
+const (
+	LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED LedgerEntryType = 0
+	LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT     LedgerEntryType = 1
+	LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAW    LedgerEntryType = 2
+	LedgerEntryType_LEDGER_ENTRY_TYPE_BILL        LedgerEntryType = 3
+	LedgerEntryType_LEDGER_ENTRY_TYPE_REFUND      LedgerEntryType = 4
+	// Unused part of a billed epoch fee returned by the billing module
+	LedgerEntryType_LEDGER_ENTRY_TYPE_BILLING_REFUND LedgerEntryType = 5
+)
+
+var LedgerEntryType_name = map[int32]string{
+	0: "LEDGER_ENTRY_TYPE_UNSPECIFIED",
+	1: "LEDGER_ENTRY_TYPE_DEPOSIT",
+	2: "LEDGER_ENTRY_TYPE_WITHDRAW",
+	3: "LEDGER_ENTRY_TYPE_BILL",
+	4: "LEDGER_ENTRY_TYPE_REFUND",
+	5: "LEDGER_ENTRY_TYPE_BILLING_REFUND",
+}
+
+var LedgerEntryType_value = map[string]int32{
+	"LEDGER_ENTRY_TYPE_UNSPECIFIED":    0,
+	"LEDGER_ENTRY_TYPE_DEPOSIT":        1,
+	"LEDGER_ENTRY_TYPE_WITHDRAW":       2,
+	"LEDGER_ENTRY_TYPE_BILL":           3,
+	"LEDGER_ENTRY_TYPE_REFUND":         4,
+	"LEDGER_ENTRY_TYPE_BILLING_REFUND": 5,
+}
+
+func (x LedgerEntryType) String() string {
+	return proto.EnumName(LedgerEntryType_name, int32(x))
+}
+
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bc2843f63262f3b7, []int{1}
+}
+
 type ChainletAccount struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 }
@@ -172,38 +246,365 @@ func (m *DenomPool) GetBalance() types.Coin {
 	return types.Coin{}
 }
 
+// Recurring deposit of a funder into a chainlet pool.
+// KV: escrow/topUp/{chainId}/{funder}/{denom}
+type TopUpSubscription struct {
+	Funder  string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// Amount deposited every interval
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// Number of billing epochs between top-ups
+	IntervalEpochs uint64 `protobuf:"varint,4,opt,name=interval_epochs,json=intervalEpochs,proto3" json:"interval_epochs,omitempty"`
+	// Total amount the subscription may deposit
+	Cap types.Coin `protobuf:"bytes,5,opt,name=cap,proto3" json:"cap"`
+	// Total amount deposited so far
+	Deposited types.Coin `protobuf:"bytes,6,opt,name=deposited,proto3" json:"deposited"`
+	// Billing epochs since the last top-up attempt
+	ElapsedEpochs uint64 `protobuf:"varint,7,opt,name=elapsed_epochs,json=elapsedEpochs,proto3" json:"elapsed_epochs,omitempty"`
+	// Consecutive failed top-up attempts
+	FailedAttempts uint64 `protobuf:"varint,8,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+}
+
+func (m *TopUpSubscription) Reset()         { *m = TopUpSubscription{} }
+func (m *TopUpSubscription) String() string { return proto.CompactTextString(m) }
+func (*TopUpSubscription) ProtoMessage()    {}
+func (*TopUpSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2843f63262f3b7, []int{3}
+}
+func (m *TopUpSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopUpSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopUpSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopUpSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpSubscription.Merge(m, src)
+}
+func (m *TopUpSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopUpSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopUpSubscription proto.InternalMessageInfo
+
+func (m *TopUpSubscription) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *TopUpSubscription) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *TopUpSubscription) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *TopUpSubscription) GetIntervalEpochs() uint64 {
+	if m != nil {
+		return m.IntervalEpochs
+	}
+	return 0
+}
+
+func (m *TopUpSubscription) GetCap() types.Coin {
+	if m != nil {
+		return m.Cap
+	}
+	return types.Coin{}
+}
+
+func (m *TopUpSubscription) GetDeposited() types.Coin {
+	if m != nil {
+		return m.Deposited
+	}
+	return types.Coin{}
+}
+
+func (m *TopUpSubscription) GetElapsedEpochs() uint64 {
+	if m != nil {
+		return m.ElapsedEpochs
+	}
+	return 0
+}
+
+func (m *TopUpSubscription) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+// Who may fund the pools of a chainlet, set by its launcher.
+// KV: escrow/funderPolicy/{chainId}
+type FunderPolicy struct {
+	ChainId   string     `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Mode      FunderMode `protobuf:"varint,2,opt,name=mode,proto3,enum=ssc.escrow.FunderMode" json:"mode,omitempty"`
+	Allowlist []string   `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// Maximum fraction of the shares of a pool a single funder other than the
+	// launcher may hold, no cap if zero. The first deposit into a pool without
+	// shares is not capped.
+	MaxFunderShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_funder_share,json=maxFunderShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_funder_share"`
+}
+
+func (m *FunderPolicy) Reset()         { *m = FunderPolicy{} }
+func (m *FunderPolicy) String() string { return proto.CompactTextString(m) }
+func (*FunderPolicy) ProtoMessage()    {}
+func (*FunderPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2843f63262f3b7, []int{4}
+}
+func (m *FunderPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderPolicy.Merge(m, src)
+}
+func (m *FunderPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderPolicy proto.InternalMessageInfo
+
+func (m *FunderPolicy) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *FunderPolicy) GetMode() FunderMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FunderMode_FUNDER_MODE_OPEN
+}
+
+func (m *FunderPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+// One balance movement of a pool. Only the last ledgerRetention entries of
+// each pool are kept.
+// KV: escrow/ledger/{chainId}/{denom}/{sequence}
+type LedgerEntry struct {
+	ChainId  string          `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Denom    string          `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Sequence uint64          `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     LedgerEntryType `protobuf:"varint,4,opt,name=type,proto3,enum=ssc.escrow.LedgerEntryType" json:"type,omitempty"`
+	// Funder for deposits, withdrawals and refunds, receiving module for bills
+	Counterparty string     `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Amount       types.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	// Pool balance after the movement
+	Balance types.Coin `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance"`
+	// Tokens per share after the movement, zero if the pool has no shares
+	SharePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=share_price,json=sharePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_price"`
+	Height     int64                       `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Time       time.Time                   `protobuf:"bytes,10,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2843f63262f3b7, []int{5}
+}
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
+}
+func (m *LedgerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
+
+func (m *LedgerEntry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetType() LedgerEntryType {
+	if m != nil {
+		return m.Type
+	}
+	return LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (m *LedgerEntry) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *LedgerEntry) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *LedgerEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("ssc.escrow.FunderMode", FunderMode_name, FunderMode_value)
+	proto.RegisterEnum("ssc.escrow.LedgerEntryType", LedgerEntryType_name, LedgerEntryType_value)
 	proto.RegisterType((*ChainletAccount)(nil), "ssc.escrow.ChainletAccount")
 	proto.RegisterType((*Funder)(nil), "ssc.escrow.Funder")
 	proto.RegisterType((*DenomPool)(nil), "ssc.escrow.DenomPool")
+	proto.RegisterType((*TopUpSubscription)(nil), "ssc.escrow.TopUpSubscription")
+	proto.RegisterType((*FunderPolicy)(nil), "ssc.escrow.FunderPolicy")
+	proto.RegisterType((*LedgerEntry)(nil), "ssc.escrow.LedgerEntry")
 }
 
 func init() { proto.RegisterFile("ssc/escrow/escrow.proto", fileDescriptor_bc2843f63262f3b7) }
 
 var fileDescriptor_bc2843f63262f3b7 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xc1, 0x4a, 0x03, 0x31,
-	0x10, 0xdd, 0x68, 0x6d, 0x69, 0x3c, 0x08, 0x4b, 0xc1, 0x6d, 0x85, 0xb4, 0x14, 0x84, 0x82, 0x98,
-	0x50, 0x3d, 0x79, 0x12, 0xdb, 0x22, 0x14, 0x3c, 0x48, 0xbd, 0x79, 0x91, 0x6c, 0x36, 0xec, 0x2e,
-	0x76, 0x33, 0x65, 0x93, 0x6a, 0xeb, 0x57, 0xf8, 0x31, 0xfe, 0x80, 0xb7, 0x1e, 0x8b, 0x27, 0xf1,
-	0x50, 0xa4, 0xfd, 0x11, 0xd9, 0x66, 0x17, 0xbc, 0x78, 0xf2, 0x94, 0xcc, 0x7b, 0x2f, 0xf3, 0x32,
-	0xf3, 0xf0, 0xa1, 0xd6, 0x82, 0x49, 0x2d, 0x52, 0x78, 0xce, 0x0f, 0x3a, 0x49, 0xc1, 0x80, 0x8b,
-	0xb5, 0x16, 0xd4, 0x22, 0x8d, 0x5a, 0x08, 0x21, 0x6c, 0x61, 0x96, 0xdd, 0xac, 0xa2, 0x41, 0x04,
-	0xe8, 0x04, 0x34, 0xf3, 0xb9, 0x96, 0xec, 0xa9, 0xeb, 0x4b, 0xc3, 0xbb, 0x4c, 0x40, 0xac, 0x72,
-	0xbe, 0x6e, 0xf9, 0x07, 0xfb, 0xd0, 0x16, 0x96, 0x6a, 0x9f, 0xe0, 0x83, 0x7e, 0xc4, 0x63, 0x35,
-	0x96, 0xe6, 0x4a, 0x08, 0x98, 0x2a, 0xe3, 0x7a, 0xb8, 0x22, 0x32, 0x68, 0x18, 0x78, 0xa8, 0x85,
-	0x3a, 0xd5, 0x51, 0x51, 0xb6, 0xef, 0x70, 0xf9, 0x7a, 0xaa, 0x02, 0x99, 0xba, 0x43, 0x5c, 0xd6,
-	0x11, 0x4f, 0xa5, 0xb6, 0x92, 0x5e, 0x77, 0xb1, 0x6a, 0x3a, 0x5f, 0xab, 0xe6, 0x91, 0x6d, 0xae,
-	0x83, 0x47, 0x1a, 0x03, 0x4b, 0xb8, 0x89, 0xe8, 0x8d, 0x0c, 0xb9, 0x98, 0x0f, 0xa4, 0xf8, 0x78,
-	0x3b, 0xc5, 0xb9, 0xf7, 0x40, 0x8a, 0x51, 0xde, 0xa0, 0xfd, 0x8e, 0x70, 0x75, 0x20, 0x15, 0x24,
-	0xb7, 0x00, 0xe3, 0xbf, 0xcd, 0xdd, 0x1a, 0xde, 0x0b, 0x32, 0x99, 0xb7, 0xb3, 0xc5, 0x6d, 0xe1,
-	0x5e, 0xe0, 0x8a, 0xcf, 0xc7, 0x5c, 0x09, 0xe9, 0xed, 0xb6, 0x50, 0x67, 0xff, 0xac, 0x4e, 0x73,
-	0x8f, 0x6c, 0x19, 0x34, 0x5f, 0x06, 0xed, 0x43, 0xac, 0x7a, 0xa5, 0xec, 0x93, 0xa3, 0x42, 0xff,
-	0x6b, 0x86, 0xd2, 0x3f, 0x67, 0xe8, 0x5d, 0x2e, 0xd6, 0x04, 0x2d, 0xd7, 0x04, 0x7d, 0xaf, 0x09,
-	0x7a, 0xdd, 0x10, 0x67, 0xb9, 0x21, 0xce, 0xe7, 0x86, 0x38, 0xf7, 0xc7, 0x61, 0x6c, 0xa2, 0xa9,
-	0x4f, 0x05, 0x24, 0x4c, 0xf3, 0x90, 0xcf, 0xe6, 0x2f, 0x2c, 0x0b, 0x7a, 0x56, 0x44, 0x6d, 0xe6,
-	0x13, 0xa9, 0xfd, 0xf2, 0x36, 0x8d, 0xf3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x88, 0x77,
-	0x15, 0x05, 0x02, 0x00, 0x00,
+	// 879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x34, 0x6d, 0x5e, 0x97, 0x34, 0x8c, 0x4a, 0x71, 0xb3, 0xbb, 0x69, 0x89, 0x58,
+	0x6d, 0x55, 0x84, 0xad, 0x96, 0x03, 0x70, 0x40, 0x28, 0x69, 0xdc, 0xdd, 0x48, 0xde, 0x24, 0x72,
+	0x52, 0x55, 0x05, 0x24, 0x6b, 0x32, 0x9e, 0x26, 0x16, 0xb6, 0xc7, 0x78, 0x26, 0xbb, 0x0d, 0x9f,
+	0x62, 0x3f, 0x0c, 0x5f, 0x80, 0x5b, 0x6f, 0xac, 0xe0, 0x82, 0x38, 0x2c, 0xa8, 0x3d, 0xf1, 0x2d,
+	0xd0, 0x78, 0x1c, 0x9a, 0xa5, 0x80, 0xda, 0xee, 0x29, 0x79, 0xbf, 0xf7, 0x9b, 0xf7, 0xff, 0x3d,
+	0xc3, 0xfb, 0x9c, 0x13, 0x93, 0x72, 0x92, 0xb0, 0x17, 0xd9, 0x8f, 0x11, 0x27, 0x4c, 0x30, 0x04,
+	0x9c, 0x13, 0x43, 0x21, 0xb5, 0xf5, 0x31, 0x1b, 0xb3, 0x14, 0x36, 0xe5, 0x3f, 0xc5, 0xa8, 0xd5,
+	0x09, 0xe3, 0x21, 0xe3, 0xe6, 0x08, 0x73, 0x6a, 0x3e, 0xdf, 0x1b, 0x51, 0x81, 0xf7, 0x4c, 0xc2,
+	0xfc, 0x28, 0xd3, 0x6f, 0x2a, 0xbd, 0xab, 0x1e, 0x2a, 0x21, 0x53, 0x6d, 0x8d, 0x19, 0x1b, 0x07,
+	0xd4, 0x4c, 0xa5, 0xd1, 0xf4, 0xd4, 0x14, 0x7e, 0x48, 0xb9, 0xc0, 0x61, 0xac, 0x08, 0x8d, 0x8f,
+	0x60, 0xed, 0x60, 0x82, 0xfd, 0x28, 0xa0, 0xa2, 0x49, 0x08, 0x9b, 0x46, 0x02, 0xe9, 0xb0, 0x4c,
+	0x24, 0xd4, 0xf1, 0x74, 0x6d, 0x5b, 0xdb, 0x29, 0x3b, 0x73, 0xb1, 0x31, 0x80, 0xd2, 0xe1, 0x34,
+	0xf2, 0x68, 0x82, 0x3a, 0x50, 0xe2, 0x13, 0x9c, 0x50, 0xae, 0x28, 0xad, 0xbd, 0xf3, 0xd7, 0x5b,
+	0xb9, 0xdf, 0x5e, 0x6f, 0xdd, 0x57, 0xde, 0xb9, 0xf7, 0xad, 0xe1, 0x33, 0x33, 0xc4, 0x62, 0x62,
+	0xd8, 0x74, 0x8c, 0xc9, 0xac, 0x4d, 0xc9, 0xcf, 0x3f, 0x7c, 0x0c, 0x59, 0x70, 0x6d, 0x4a, 0x9c,
+	0xcc, 0x40, 0xe3, 0x47, 0x0d, 0xca, 0x6d, 0x1a, 0xb1, 0xb0, 0xcf, 0x58, 0xf0, 0xdf, 0xce, 0xd1,
+	0x3a, 0x2c, 0x79, 0x92, 0xa6, 0xe7, 0x53, 0x5c, 0x09, 0xe8, 0x73, 0x58, 0x1e, 0xe1, 0x00, 0x47,
+	0x84, 0xea, 0x85, 0x6d, 0x6d, 0x67, 0x75, 0x7f, 0xd3, 0xc8, 0x7c, 0xc8, 0x6a, 0x19, 0x59, 0xb5,
+	0x8c, 0x03, 0xe6, 0x47, 0xad, 0xa2, 0x0c, 0xd2, 0x99, 0xf3, 0x17, 0x72, 0x28, 0xbe, 0x6d, 0x0e,
+	0x7f, 0xe6, 0xe1, 0xdd, 0x21, 0x8b, 0x8f, 0xe2, 0xc1, 0x74, 0xc4, 0x49, 0xe2, 0xc7, 0xc2, 0x67,
+	0x11, 0xda, 0x80, 0xd2, 0x69, 0x5a, 0xae, 0x2c, 0x95, 0x4c, 0x5a, 0xcc, 0x31, 0xff, 0x66, 0x8e,
+	0x9f, 0x42, 0x09, 0x87, 0xb2, 0x09, 0x37, 0x4d, 0x26, 0xa3, 0xa3, 0xc7, 0xb0, 0xe6, 0x47, 0x82,
+	0x26, 0xcf, 0x71, 0xe0, 0xd2, 0x98, 0x91, 0x89, 0x4a, 0xaa, 0xe8, 0x54, 0xe6, 0xb0, 0x95, 0xa2,
+	0x68, 0x0f, 0x0a, 0x04, 0xc7, 0xfa, 0xd2, 0xcd, 0xcc, 0x4b, 0x2e, 0xfa, 0x02, 0xca, 0x1e, 0x8d,
+	0x19, 0xf7, 0x05, 0xf5, 0xf4, 0xd2, 0xcd, 0x1e, 0x5e, 0xbd, 0x40, 0x8f, 0xa0, 0x42, 0x03, 0x1c,
+	0x73, 0xea, 0xcd, 0x23, 0x5b, 0x4e, 0x23, 0x7b, 0x27, 0x43, 0xb3, 0xc0, 0x1e, 0xc3, 0xda, 0x29,
+	0xf6, 0x03, 0xea, 0xb9, 0x58, 0x08, 0x1a, 0xc6, 0x82, 0xeb, 0x2b, 0x2a, 0x03, 0x05, 0x37, 0x33,
+	0xb4, 0xf1, 0x93, 0x06, 0xf7, 0xd4, 0x14, 0xf6, 0x59, 0xe0, 0x93, 0xd9, 0xff, 0x8c, 0xcc, 0x2e,
+	0x14, 0x43, 0xe6, 0xd1, 0xb4, 0xca, 0x95, 0xfd, 0x0d, 0xe3, 0x6a, 0xd3, 0x0c, 0x65, 0xe1, 0x19,
+	0xf3, 0xa8, 0x93, 0x72, 0xd0, 0x03, 0x28, 0xe3, 0x20, 0x60, 0x2f, 0x02, 0x9f, 0xcb, 0xea, 0x17,
+	0x76, 0xca, 0xce, 0x15, 0x80, 0xbe, 0x86, 0x6a, 0x88, 0xcf, 0x5c, 0xd5, 0x40, 0x37, 0xed, 0xfa,
+	0xdd, 0xa7, 0xa6, 0x12, 0xe2, 0x33, 0xe5, 0x7f, 0x20, 0x0d, 0x35, 0xce, 0x0b, 0xb0, 0x6a, 0x53,
+	0x6f, 0x4c, 0x13, 0x2b, 0x12, 0xc9, 0xec, 0xd6, 0x3b, 0x50, 0x83, 0x15, 0x4e, 0xbf, 0x9b, 0xd2,
+	0xf9, 0x12, 0x14, 0x9d, 0xbf, 0x65, 0x64, 0x42, 0x51, 0xcc, 0x62, 0x15, 0x6c, 0x65, 0xff, 0xfe,
+	0x62, 0x09, 0x16, 0x5c, 0x0e, 0x67, 0x31, 0x75, 0x52, 0x22, 0x6a, 0xc0, 0xbd, 0xf4, 0x0c, 0xd0,
+	0x24, 0xc6, 0x89, 0x98, 0xa5, 0x93, 0x52, 0x76, 0xde, 0xc0, 0x16, 0xc6, 0xb4, 0x74, 0xbb, 0x31,
+	0x5d, 0xd8, 0xd6, 0xe5, 0x5b, 0x6e, 0xab, 0x03, 0xab, 0x69, 0xd9, 0xdd, 0x38, 0xf1, 0x09, 0xd5,
+	0x57, 0xee, 0x5a, 0x7c, 0x48, 0xad, 0xf4, 0xa5, 0x11, 0xb9, 0xa0, 0x13, 0xea, 0x8f, 0x27, 0x42,
+	0x2f, 0x6f, 0x6b, 0x3b, 0x05, 0x27, 0x93, 0xd0, 0x67, 0x50, 0x94, 0x77, 0x52, 0x87, 0x34, 0xc6,
+	0x9a, 0xa1, 0x8e, 0xa8, 0x31, 0x3f, 0xa2, 0xc6, 0x70, 0x7e, 0x44, 0x5b, 0x2b, 0x32, 0x80, 0x97,
+	0xbf, 0x6f, 0x69, 0x4e, 0xfa, 0x62, 0xf7, 0x1b, 0x80, 0xab, 0xc9, 0x42, 0xeb, 0x50, 0x3d, 0x3c,
+	0xea, 0xb6, 0x2d, 0xc7, 0x7d, 0xd6, 0x6b, 0x5b, 0x6e, 0xaf, 0x6f, 0x75, 0xab, 0x39, 0xb4, 0x09,
+	0xef, 0x2d, 0xa2, 0x4d, 0xdb, 0xee, 0x1d, 0xdb, 0x9d, 0xc1, 0xb0, 0xaa, 0xa1, 0x87, 0xb0, 0xb9,
+	0xa8, 0xb2, 0x9b, 0x47, 0xdd, 0x83, 0xa7, 0x96, 0xe3, 0xf6, 0xba, 0xf6, 0x49, 0x35, 0xbf, 0xfb,
+	0x8b, 0x06, 0x6b, 0xff, 0xe8, 0x1a, 0xfa, 0x00, 0x1e, 0xda, 0x56, 0xfb, 0x89, 0xe5, 0xb8, 0x56,
+	0x77, 0xe8, 0x9c, 0xb8, 0xc3, 0x93, 0xbe, 0xe5, 0x1e, 0x75, 0x07, 0x7d, 0xeb, 0xa0, 0x73, 0xd8,
+	0xb1, 0xda, 0xd5, 0x9c, 0xb4, 0x7a, 0x9d, 0xd2, 0xb6, 0xfa, 0xbd, 0x41, 0x47, 0x3a, 0xad, 0x43,
+	0xed, 0xba, 0xfa, 0xb8, 0x33, 0x7c, 0xda, 0x76, 0x9a, 0xc7, 0xd5, 0x3c, 0xaa, 0xc1, 0xc6, 0x75,
+	0x7d, 0xab, 0x63, 0xdb, 0xd5, 0x02, 0x7a, 0x00, 0xfa, 0x75, 0x9d, 0x63, 0xc9, 0x24, 0xaa, 0x45,
+	0xf4, 0x21, 0x6c, 0xff, 0xfb, 0xcb, 0x4e, 0xf7, 0xc9, 0x9c, 0xb5, 0xd4, 0xfa, 0xf2, 0xfc, 0xa2,
+	0xae, 0xbd, 0xba, 0xa8, 0x6b, 0x7f, 0x5c, 0xd4, 0xb5, 0x97, 0x97, 0xf5, 0xdc, 0xab, 0xcb, 0x7a,
+	0xee, 0xd7, 0xcb, 0x7a, 0xee, 0xab, 0x47, 0x63, 0x5f, 0x4c, 0xa6, 0x23, 0x83, 0xb0, 0xd0, 0xe4,
+	0x78, 0x8c, 0xcf, 0x66, 0xdf, 0x9b, 0xf2, 0x33, 0x7a, 0x36, 0xff, 0x90, 0xca, 0x89, 0xe5, 0xa3,
+	0x52, 0xda, 0x98, 0x4f, 0xfe, 0x1a, 0x00, 0xd1, 0x81, 0x5d, 0x05, 0x63, 0x07, 0x00, 0x00,
 }
 
 func (m *ChainletAccount) Marshal() (dAtA []byte, err error) {
@@ -326,75 +727,749 @@ func (m *DenomPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEscrow(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ChainletAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovEscrow(uint64(l))
+func (m *TopUpSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Funder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Shares.Size()
-	n += 1 + l + sovEscrow(uint64(l))
-	return n
+func (m *TopUpSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TopUpSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovEscrow(uint64(l))
+	if m.FailedAttempts != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEscrow(uint64(l))
+	if m.ElapsedEpochs != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.ElapsedEpochs))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = m.Balance.Size()
-	n += 1 + l + sovEscrow(uint64(l))
-	l = m.Shares.Size()
-	n += 1 + l + sovEscrow(uint64(l))
-	return n
-}
-
-func sovEscrow(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEscrow(x uint64) (n int) {
-	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ChainletAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+	{
+		size, err := m.Deposited.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IntervalEpochs != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.IntervalEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFunderShare.Size()
+		i -= size
+		if _, err := m.MaxFunderShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintEscrow(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LedgerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LedgerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEscrow(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x52
+	if m.Height != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainletAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	return n
+}
+
+func (m *Funder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	return n
+}
+
+func (m *DenomPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	return n
+}
+
+func (m *TopUpSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	if m.IntervalEpochs != 0 {
+		n += 1 + sovEscrow(uint64(m.IntervalEpochs))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.Deposited.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	if m.ElapsedEpochs != 0 {
+		n += 1 + sovEscrow(uint64(m.ElapsedEpochs))
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovEscrow(uint64(m.FailedAttempts))
+	}
+	return n
+}
+
+func (m *FunderPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovEscrow(uint64(m.Mode))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	l = m.MaxFunderShare.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	return n
+}
+
+func (m *LedgerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEscrow(uint64(m.Sequence))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEscrow(uint64(m.Type))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.SharePrice.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEscrow(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEscrow(uint64(l))
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainletAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainletAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainletAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Funder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopUpSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowEscrow
 			}
@@ -411,17 +1486,199 @@ func (m *ChainletAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainletAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopUpSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainletAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopUpSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalEpochs", wireType)
+			}
+			m.IntervalEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedEpochs", wireType)
+			}
+			m.ElapsedEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEscrow
@@ -431,24 +1688,30 @@ func (m *ChainletAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ElapsedEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEscrow
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEscrow
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEscrow(dAtA[iNdEx:])
@@ -470,7 +1733,7 @@ func (m *ChainletAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Funder) Unmarshal(dAtA []byte) error {
+func (m *FunderPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -493,15 +1756,15 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunderPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunderPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -529,7 +1792,90 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= FunderMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunderShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFunderShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -554,7 +1900,7 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomPool) Unmarshal(dAtA []byte) error {
+func (m *LedgerEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -577,10 +1923,10 @@ func (m *DenomPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: LedgerEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -648,6 +1994,109 @@ func (m *DenomPool) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= LedgerEntryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
@@ -680,9 +2129,9 @@ func (m *DenomPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -710,7 +2159,59 @@ func (m *DenomPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
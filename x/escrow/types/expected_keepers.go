@@ -25,13 +25,16 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
 
 type BillingKeeper interface {
 	BillAndRestartChainlet(ctx sdk.Context, chainId string) error
+	GetBillingEpoch(ctx sdk.Context) string
 }
 
 type ChainletKeeper interface {
 	GetChainletStackInfo(ctx sdk.Context, chainId string) (*chainlettypes.ChainletStack, error)
+	GetChainletInfo(ctx sdk.Context, chainId string) (*chainlettypes.Chainlet, error)
 }
@@ -9,10 +9,13 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:           DefaultParams(),
-		ChainletAccounts: []ChainletAccount{},
-		Pools:            []DenomPool{},
-		Funders:          []GenesisFunder{},
+		Params:             DefaultParams(),
+		ChainletAccounts:   []ChainletAccount{},
+		Pools:              []DenomPool{},
+		Funders:            []GenesisFunder{},
+		TopUpSubscriptions: []TopUpSubscription{},
+		FunderPolicies:     []FunderPolicy{},
+		LedgerEntries:      []LedgerEntry{},
 	}
 }
 
@@ -50,5 +53,34 @@ func (gs GenesisState) Validate() error {
 		funderKeys[key] = true
 	}
 
+	// Validate top-up subscriptions have unique {funder, chainId, denom} tuples
+	topUpKeys := make(map[string]bool)
+	for _, sub := range gs.TopUpSubscriptions {
+		key := sub.Funder + "/" + sub.ChainId + "/" + sub.Amount.Denom
+		if topUpKeys[key] {
+			return ErrSubscriptionExists
+		}
+		topUpKeys[key] = true
+	}
+
+	// Validate funder policies have unique chain IDs
+	policyIDs := make(map[string]bool)
+	for _, policy := range gs.FunderPolicies {
+		if policyIDs[policy.ChainId] {
+			return ErrInvalidParams
+		}
+		policyIDs[policy.ChainId] = true
+	}
+
+	// Validate ledger entries have unique {chainId, denom, sequence} tuples
+	ledgerKeys := make(map[string]bool)
+	for _, entry := range gs.LedgerEntries {
+		key := string(LedgerKey(entry.ChainId, entry.Denom, entry.Sequence))
+		if ledgerKeys[key] {
+			return ErrInvalidParams
+		}
+		ledgerKeys[key] = true
+	}
+
 	return gs.Params.Validate()
 }
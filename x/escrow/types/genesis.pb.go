@@ -32,6 +32,11 @@ type GenesisState struct {
 	Pools []DenomPool `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools"`
 	// List of all funder positions with their identifiers
 	Funders []GenesisFunder `protobuf:"bytes,4,rep,name=funders,proto3" json:"funders"`
+	// List of all recurring top-up subscriptions
+	TopUpSubscriptions []TopUpSubscription `protobuf:"bytes,5,rep,name=top_up_subscriptions,json=topUpSubscriptions,proto3" json:"top_up_subscriptions"`
+	// List of all funder policies
+	FunderPolicies []FunderPolicy `protobuf:"bytes,6,rep,name=funder_policies,json=funderPolicies,proto3" json:"funder_policies"`
+	LedgerEntries  []LedgerEntry  `protobuf:"bytes,7,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -95,6 +100,27 @@ func (m *GenesisState) GetFunders() []GenesisFunder {
 	return nil
 }
 
+func (m *GenesisState) GetTopUpSubscriptions() []TopUpSubscription {
+	if m != nil {
+		return m.TopUpSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFunderPolicies() []FunderPolicy {
+	if m != nil {
+		return m.FunderPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLedgerEntries() []LedgerEntry {
+	if m != nil {
+		return m.LedgerEntries
+	}
+	return nil
+}
+
 // GenesisFunder wraps Funder with its composite key for genesis export/import
 type GenesisFunder struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -172,30 +198,36 @@ func init() {
 func init() { proto.RegisterFile("ssc/escrow/genesis.proto", fileDescriptor_d20be0fd550c3abf) }
 
 var fileDescriptor_d20be0fd550c3abf = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0xc6, 0x93, 0xfe, 0xd5, 0xad, 0x82, 0x2e, 0x15, 0xb7, 0x15, 0x62, 0x29, 0x08, 0x3d, 0x25,
-	0x5a, 0x4f, 0x9e, 0xc4, 0x2a, 0x8a, 0x17, 0x29, 0xf5, 0xe6, 0xa5, 0x6c, 0x37, 0x6b, 0x1a, 0x68,
-	0xb3, 0x21, 0xb3, 0xc5, 0xd6, 0x67, 0xf0, 0xe0, 0x63, 0xf5, 0xd8, 0xa3, 0x27, 0x91, 0xf6, 0x3d,
-	0x44, 0xb2, 0xbb, 0xc5, 0x14, 0x3d, 0x25, 0x33, 0xf3, 0xfb, 0x66, 0xbe, 0x9d, 0x41, 0x04, 0x80,
-	0x79, 0x1c, 0x58, 0x22, 0x5e, 0xbc, 0x80, 0x47, 0x1c, 0x42, 0x70, 0xe3, 0x44, 0x48, 0x81, 0x11,
-	0x00, 0x73, 0x75, 0xa5, 0x5e, 0x0d, 0x44, 0x20, 0x54, 0xda, 0x4b, 0xff, 0x34, 0x51, 0x3f, 0xcc,
-	0x68, 0x63, 0x9a, 0xd0, 0x31, 0xfc, 0x53, 0xd0, 0x1f, 0x5d, 0x68, 0x7e, 0xdb, 0x68, 0xe7, 0x4e,
-	0x4f, 0x79, 0x94, 0x54, 0x72, 0x7c, 0x8a, 0x4a, 0x5a, 0x49, 0xec, 0x86, 0xdd, 0xaa, 0xb4, 0xb1,
-	0xfb, 0x3b, 0xd5, 0xed, 0xaa, 0x4a, 0xa7, 0x30, 0xff, 0x3c, 0xb6, 0x7a, 0x86, 0xc3, 0x0f, 0x68,
-	0x9f, 0x0d, 0x69, 0x18, 0x8d, 0xb8, 0xec, 0x53, 0xc6, 0xc4, 0x24, 0x92, 0x40, 0x72, 0x8d, 0x7c,
-	0xab, 0xd2, 0x3e, 0xca, 0x8a, 0xaf, 0x0d, 0x74, 0xa5, 0x19, 0xd3, 0x65, 0x8f, 0x6d, 0xa6, 0x01,
-	0x9f, 0xa1, 0x62, 0x2c, 0xc4, 0x08, 0x48, 0x5e, 0xf5, 0x38, 0xc8, 0xf6, 0xb8, 0xe1, 0x91, 0x18,
-	0x77, 0x85, 0x18, 0x19, 0xb5, 0x26, 0xf1, 0x05, 0x2a, 0x3f, 0x4f, 0x22, 0x9f, 0x27, 0x40, 0x0a,
-	0x4a, 0x54, 0xcb, 0x8a, 0xcc, 0xfb, 0x6e, 0x15, 0x61, 0x84, 0x6b, 0xbe, 0xf9, 0x66, 0xa3, 0xdd,
-	0x0d, 0x00, 0xd7, 0xd0, 0x96, 0xf2, 0xd4, 0x0f, 0x7d, 0xb5, 0x83, 0xed, 0x5e, 0x59, 0xc5, 0xf7,
-	0x3e, 0xae, 0xa2, 0xa2, 0x9f, 0x3a, 0x20, 0x39, 0x95, 0xd7, 0x01, 0x26, 0xa8, 0x4c, 0x7d, 0x3f,
-	0xe1, 0x90, 0x5a, 0x56, 0xbc, 0x09, 0xd3, 0x65, 0xea, 0x39, 0xa4, 0xf0, 0x77, 0x99, 0x1b, 0x7e,
-	0x0c, 0xd7, 0xb9, 0x9c, 0x2f, 0x1d, 0x7b, 0xb1, 0x74, 0xec, 0xaf, 0xa5, 0x63, 0xbf, 0xaf, 0x1c,
-	0x6b, 0xb1, 0x72, 0xac, 0x8f, 0x95, 0x63, 0x3d, 0x9d, 0x04, 0xa1, 0x1c, 0x4e, 0x06, 0x2e, 0x13,
-	0x63, 0x0f, 0x68, 0x40, 0xa7, 0xb3, 0x57, 0x2f, 0xbd, 0xea, 0x74, 0x7d, 0x57, 0x39, 0x8b, 0x39,
-	0x0c, 0x4a, 0xea, 0xae, 0xe7, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x69, 0xe3, 0xa7, 0x47,
-	0x02, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0x37, 0xdd, 0x7f, 0xe0, 0xd2, 0x02, 0xd6, 0xa2, 0xba, 0x45, 0x84, 0xaa, 0x12, 0x52,
+	0x4f, 0x09, 0x94, 0x13, 0x27, 0x44, 0x29, 0x54, 0x48, 0x08, 0xad, 0x5a, 0x7a, 0xe1, 0x12, 0x65,
+	0x1d, 0x37, 0xb5, 0x94, 0xcd, 0x58, 0x1e, 0x47, 0x74, 0x79, 0x06, 0x0e, 0x3c, 0x56, 0x8f, 0x3d,
+	0x72, 0x42, 0x68, 0x97, 0x07, 0x41, 0xb1, 0xbd, 0xd4, 0x0b, 0x9c, 0x92, 0x99, 0xf9, 0xbe, 0xdf,
+	0xd8, 0xe3, 0x21, 0x0c, 0x91, 0xa7, 0x02, 0xb9, 0x86, 0xcf, 0x69, 0x29, 0x6a, 0x81, 0x12, 0x13,
+	0xa5, 0xc1, 0x00, 0x25, 0x88, 0x3c, 0x71, 0x95, 0x9d, 0x51, 0x09, 0x25, 0xd8, 0x74, 0xda, 0xfe,
+	0x39, 0xc5, 0xce, 0x56, 0xe0, 0x55, 0xb9, 0xce, 0xa7, 0xf8, 0x9f, 0x82, 0xfb, 0xb8, 0xc2, 0xde,
+	0xaf, 0x2e, 0xb9, 0x73, 0xec, 0xba, 0x9c, 0x9a, 0xdc, 0x08, 0xfa, 0x94, 0x0c, 0x9c, 0x93, 0x45,
+	0xbb, 0xd1, 0xfe, 0xfa, 0x01, 0x4d, 0x6e, 0xba, 0x26, 0x63, 0x5b, 0x39, 0xec, 0x5d, 0xfd, 0x78,
+	0xdc, 0x39, 0xf1, 0x3a, 0xfa, 0x81, 0xdc, 0xe7, 0x17, 0xb9, 0xac, 0x2b, 0x61, 0xb2, 0x9c, 0x73,
+	0x68, 0x6a, 0x83, 0x6c, 0x6d, 0xb7, 0xbb, 0xbf, 0x7e, 0xf0, 0x30, 0x34, 0xbf, 0xf6, 0xa2, 0x57,
+	0x4e, 0xe3, 0x29, 0xf7, 0xf8, 0x6a, 0x1a, 0xe9, 0x33, 0xd2, 0x57, 0x00, 0x15, 0xb2, 0xae, 0x65,
+	0x3c, 0x08, 0x19, 0x47, 0xa2, 0x86, 0xe9, 0x18, 0xa0, 0xf2, 0x6e, 0xa7, 0xa4, 0x2f, 0xc8, 0xf0,
+	0xbc, 0xa9, 0x0b, 0xa1, 0x91, 0xf5, 0xac, 0x69, 0x3b, 0x34, 0xf9, 0xfb, 0xbd, 0xb5, 0x0a, 0x6f,
+	0x5c, 0xea, 0xe9, 0x19, 0x19, 0x19, 0x50, 0x59, 0xa3, 0x32, 0x6c, 0x26, 0xc8, 0xb5, 0x54, 0x46,
+	0x42, 0x8d, 0xac, 0x6f, 0x39, 0x8f, 0x42, 0xce, 0x47, 0x50, 0x67, 0xea, 0x34, 0x50, 0x79, 0x16,
+	0x35, 0x7f, 0x17, 0x90, 0x1e, 0x93, 0xbb, 0xae, 0x43, 0xa6, 0xa0, 0x92, 0x5c, 0x0a, 0x64, 0x03,
+	0x4b, 0x64, 0x21, 0xd1, 0x1d, 0x69, 0xdc, 0x2a, 0x66, 0x1e, 0xb6, 0x79, 0x7e, 0x93, 0x93, 0x02,
+	0xe9, 0x11, 0xd9, 0xac, 0x44, 0x51, 0x0a, 0x9d, 0x89, 0xda, 0xe8, 0x96, 0x33, 0xb4, 0x9c, 0xad,
+	0x90, 0xf3, 0xde, 0x2a, 0xde, 0xd4, 0x46, 0x2f, 0x31, 0x1b, 0xd5, 0x9f, 0x94, 0x14, 0xb8, 0xf7,
+	0x35, 0x22, 0x1b, 0x2b, 0x63, 0xa0, 0xdb, 0xe4, 0x96, 0x9d, 0x7c, 0x26, 0x0b, 0xfb, 0xd2, 0xb7,
+	0x4f, 0x86, 0x36, 0x7e, 0x57, 0xd0, 0x11, 0xe9, 0x17, 0xed, 0x9c, 0xd9, 0x9a, 0xcd, 0xbb, 0x80,
+	0x32, 0x32, 0xcc, 0x8b, 0x42, 0x0b, 0x6c, 0x1f, 0xc6, 0xea, 0x7d, 0xd8, 0xae, 0x8c, 0x3b, 0x34,
+	0xeb, 0xfd, 0xbb, 0x32, 0x2b, 0x53, 0xf7, 0xba, 0xc3, 0x97, 0x57, 0xf3, 0x38, 0xba, 0x9e, 0xc7,
+	0xd1, 0xcf, 0x79, 0x1c, 0x7d, 0x5b, 0xc4, 0x9d, 0xeb, 0x45, 0xdc, 0xf9, 0xbe, 0x88, 0x3b, 0x9f,
+	0x9e, 0x94, 0xd2, 0x5c, 0x34, 0x93, 0x84, 0xc3, 0x34, 0xc5, 0xbc, 0xcc, 0x2f, 0x67, 0x5f, 0xd2,
+	0x76, 0x77, 0x2f, 0x97, 0xdb, 0x6b, 0x66, 0x4a, 0xe0, 0x64, 0x60, 0xb7, 0xf7, 0xf9, 0xef, 0x01,
+	0x00, 0x0a, 0x1b, 0x58, 0x00, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -218,6 +250,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LedgerEntries) > 0 {
+		for iNdEx := len(m.LedgerEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LedgerEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FunderPolicies) > 0 {
+		for iNdEx := len(m.FunderPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunderPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TopUpSubscriptions) > 0 {
+		for iNdEx := len(m.TopUpSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopUpSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Funders) > 0 {
 		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -364,6 +438,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TopUpSubscriptions) > 0 {
+		for _, e := range m.TopUpSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FunderPolicies) > 0 {
+		for _, e := range m.FunderPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LedgerEntries) > 0 {
+		for _, e := range m.LedgerEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -560,6 +652,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpSubscriptions = append(m.TopUpSubscriptions, TopUpSubscription{})
+			if err := m.TopUpSubscriptions[len(m.TopUpSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderPolicies = append(m.FunderPolicies, FunderPolicy{})
+			if err := m.FunderPolicies[len(m.FunderPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LedgerEntries = append(m.LedgerEntries, LedgerEntry{})
+			if err := m.LedgerEntries[len(m.LedgerEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
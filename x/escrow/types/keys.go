@@ -1,5 +1,12 @@
 package types
 
+import (
+	"encoding/binary"
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
 // Module constants
 const (
 	ModuleName  = "escrow"
@@ -11,10 +18,18 @@ const (
 // Binary key prefixes (single-byte to keep keys compact).
 // Using []byte vars (not const) so we can append at runtime.
 var (
-	KeyChainletPrefix = []byte{0x01} // escrow/chainlet/{chainId}
-	KeyPoolPrefix     = []byte{0x02} // escrow/pool/{chainId}/{denom}
-	KeyFunderPrefix   = []byte{0x03} // escrow/funder/{chainId}/{denom}/{addr}
-	KeyByFunderPrefix = []byte{0x04} // escrow/byFunder/{addr}/{chainId}/{denom}
+	KeyChainletPrefix     = []byte{0x01} // escrow/chainlet/{chainId}
+	KeyPoolPrefix         = []byte{0x02} // escrow/pool/{chainId}/{denom}
+	KeyFunderPrefix       = []byte{0x03} // escrow/funder/{chainId}/{denom}/{addr}
+	KeyByFunderPrefix     = []byte{0x04} // escrow/byFunder/{addr}/{chainId}/{denom}
+	KeyTopUpPrefix        = []byte{0x05} // escrow/topUp/{chainId}/{funder}/{denom}
+	KeyFunderPolicyPrefix = []byte{0x06} // escrow/funderPolicy/{chainId}
+	KeyLedgerPrefix       = []byte{0x07} // escrow/ledger/{chainId}/{denom}/{sequence}
+	KeyLedgerSeqPrefix    = []byte{0x08} // escrow/ledgerSeq/{chainId}/{denom}
+	KeyTVLPrefix          = []byte{0x09} // escrow/tvl/{denom}
+	KeyBalanceRankPrefix  = []byte{0x0A} // escrow/rank/{denom}/{balance}{chainId}
+	KeyActivePools        = []byte{0x0B} // escrow/activePools
+	KeyActiveFunders      = []byte{0x0C} // escrow/activeFunders
 )
 
 // delimiter used between path segments. Keep it a single byte.
@@ -30,6 +45,14 @@ func ChainletKey(chainID string) []byte {
 	return k
 }
 
+// FunderPolicyKey -> escrow/funderPolicy/{chainId}
+func FunderPolicyKey(chainID string) []byte {
+	k := make([]byte, 0, 1+len(chainID))
+	k = append(k, KeyFunderPolicyPrefix...)
+	k = append(k, []byte(chainID)...)
+	return k
+}
+
 // --- Pool keys (per {chainId, denom}) ---
 
 // PoolKey -> escrow/pool/{chainId}/{denom}
@@ -93,3 +116,92 @@ func ByFunderPrefix(addr string) []byte {
 	k = append(k, delim)
 	return k
 }
+
+// --- Top-up subscriptions (per {chainId, funder, denom}) ---
+
+// TopUpKey -> escrow/topUp/{chainId}/{funder}/{denom}
+func TopUpKey(chainID, funder, denom string) []byte {
+	k := make([]byte, 0, 3+len(chainID)+len(funder)+len(denom))
+	k = append(k, TopUpPrefix(chainID)...)
+	k = append(k, []byte(funder)...)
+	k = append(k, delim)
+	k = append(k, []byte(denom)...)
+	return k
+}
+
+// TopUpPrefix -> escrow/topUp/{chainId}/  (for iterating the subscriptions of a chainlet)
+func TopUpPrefix(chainID string) []byte {
+	k := make([]byte, 0, 2+len(chainID))
+	k = append(k, KeyTopUpPrefix...)
+	k = append(k, []byte(chainID)...)
+	k = append(k, delim)
+	return k
+}
+
+// --- Pool ledger (per {chainId, denom}) ---
+
+// LedgerKey -> escrow/ledger/{chainId}/{denom}/{sequence}
+func LedgerKey(chainID, denom string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(LedgerPrefix(chainID, denom), sequence)
+}
+
+// LedgerPrefix -> escrow/ledger/{chainId}/{denom}/  (for iterating the entries of a pool in order)
+func LedgerPrefix(chainID, denom string) []byte {
+	k := make([]byte, 0, 3+len(chainID)+len(denom)+8)
+	k = append(k, KeyLedgerPrefix...)
+	k = append(k, []byte(chainID)...)
+	k = append(k, delim)
+	k = append(k, []byte(denom)...)
+	k = append(k, delim)
+	return k
+}
+
+// LedgerSeqKey -> escrow/ledgerSeq/{chainId}/{denom}
+func LedgerSeqKey(chainID, denom string) []byte {
+	k := make([]byte, 0, 2+len(chainID)+len(denom))
+	k = append(k, KeyLedgerSeqPrefix...)
+	k = append(k, []byte(chainID)...)
+	k = append(k, delim)
+	k = append(k, []byte(denom)...)
+	return k
+}
+
+// --- Aggregates ---
+
+// TVLKey -> escrow/tvl/{denom}
+func TVLKey(denom string) []byte {
+	k := make([]byte, 0, 1+len(denom))
+	k = append(k, KeyTVLPrefix...)
+	k = append(k, []byte(denom)...)
+	return k
+}
+
+// BalanceRankKey -> escrow/rank/{denom}/{balance}{chainId}. The balance is length-prefixed
+// big-endian so that keys sort by balance.
+func BalanceRankKey(denom string, balance math.Int, chainID string) []byte {
+	bz := balance.BigInt().Bytes()
+	k := BalanceRankPrefix(denom)
+	k = append(k, byte(len(bz)))
+	k = append(k, bz...)
+	k = append(k, []byte(chainID)...)
+	return k
+}
+
+// BalanceRankPrefix -> escrow/rank/{denom}/  (for iterating the pools of a denom by balance)
+func BalanceRankPrefix(denom string) []byte {
+	k := make([]byte, 0, 2+len(denom))
+	k = append(k, KeyBalanceRankPrefix...)
+	k = append(k, []byte(denom)...)
+	k = append(k, delim)
+	return k
+}
+
+// ParseBalanceRankKey splits a key under BalanceRankPrefix into balance and chain ID.
+func ParseBalanceRankKey(key []byte) (math.Int, string, bool) {
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return math.Int{}, "", false
+	}
+	n := int(key[0])
+	balance := math.NewIntFromBigInt(new(big.Int).SetBytes(key[1 : 1+n]))
+	return balance, string(key[1+n:]), true
+}
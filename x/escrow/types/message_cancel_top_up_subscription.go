@@ -0,0 +1,46 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelTopUpSubscription = "cancel_top_up_subscription"
+
+var _ sdk.Msg = &MsgCancelTopUpSubscription{}
+
+func NewMsgCancelTopUpSubscription(creator string, chainId string, denom string) *MsgCancelTopUpSubscription {
+	return &MsgCancelTopUpSubscription{
+		Creator: creator,
+		ChainId: chainId,
+		Denom:   denom,
+	}
+}
+
+func (msg *MsgCancelTopUpSubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTopUpSubscription) Type() string {
+	return TypeMsgCancelTopUpSubscription
+}
+
+func (msg *MsgCancelTopUpSubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelTopUpSubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return cosmossdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateTopUpSubscription = "create_top_up_subscription"
+
+var _ sdk.Msg = &MsgCreateTopUpSubscription{}
+
+func NewMsgCreateTopUpSubscription(creator string, chainId string, amount string, intervalEpochs uint64, capAmount string) *MsgCreateTopUpSubscription {
+	return &MsgCreateTopUpSubscription{
+		Creator:        creator,
+		ChainId:        chainId,
+		Amount:         amount,
+		IntervalEpochs: intervalEpochs,
+		Cap:            capAmount,
+	}
+}
+
+func (msg *MsgCreateTopUpSubscription) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateTopUpSubscription) Type() string {
+	return TypeMsgCreateTopUpSubscription
+}
+
+func (msg *MsgCreateTopUpSubscription) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateTopUpSubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	amount, err := sdk.ParseCoinNormalized(msg.Amount)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(ErrInvalidCoin, "invalid coin (%s)", msg.Amount)
+	}
+	if !amount.Amount.IsPositive() {
+		return cosmossdkerrors.Wrapf(ErrInvalidCoin, "must send more than 0 coins (%s)", amount.Amount.String())
+	}
+	capCoin, err := sdk.ParseCoinNormalized(msg.Cap)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(ErrInvalidCoin, "invalid cap (%s)", msg.Cap)
+	}
+	if capCoin.Denom != amount.Denom {
+		return cosmossdkerrors.Wrapf(ErrInvalidDenom, "cap denom %s does not match amount denom %s", capCoin.Denom, amount.Denom)
+	}
+	if capCoin.Amount.LT(amount.Amount) {
+		return cosmossdkerrors.Wrapf(ErrInvalidCoin, "cap %s is lower than amount %s", capCoin, amount)
+	}
+	if msg.IntervalEpochs == 0 {
+		return cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "interval must be at least one epoch")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTopUpSubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateTopUpSubscription
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateTopUpSubscription{
+				Creator:        "invalid_address",
+				ChainId:        "abc_1-1",
+				Amount:         "10utsaga",
+				IntervalEpochs: 1,
+				Cap:            "100utsaga",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgCreateTopUpSubscription{
+				Creator:        sample.AccAddress(),
+				ChainId:        "abc_1-1",
+				Amount:         "0utsaga",
+				IntervalEpochs: 1,
+				Cap:            "100utsaga",
+			},
+			err: ErrInvalidCoin,
+		}, {
+			name: "cap denom",
+			msg: MsgCreateTopUpSubscription{
+				Creator:        sample.AccAddress(),
+				ChainId:        "abc_1-1",
+				Amount:         "10utsaga",
+				IntervalEpochs: 1,
+				Cap:            "100utagas",
+			},
+			err: ErrInvalidDenom,
+		}, {
+			name: "cap below amount",
+			msg: MsgCreateTopUpSubscription{
+				Creator:        sample.AccAddress(),
+				ChainId:        "abc_1-1",
+				Amount:         "10utsaga",
+				IntervalEpochs: 1,
+				Cap:            "5utsaga",
+			},
+			err: ErrInvalidCoin,
+		}, {
+			name: "zero interval",
+			msg: MsgCreateTopUpSubscription{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+				Amount:  "10utsaga",
+				Cap:     "100utsaga",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgCreateTopUpSubscription{
+				Creator:        sample.AccAddress(),
+				ChainId:        "abc_1-1",
+				Amount:         "10utsaga",
+				IntervalEpochs: 7,
+				Cap:            "100utsaga",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
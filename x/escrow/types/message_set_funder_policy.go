@@ -0,0 +1,80 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetFunderPolicy = "set_funder_policy"
+
+var _ sdk.Msg = &MsgSetFunderPolicy{}
+
+func NewMsgSetFunderPolicy(creator string, chainId string, mode FunderMode, allowlist []string, maxFunderShare string) *MsgSetFunderPolicy {
+	return &MsgSetFunderPolicy{
+		Creator:        creator,
+		ChainId:        chainId,
+		Mode:           mode,
+		Allowlist:      allowlist,
+		MaxFunderShare: maxFunderShare,
+	}
+}
+
+func (msg *MsgSetFunderPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetFunderPolicy) Type() string {
+	return TypeMsgSetFunderPolicy
+}
+
+func (msg *MsgSetFunderPolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetFunderPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, ok := FunderMode_name[int32(msg.Mode)]; !ok {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid funder mode %d", msg.Mode)
+	}
+	if len(msg.Allowlist) > 0 && msg.Mode != FunderMode_FUNDER_MODE_ALLOWLIST {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "allowlist requires mode %s", FunderMode_FUNDER_MODE_ALLOWLIST)
+	}
+	seen := make(map[string]bool)
+	for _, addr := range msg.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowlist address %s (%s)", addr, err)
+		}
+		if seen[addr] {
+			return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowlist address %s", addr)
+		}
+		seen[addr] = true
+	}
+	if _, err := msg.GetMaxFunderShareDec(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetMaxFunderShareDec returns the funder share cap, zero if not set.
+func (msg *MsgSetFunderPolicy) GetMaxFunderShareDec() (math.LegacyDec, error) {
+	if msg.MaxFunderShare == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	share, err := math.LegacyNewDecFromStr(msg.MaxFunderShare)
+	if err != nil {
+		return math.LegacyDec{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max funder share %s: %s", msg.MaxFunderShare, err)
+	}
+	if !share.IsPositive() || share.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max funder share %s not in (0, 1]", msg.MaxFunderShare)
+	}
+	return share, nil
+}
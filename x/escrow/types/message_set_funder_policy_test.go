@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetFunderPolicy_ValidateBasic(t *testing.T) {
+	friend := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetFunderPolicy
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetFunderPolicy{
+				Creator: "invalid_address",
+				ChainId: "abc_1-1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid mode",
+			msg: MsgSetFunderPolicy{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+				Mode:    FunderMode(7),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "allowlist outside allowlist mode",
+			msg: MsgSetFunderPolicy{
+				Creator:   sample.AccAddress(),
+				ChainId:   "abc_1-1",
+				Mode:      FunderMode_FUNDER_MODE_LAUNCHER_ONLY,
+				Allowlist: []string{friend},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid allowlist address",
+			msg: MsgSetFunderPolicy{
+				Creator:   sample.AccAddress(),
+				ChainId:   "abc_1-1",
+				Mode:      FunderMode_FUNDER_MODE_ALLOWLIST,
+				Allowlist: []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate allowlist address",
+			msg: MsgSetFunderPolicy{
+				Creator:   sample.AccAddress(),
+				ChainId:   "abc_1-1",
+				Mode:      FunderMode_FUNDER_MODE_ALLOWLIST,
+				Allowlist: []string{friend, friend},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "max funder share above one",
+			msg: MsgSetFunderPolicy{
+				Creator:        sample.AccAddress(),
+				ChainId:        "abc_1-1",
+				MaxFunderShare: "1.5",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "open",
+			msg: MsgSetFunderPolicy{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+			},
+		}, {
+			name: "allowlist with cap",
+			msg: MsgSetFunderPolicy{
+				Creator:        sample.AccAddress(),
+				ChainId:        "abc_1-1",
+				Mode:           FunderMode_FUNDER_MODE_ALLOWLIST,
+				Allowlist:      []string{friend},
+				MaxFunderShare: "0.2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferEscrowPosition = "transfer_escrow_position"
+
+var _ sdk.Msg = &MsgTransferEscrowPosition{}
+
+func NewMsgTransferEscrowPosition(creator string, chainId string, denom string, recipient string, shares string) *MsgTransferEscrowPosition {
+	return &MsgTransferEscrowPosition{
+		Creator:   creator,
+		ChainId:   chainId,
+		Denom:     denom,
+		Recipient: recipient,
+		Shares:    shares,
+	}
+}
+
+func (msg *MsgTransferEscrowPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferEscrowPosition) Type() string {
+	return TypeMsgTransferEscrowPosition
+}
+
+func (msg *MsgTransferEscrowPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferEscrowPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.Creator == msg.Recipient {
+		return cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "recipient must differ from creator")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return cosmossdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	if _, err := msg.GetSharesDec(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSharesDec returns the number of shares to transfer, nil if not set.
+func (msg *MsgTransferEscrowPosition) GetSharesDec() (math.LegacyDec, error) {
+	if msg.Shares == "" {
+		return math.LegacyDec{}, nil
+	}
+	shares, err := math.LegacyNewDecFromStr(msg.Shares)
+	if err != nil {
+		return math.LegacyDec{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid shares %s: %s", msg.Shares, err)
+	}
+	if !shares.IsPositive() {
+		return math.LegacyDec{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "shares must be positive (%s)", msg.Shares)
+	}
+	return shares, nil
+}
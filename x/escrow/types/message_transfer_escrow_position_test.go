@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sagaxyz/ssc/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferEscrowPosition_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferEscrowPosition
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferEscrowPosition{
+				Creator:   "invalid_address",
+				ChainId:   "abc_1-1",
+				Denom:     "utsaga",
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgTransferEscrowPosition{
+				Creator:   creator,
+				ChainId:   "abc_1-1",
+				Denom:     "utsaga",
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "same recipient",
+			msg: MsgTransferEscrowPosition{
+				Creator:   creator,
+				ChainId:   "abc_1-1",
+				Denom:     "utsaga",
+				Recipient: creator,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing denom",
+			msg: MsgTransferEscrowPosition{
+				Creator:   creator,
+				ChainId:   "abc_1-1",
+				Recipient: sample.AccAddress(),
+			},
+			err: ErrInvalidDenom,
+		}, {
+			name: "zero shares",
+			msg: MsgTransferEscrowPosition{
+				Creator:   creator,
+				ChainId:   "abc_1-1",
+				Denom:     "utsaga",
+				Recipient: sample.AccAddress(),
+				Shares:    "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "whole position",
+			msg: MsgTransferEscrowPosition{
+				Creator:   creator,
+				ChainId:   "abc_1-1",
+				Denom:     "utsaga",
+				Recipient: sample.AccAddress(),
+			},
+		}, {
+			name: "shares",
+			msg: MsgTransferEscrowPosition{
+				Creator:   creator,
+				ChainId:   "abc_1-1",
+				Denom:     "utsaga",
+				Recipient: sample.AccAddress(),
+				Shares:    "12.5",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	cosmossdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -10,10 +11,13 @@ const TypeMsgWithdraw = "withdraw"
 
 var _ sdk.Msg = &MsgWithdraw{}
 
-func NewMsgWithdraw(creator string, chainId string) *MsgWithdraw {
+func NewMsgWithdraw(creator string, chainId string, denom string, amount string, fraction string) *MsgWithdraw {
 	return &MsgWithdraw{
-		Creator: creator,
-		ChainId: chainId,
+		Creator:  creator,
+		ChainId:  chainId,
+		Denom:    denom,
+		Amount:   amount,
+		Fraction: fraction,
 	}
 }
 
@@ -38,5 +42,54 @@ func (msg *MsgWithdraw) ValidateBasic() error {
 	if err != nil {
 		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.Denom == "" {
+		if msg.Amount != "" || msg.Fraction != "" {
+			return cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount and fraction require a denom")
+		}
+		return nil
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return cosmossdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	if msg.Amount != "" && msg.Fraction != "" {
+		return cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount and fraction cannot both be set")
+	}
+	if msg.Amount != "" {
+		if _, err := msg.GetAmountInt(); err != nil {
+			return err
+		}
+	}
+	if msg.Fraction != "" {
+		if _, err := msg.GetFractionDec(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// GetAmountInt returns the amount to withdraw, nil if not set.
+func (msg *MsgWithdraw) GetAmountInt() (math.Int, error) {
+	if msg.Amount == "" {
+		return math.Int{}, nil
+	}
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok || !amount.IsPositive() {
+		return math.Int{}, cosmossdkerrors.Wrapf(ErrInvalidCoin, "invalid amount %s", msg.Amount)
+	}
+	return amount, nil
+}
+
+// GetFractionDec returns the fraction of the position to withdraw, nil if not set.
+func (msg *MsgWithdraw) GetFractionDec() (math.LegacyDec, error) {
+	if msg.Fraction == "" {
+		return math.LegacyDec{}, nil
+	}
+	fraction, err := math.LegacyNewDecFromStr(msg.Fraction)
+	if err != nil {
+		return math.LegacyDec{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fraction %s: %s", msg.Fraction, err)
+	}
+	if !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fraction %s not in (0, 1]", msg.Fraction)
+	}
+	return fraction, nil
+}
@@ -27,6 +27,65 @@ func TestMsgWithdraw_ValidateBasic(t *testing.T) {
 				Creator: sample.AccAddress(),
 				ChainId: "abc_1-1",
 			},
+		}, {
+			name: "denom",
+			msg: MsgWithdraw{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+				Denom:   "utsaga",
+			},
+		}, {
+			name: "amount",
+			msg: MsgWithdraw{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+				Denom:   "utsaga",
+				Amount:  "100",
+			},
+		}, {
+			name: "fraction",
+			msg: MsgWithdraw{
+				Creator:  sample.AccAddress(),
+				ChainId:  "abc_1-1",
+				Denom:    "utsaga",
+				Fraction: "0.25",
+			},
+		}, {
+			name: "amount without denom",
+			msg: MsgWithdraw{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+				Amount:  "100",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "amount and fraction",
+			msg: MsgWithdraw{
+				Creator:  sample.AccAddress(),
+				ChainId:  "abc_1-1",
+				Denom:    "utsaga",
+				Amount:   "100",
+				Fraction: "0.5",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero amount",
+			msg: MsgWithdraw{
+				Creator: sample.AccAddress(),
+				ChainId: "abc_1-1",
+				Denom:   "utsaga",
+				Amount:  "0",
+			},
+			err: ErrInvalidCoin,
+		}, {
+			name: "fraction above one",
+			msg: MsgWithdraw{
+				Creator:  sample.AccAddress(),
+				ChainId:  "abc_1-1",
+				Denom:    "utsaga",
+				Fraction: "1.5",
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -9,6 +9,9 @@ import (
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// DefaultLedgerRetention is the default number of ledger entries kept per pool
+const DefaultLedgerRetention uint64 = 100
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -18,6 +21,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams() Params {
 	return Params{
 		SupportedDenoms: []string{"utsaga", "utagas"},
+		LedgerRetention: DefaultLedgerRetention,
 	}
 }
 
@@ -30,6 +34,7 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	psp := paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair([]byte("SupportedDenoms"), &p.SupportedDenoms, validateDenoms),
+		paramtypes.NewParamSetPair([]byte("LedgerRetention"), &p.LedgerRetention, validateLedgerRetention),
 	}
 
 	return psp
@@ -40,6 +45,9 @@ func (p Params) Validate() error {
 	if err := validateDenoms(p.SupportedDenoms); err != nil {
 		return cosmossdkerrors.Wrapf(ErrInvalidParams, err.Error())
 	}
+	if err := validateLedgerRetention(p.LedgerRetention); err != nil {
+		return cosmossdkerrors.Wrapf(ErrInvalidParams, err.Error())
+	}
 	return nil
 }
 
@@ -63,3 +71,11 @@ func validateDenom(v interface{}) error {
 	}
 	return nil
 }
+
+func validateLedgerRetention(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("not a uint64")
+	}
+	return nil
+}
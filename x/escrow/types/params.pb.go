@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// option (gogoproto.goproto_stringer) = false;
 	SupportedDenoms []string `protobuf:"bytes,1,rep,name=supportedDenoms,proto3" json:"supportedDenoms,omitempty"`
+	// Number of ledger entries kept per pool, the ledger is disabled if zero
+	LedgerRetention uint64 `protobuf:"varint,2,opt,name=ledgerRetention,proto3" json:"ledgerRetention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +71,13 @@ func (m *Params) GetSupportedDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetLedgerRetention() uint64 {
+	if m != nil {
+		return m.LedgerRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ssc.escrow.Params")
 }
@@ -76,18 +85,19 @@ func init() {
 func init() { proto.RegisterFile("ssc/escrow/params.proto", fileDescriptor_2f8a9cc6c8a82f57) }
 
 var fileDescriptor_2f8a9cc6c8a82f57 = []byte{
-	// 169 bytes of a gzipped FileDescriptorProto
+	// 192 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0x2e, 0x4e, 0xd6,
 	0x4f, 0x2d, 0x4e, 0x2e, 0xca, 0x2f, 0xd7, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
 	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2a, 0x2e, 0x4e, 0xd6, 0x83, 0x48, 0x48, 0x89, 0xa4, 0xe7, 0xa7,
-	0xe7, 0x83, 0x85, 0xf5, 0x41, 0x2c, 0x88, 0x0a, 0x25, 0x23, 0x2e, 0xb6, 0x00, 0xb0, 0x0e, 0x21,
+	0xe7, 0x83, 0x85, 0xf5, 0x41, 0x2c, 0x88, 0x0a, 0xa5, 0x18, 0x2e, 0xb6, 0x00, 0xb0, 0x0e, 0x21,
 	0x0d, 0x2e, 0xfe, 0xe2, 0xd2, 0x82, 0x82, 0xfc, 0xa2, 0x92, 0xd4, 0x14, 0x97, 0xd4, 0xbc, 0xfc,
-	0xdc, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0xce, 0x20, 0x74, 0x61, 0x27, 0xfb, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0x4c, 0x4f, 0xac, 0xa8, 0xac, 0xd2, 0x07, 0xb9, 0xad, 0x02, 0xe6,
-	0xba, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xdd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x02, 0x15, 0x44, 0x53, 0xb8, 0x00, 0x00, 0x00,
+	0xdc, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0xce, 0x20, 0x74, 0x61, 0x90, 0xca, 0x9c, 0xd4, 0x94,
+	0xf4, 0xd4, 0xa2, 0xa0, 0xd4, 0x92, 0xd4, 0xbc, 0x92, 0xcc, 0xfc, 0x3c, 0x09, 0x26, 0x05, 0x46,
+	0x0d, 0x96, 0x20, 0x74, 0x61, 0x27, 0xfb, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c,
+	0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63,
+	0x88, 0x52, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0x4e, 0x4c,
+	0x4f, 0xac, 0xa8, 0xac, 0xd2, 0x07, 0xf9, 0xa2, 0x02, 0xe6, 0x8f, 0x92, 0xca, 0x82, 0xd4, 0xe2,
+	0x24, 0x36, 0xb0, 0x2b, 0x8d, 0x01, 0x03, 0x00, 0x08, 0x65, 0x74, 0xaa, 0xe2, 0x00, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -110,6 +120,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LedgerRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LedgerRetention))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.SupportedDenoms) > 0 {
 		for iNdEx := len(m.SupportedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SupportedDenoms[iNdEx])
@@ -145,6 +160,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.LedgerRetention != 0 {
+		n += 1 + sovParams(uint64(m.LedgerRetention))
+	}
 	return n
 }
 
@@ -215,6 +233,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SupportedDenoms = append(m.SupportedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerRetention", wireType)
+			}
+			m.LedgerRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
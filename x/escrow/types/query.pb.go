@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -752,6 +754,393 @@ func (m *QueryFunderPositionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// ---------------------------------------
+// Funder policy
+// ---------------------------------------
+type QueryFunderPolicyRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+}
+
+func (m *QueryFunderPolicyRequest) Reset()         { *m = QueryFunderPolicyRequest{} }
+func (m *QueryFunderPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderPolicyRequest) ProtoMessage()    {}
+func (*QueryFunderPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{14}
+}
+func (m *QueryFunderPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunderPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunderPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunderPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderPolicyRequest.Merge(m, src)
+}
+func (m *QueryFunderPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunderPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunderPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryFunderPolicyRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryFunderPolicyResponse struct {
+	Policy FunderPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryFunderPolicyResponse) Reset()         { *m = QueryFunderPolicyResponse{} }
+func (m *QueryFunderPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderPolicyResponse) ProtoMessage()    {}
+func (*QueryFunderPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{15}
+}
+func (m *QueryFunderPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunderPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunderPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunderPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderPolicyResponse.Merge(m, src)
+}
+func (m *QueryFunderPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunderPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunderPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryFunderPolicyResponse) GetPolicy() FunderPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return FunderPolicy{}
+}
+
+// ---------------------------------------
+// Pool ledger
+// ---------------------------------------
+type QueryPoolLedgerRequest struct {
+	ChainId    string             `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,98,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolLedgerRequest) Reset()         { *m = QueryPoolLedgerRequest{} }
+func (m *QueryPoolLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolLedgerRequest) ProtoMessage()    {}
+func (*QueryPoolLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{16}
+}
+func (m *QueryPoolLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolLedgerRequest.Merge(m, src)
+}
+func (m *QueryPoolLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryPoolLedgerRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryPoolLedgerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryPoolLedgerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolLedgerResponse struct {
+	Entries    []LedgerEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,98,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolLedgerResponse) Reset()         { *m = QueryPoolLedgerResponse{} }
+func (m *QueryPoolLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolLedgerResponse) ProtoMessage()    {}
+func (*QueryPoolLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{17}
+}
+func (m *QueryPoolLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolLedgerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolLedgerResponse.Merge(m, src)
+}
+func (m *QueryPoolLedgerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolLedgerResponse proto.InternalMessageInfo
+
+func (m *QueryPoolLedgerResponse) GetEntries() []LedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryPoolLedgerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ---------------------------------------
+// Aggregate statistics
+// ---------------------------------------
+type QueryEscrowStatsRequest struct {
+	// Number of chainlets to rank per denom, 10 if zero
+	TopN uint32 `protobuf:"varint,1,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// Only rank the chainlets of this denom if set
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryEscrowStatsRequest) Reset()         { *m = QueryEscrowStatsRequest{} }
+func (m *QueryEscrowStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowStatsRequest) ProtoMessage()    {}
+func (*QueryEscrowStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{18}
+}
+func (m *QueryEscrowStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowStatsRequest.Merge(m, src)
+}
+func (m *QueryEscrowStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowStatsRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowStatsRequest) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *QueryEscrowStatsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type ChainletBalance struct {
+	ChainId string     `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *ChainletBalance) Reset()         { *m = ChainletBalance{} }
+func (m *ChainletBalance) String() string { return proto.CompactTextString(m) }
+func (*ChainletBalance) ProtoMessage()    {}
+func (*ChainletBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{19}
+}
+func (m *ChainletBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainletBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainletBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainletBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainletBalance.Merge(m, src)
+}
+func (m *ChainletBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainletBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainletBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainletBalance proto.InternalMessageInfo
+
+func (m *ChainletBalance) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainletBalance) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+type QueryEscrowStatsResponse struct {
+	// Total balance per denom across all pools
+	Tvl github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tvl,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tvl"`
+	// Pools with a positive balance
+	ActivePools uint64 `protobuf:"varint,2,opt,name=active_pools,json=activePools,proto3" json:"active_pools,omitempty"`
+	// Addresses with at least one position
+	ActiveFunders uint64 `protobuf:"varint,3,opt,name=active_funders,json=activeFunders,proto3" json:"active_funders,omitempty"`
+	// Chainlets with the largest pool balances, per denom, largest first
+	TopChainlets []ChainletBalance `protobuf:"bytes,4,rep,name=top_chainlets,json=topChainlets,proto3" json:"top_chainlets"`
+}
+
+func (m *QueryEscrowStatsResponse) Reset()         { *m = QueryEscrowStatsResponse{} }
+func (m *QueryEscrowStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowStatsResponse) ProtoMessage()    {}
+func (*QueryEscrowStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e20066efa428ecf, []int{20}
+}
+func (m *QueryEscrowStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowStatsResponse.Merge(m, src)
+}
+func (m *QueryEscrowStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowStatsResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowStatsResponse) GetTvl() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tvl
+	}
+	return nil
+}
+
+func (m *QueryEscrowStatsResponse) GetActivePools() uint64 {
+	if m != nil {
+		return m.ActivePools
+	}
+	return 0
+}
+
+func (m *QueryEscrowStatsResponse) GetActiveFunders() uint64 {
+	if m != nil {
+		return m.ActiveFunders
+	}
+	return 0
+}
+
+func (m *QueryEscrowStatsResponse) GetTopChainlets() []ChainletBalance {
+	if m != nil {
+		return m.TopChainlets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ssc.escrow.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ssc.escrow.QueryParamsResponse")
@@ -767,63 +1156,91 @@ func init() {
 	proto.RegisterType((*QueryFunderPositionsRequest)(nil), "ssc.escrow.QueryFunderPositionsRequest")
 	proto.RegisterType((*Position)(nil), "ssc.escrow.Position")
 	proto.RegisterType((*QueryFunderPositionsResponse)(nil), "ssc.escrow.QueryFunderPositionsResponse")
+	proto.RegisterType((*QueryFunderPolicyRequest)(nil), "ssc.escrow.QueryFunderPolicyRequest")
+	proto.RegisterType((*QueryFunderPolicyResponse)(nil), "ssc.escrow.QueryFunderPolicyResponse")
+	proto.RegisterType((*QueryPoolLedgerRequest)(nil), "ssc.escrow.QueryPoolLedgerRequest")
+	proto.RegisterType((*QueryPoolLedgerResponse)(nil), "ssc.escrow.QueryPoolLedgerResponse")
+	proto.RegisterType((*QueryEscrowStatsRequest)(nil), "ssc.escrow.QueryEscrowStatsRequest")
+	proto.RegisterType((*ChainletBalance)(nil), "ssc.escrow.ChainletBalance")
+	proto.RegisterType((*QueryEscrowStatsResponse)(nil), "ssc.escrow.QueryEscrowStatsResponse")
 }
 
 func init() { proto.RegisterFile("ssc/escrow/query.proto", fileDescriptor_0e20066efa428ecf) }
 
 var fileDescriptor_0e20066efa428ecf = []byte{
-	// 807 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xf0, 0xa3, 0xc0, 0xc3, 0xe5, 0xe7, 0x58, 0xa5, 0x2e, 0xb8, 0xc5, 0x8d, 0x58,
-	0x84, 0xb8, 0x03, 0x35, 0xc6, 0x44, 0x63, 0x08, 0xa8, 0x34, 0xde, 0x70, 0x3d, 0xe9, 0xc1, 0x64,
-	0xba, 0x1d, 0x97, 0x26, 0xed, 0xce, 0xb2, 0xb3, 0x55, 0x2a, 0x21, 0x26, 0x1e, 0x4d, 0x34, 0x46,
-	0x13, 0x2f, 0x9e, 0x7c, 0x37, 0xdc, 0x24, 0xf1, 0xe2, 0xc9, 0x18, 0xf0, 0x85, 0x98, 0x9d, 0x99,
-	0x2d, 0x5b, 0xb6, 0x9b, 0x22, 0xe9, 0xa9, 0xdd, 0x67, 0xbe, 0xf3, 0x3c, 0x9f, 0xe7, 0xcf, 0x3e,
-	0x2d, 0x5c, 0xe4, 0xdc, 0xc1, 0x94, 0x3b, 0x01, 0x7b, 0x85, 0xb7, 0xdb, 0x34, 0xe8, 0x58, 0x7e,
-	0xc0, 0x42, 0x86, 0x80, 0x73, 0xc7, 0x92, 0x76, 0xbd, 0xe0, 0x32, 0x97, 0x09, 0x33, 0x8e, 0xbe,
-	0x49, 0x85, 0x3e, 0xeb, 0x32, 0xe6, 0x36, 0x29, 0x26, 0x7e, 0x03, 0x13, 0xcf, 0x63, 0x21, 0x09,
-	0x1b, 0xcc, 0xe3, 0xea, 0x74, 0xd1, 0x61, 0xbc, 0xc5, 0x38, 0xae, 0x11, 0x4e, 0xa5, 0x63, 0xfc,
-	0x72, 0xa5, 0x46, 0x43, 0xb2, 0x82, 0x7d, 0xe2, 0x36, 0x3c, 0x21, 0x56, 0xda, 0xe9, 0x04, 0x83,
-	0x4f, 0x02, 0xd2, 0xe2, 0x7d, 0x0e, 0xe4, 0x87, 0x3c, 0x30, 0x0b, 0x80, 0x1e, 0x47, 0x3e, 0x37,
-	0x85, 0xda, 0xa6, 0xdb, 0x6d, 0xca, 0x43, 0xb3, 0x0a, 0xe7, 0x7b, 0xac, 0xdc, 0x67, 0x1e, 0xa7,
-	0x68, 0x19, 0xf2, 0xd2, 0x6b, 0x51, 0x9b, 0xd3, 0x16, 0xa6, 0x2a, 0xc8, 0x3a, 0xce, 0xcd, 0x92,
-	0xda, 0xf5, 0xff, 0xf6, 0x7f, 0x95, 0x72, 0xb6, 0xd2, 0x99, 0x77, 0xc0, 0x10, 0x8e, 0xaa, 0x34,
-	0xbc, 0xbf, 0x45, 0x1a, 0x5e, 0x93, 0x86, 0x6b, 0x8e, 0xc3, 0xda, 0x5e, 0xa8, 0x42, 0xa1, 0x22,
-	0x8c, 0x3b, 0xd1, 0xc9, 0xa3, 0xba, 0x70, 0x3a, 0x69, 0xc7, 0x8f, 0xe6, 0x73, 0x28, 0x65, 0xde,
-	0x55, 0x40, 0x77, 0x61, 0x9c, 0x48, 0x93, 0x22, 0x9a, 0x49, 0x12, 0x9d, 0xb8, 0xa5, 0xd0, 0xe2,
-	0x1b, 0x66, 0x1b, 0xce, 0xc9, 0x24, 0x19, 0x6b, 0xf2, 0x81, 0x38, 0x68, 0x03, 0xe0, 0xb8, 0xde,
-	0xc5, 0x9a, 0x08, 0x77, 0xcd, 0x92, 0xcd, 0xb1, 0xa2, 0xe6, 0x58, 0xb2, 0xeb, 0xaa, 0x39, 0xd6,
-	0x26, 0x71, 0xa9, 0xf2, 0x6a, 0x27, 0x6e, 0x9a, 0xef, 0xb4, 0xb8, 0xe4, 0x32, 0xae, 0x4a, 0x65,
-	0x09, 0xc6, 0xfc, 0xc8, 0x50, 0xd4, 0xe6, 0x46, 0x17, 0xa6, 0x2a, 0x17, 0x92, 0x89, 0x3c, 0xa0,
-	0x1e, 0x6b, 0x45, 0x72, 0x5b, 0x6a, 0x50, 0xb5, 0x0f, 0x4b, 0x79, 0x20, 0x8b, 0x8c, 0xd4, 0x03,
-	0xf3, 0x5e, 0x53, 0x9d, 0xde, 0x68, 0x7b, 0x75, 0x1a, 0x9c, 0xa2, 0x0c, 0x05, 0x18, 0xab, 0x47,
-	0x38, 0xc5, 0x11, 0x61, 0x97, 0x0f, 0x43, 0x2b, 0xce, 0x53, 0x98, 0x92, 0x24, 0x0f, 0xbd, 0x30,
-	0xe8, 0x44, 0x18, 0xa4, 0x5e, 0x0f, 0x28, 0xe7, 0x31, 0x86, 0x7a, 0x8c, 0x46, 0xf1, 0x85, 0x10,
-	0x0a, 0x8e, 0x13, 0xa3, 0x28, 0x5d, 0xc4, 0xa3, 0x28, 0x75, 0xe6, 0x27, 0x0d, 0x0a, 0xbd, 0xa9,
-	0xaa, 0xca, 0xaf, 0xc0, 0xb8, 0x94, 0xc4, 0xb5, 0x9f, 0x4e, 0xfb, 0x12, 0x38, 0x76, 0xac, 0x1b,
-	0x5e, 0xfd, 0xeb, 0x50, 0x4c, 0x30, 0x3d, 0xd9, 0x22, 0x01, 0x3d, 0x73, 0x0f, 0x12, 0xc5, 0x1a,
-	0xed, 0x29, 0x96, 0x59, 0x85, 0x4b, 0x7d, 0xa2, 0xa8, 0xf4, 0x17, 0x21, 0xcf, 0x85, 0xa5, 0xdf,
-	0x4b, 0x2d, 0x6f, 0xd8, 0x4a, 0x61, 0xbe, 0x81, 0x99, 0x84, 0xa3, 0x4d, 0xc6, 0x1b, 0x62, 0x53,
-	0x25, 0x88, 0x33, 0xda, 0x35, 0xac, 0xf9, 0x68, 0xc2, 0x44, 0x1c, 0xf5, 0x9f, 0xeb, 0xb3, 0xdc,
-	0x4d, 0x74, 0x74, 0xd0, 0xc8, 0xa8, 0x74, 0xbf, 0x6a, 0x30, 0xdb, 0x3f, 0x5f, 0x55, 0xbb, 0x0a,
-	0x4c, 0xfa, 0xb1, 0x51, 0x0d, 0x4f, 0xa1, 0x67, 0x27, 0xaa, 0x43, 0xfb, 0x58, 0x36, 0xb4, 0xd9,
-	0xa9, 0x7c, 0xcf, 0xc3, 0x98, 0xa0, 0x43, 0x14, 0xf2, 0x72, 0xfb, 0x22, 0x23, 0x19, 0x3d, 0xbd,
-	0xd8, 0xf5, 0x52, 0xe6, 0xb9, 0x0c, 0x60, 0xea, 0x6f, 0x7f, 0xfc, 0xf9, 0x3c, 0x52, 0x40, 0x08,
-	0xa7, 0x7e, 0x4a, 0xd0, 0x17, 0x0d, 0x50, 0x7a, 0x19, 0xa3, 0xc5, 0x94, 0xcf, 0xcc, 0x6d, 0xaf,
-	0x2f, 0x9d, 0x4a, 0xab, 0x58, 0xca, 0x82, 0xe5, 0x0a, 0x2a, 0x25, 0x59, 0x1c, 0x25, 0xe6, 0x78,
-	0x57, 0xb5, 0x7b, 0x0f, 0xed, 0xc0, 0x44, 0x95, 0x86, 0x62, 0x9f, 0xa2, 0xcb, 0xe9, 0x0c, 0x13,
-	0xfb, 0x5d, 0x37, 0xb2, 0x8e, 0x55, 0xcc, 0x1b, 0x22, 0x66, 0x19, 0xcd, 0x0f, 0x88, 0x89, 0xe5,
-	0x22, 0xfe, 0xa0, 0x01, 0x54, 0x69, 0xa8, 0x56, 0x0a, 0x4a, 0x97, 0xb7, 0x77, 0xaf, 0xea, 0x73,
-	0xd9, 0x02, 0x05, 0x70, 0x4f, 0x00, 0xdc, 0x46, 0xb7, 0x4e, 0x05, 0x80, 0x77, 0xc5, 0x6c, 0xef,
-	0xe1, 0x78, 0x33, 0x7d, 0xd3, 0x60, 0xb2, 0x0b, 0x84, 0xae, 0x66, 0x84, 0xeb, 0x59, 0x34, 0xfa,
-	0xfc, 0x00, 0x95, 0x22, 0xab, 0x0a, 0xb2, 0x35, 0xb4, 0x7a, 0x26, 0x32, 0xbc, 0xab, 0x76, 0xc1,
-	0x5e, 0x34, 0x47, 0xff, 0x77, 0x19, 0xd7, 0x49, 0x93, 0x78, 0x0e, 0x45, 0xe5, 0x0c, 0x88, 0x93,
-	0x4b, 0x46, 0x5f, 0x18, 0x2c, 0x54, 0xc0, 0x58, 0x00, 0x5f, 0x47, 0xe5, 0x24, 0x70, 0x0a, 0x07,
-	0x77, 0x5f, 0xcd, 0xf5, 0xd5, 0xfd, 0x43, 0x43, 0x3b, 0x38, 0x34, 0xb4, 0xdf, 0x87, 0x86, 0xf6,
-	0xf1, 0xc8, 0xc8, 0x1d, 0x1c, 0x19, 0xb9, 0x9f, 0x47, 0x46, 0xee, 0xd9, 0xbc, 0xdb, 0x08, 0xb7,
-	0xda, 0x35, 0xcb, 0x61, 0x2d, 0xcc, 0x89, 0x4b, 0x76, 0x3a, 0xaf, 0x85, 0xd3, 0x9d, 0xd8, 0x6d,
-	0xd8, 0xf1, 0x29, 0xaf, 0xe5, 0xc5, 0x9f, 0xaa, 0x9b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb5,
-	0xb5, 0xfd, 0x7b, 0x0c, 0x0a, 0x00, 0x00,
+	// 1150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xb3, 0xf9, 0xd3, 0xbc, 0xb4, 0x94, 0x4e, 0x96, 0x66, 0xe3, 0x16, 0x27, 0x35, 0x0d,
+	0x09, 0xa9, 0xb2, 0x4e, 0x42, 0xff, 0x88, 0x7f, 0xaa, 0x9a, 0xb6, 0x59, 0x21, 0x21, 0x14, 0x9c,
+	0x13, 0x48, 0x10, 0x79, 0xbd, 0xd3, 0x8d, 0xc5, 0xc6, 0xe3, 0x7a, 0x66, 0x43, 0x42, 0x14, 0x21,
+	0x71, 0x04, 0x81, 0x2a, 0x2a, 0xb8, 0xf4, 0xc4, 0x95, 0x4f, 0xd2, 0x63, 0x25, 0x2e, 0x9c, 0x00,
+	0x25, 0x7c, 0x02, 0x3e, 0x01, 0xf2, 0xcc, 0x9b, 0x8d, 0x5d, 0xaf, 0xb3, 0x69, 0xb5, 0x12, 0xa7,
+	0x8d, 0x67, 0x7e, 0xf3, 0xde, 0xef, 0xfd, 0xde, 0x9b, 0xf7, 0x26, 0x70, 0x91, 0x73, 0xdf, 0xa1,
+	0xdc, 0x8f, 0xd9, 0x57, 0xce, 0xc3, 0x36, 0x8d, 0xf7, 0xaa, 0x51, 0xcc, 0x04, 0x23, 0xc0, 0xb9,
+	0x5f, 0x55, 0xeb, 0x66, 0xb9, 0xc9, 0x9a, 0x4c, 0x2e, 0x3b, 0xc9, 0x5f, 0x0a, 0x61, 0x5e, 0x6e,
+	0x32, 0xd6, 0x6c, 0x51, 0xc7, 0x8b, 0x02, 0xc7, 0x0b, 0x43, 0x26, 0x3c, 0x11, 0xb0, 0x90, 0xe3,
+	0xee, 0x82, 0xcf, 0xf8, 0x36, 0xe3, 0x4e, 0xdd, 0xe3, 0x54, 0x19, 0x76, 0x76, 0x96, 0xeb, 0x54,
+	0x78, 0xcb, 0x4e, 0xe4, 0x35, 0x83, 0x50, 0x82, 0x11, 0x6b, 0xa5, 0xb1, 0x1a, 0xe5, 0xb3, 0x40,
+	0xef, 0x4f, 0xa6, 0x38, 0x46, 0x5e, 0xec, 0x6d, 0xf3, 0x2e, 0x1b, 0xea, 0x47, 0x6d, 0xd8, 0x65,
+	0x20, 0x9f, 0x24, 0x3e, 0xd7, 0x25, 0xda, 0xa5, 0x0f, 0xdb, 0x94, 0x0b, 0xbb, 0x06, 0x13, 0x99,
+	0x55, 0x1e, 0xb1, 0x90, 0x53, 0xb2, 0x04, 0x23, 0xca, 0x6a, 0xc5, 0x98, 0x31, 0xe6, 0xc7, 0x57,
+	0x48, 0xf5, 0x38, 0xf6, 0xaa, 0xc2, 0xae, 0x0e, 0x3d, 0xfd, 0x73, 0x7a, 0xc0, 0x45, 0x9c, 0xfd,
+	0x2e, 0x58, 0xd2, 0x50, 0x8d, 0x8a, 0xbb, 0x5b, 0x5e, 0x10, 0xb6, 0xa8, 0xb8, 0xe3, 0xfb, 0xac,
+	0x1d, 0x0a, 0x74, 0x45, 0x2a, 0x30, 0xea, 0x27, 0x3b, 0x1f, 0x36, 0xa4, 0xd1, 0x31, 0x57, 0x7f,
+	0xda, 0x5f, 0xc0, 0x74, 0xe1, 0x59, 0x24, 0xf4, 0x1e, 0x8c, 0x7a, 0x6a, 0x09, 0x19, 0x5d, 0x4a,
+	0x33, 0x7a, 0xee, 0x14, 0x52, 0xd3, 0x27, 0xec, 0x36, 0x5c, 0x50, 0x41, 0x32, 0xd6, 0xe2, 0x3d,
+	0xe9, 0x90, 0x35, 0x80, 0xe3, 0x7c, 0x54, 0xea, 0xd2, 0xdd, 0x9b, 0x55, 0x95, 0x90, 0x6a, 0x92,
+	0x90, 0xaa, 0xaa, 0x0a, 0x4c, 0x4b, 0x75, 0xdd, 0x6b, 0x52, 0xb4, 0xea, 0xa6, 0x4e, 0xda, 0xdf,
+	0x19, 0x40, 0xd2, 0x7e, 0x31, 0x94, 0x6b, 0x30, 0x1c, 0x25, 0x0b, 0x15, 0x63, 0xa6, 0x34, 0x3f,
+	0xbe, 0xf2, 0x5a, 0x3a, 0x90, 0x7b, 0x34, 0x64, 0xdb, 0x09, 0xdc, 0x55, 0x18, 0x52, 0xeb, 0xc2,
+	0x65, 0xae, 0x27, 0x17, 0xe5, 0x29, 0x43, 0xe6, 0x07, 0x03, 0x33, 0xbd, 0xd6, 0x0e, 0x1b, 0x34,
+	0x3e, 0x85, 0x0c, 0x65, 0x18, 0x6e, 0x24, 0x74, 0x2a, 0x83, 0x72, 0x5d, 0x7d, 0xf4, 0x4d, 0x9c,
+	0x4f, 0x61, 0x5c, 0x31, 0xb9, 0x1f, 0x8a, 0x78, 0x2f, 0xa1, 0xe1, 0x35, 0x1a, 0x31, 0xe5, 0x5c,
+	0xd3, 0xc0, 0xcf, 0xa4, 0x14, 0x1f, 0x48, 0x60, 0x65, 0x30, 0x5f, 0x8a, 0xca, 0x84, 0x2e, 0x45,
+	0x85, 0xb3, 0x7f, 0x32, 0xa0, 0x9c, 0x0d, 0x15, 0x95, 0x5f, 0x86, 0x51, 0x05, 0xd1, 0xda, 0x4f,
+	0xe6, 0x6d, 0x49, 0x3a, 0xae, 0xc6, 0xf5, 0x4f, 0xff, 0x06, 0x54, 0x52, 0x9c, 0x36, 0xb6, 0xbc,
+	0x98, 0xbe, 0x74, 0x0e, 0x52, 0x62, 0x95, 0x32, 0x62, 0xd9, 0x35, 0x98, 0xea, 0xe2, 0x05, 0xc3,
+	0x5f, 0x80, 0x11, 0x2e, 0x57, 0xba, 0x5d, 0x6a, 0x75, 0xc2, 0x45, 0x84, 0xfd, 0x0d, 0x5c, 0x4a,
+	0x19, 0x5a, 0x67, 0x3c, 0x90, 0x9d, 0x2c, 0xc5, 0xb8, 0x20, 0x5d, 0xfd, 0xaa, 0x8f, 0x16, 0x9c,
+	0xd1, 0x5e, 0x5f, 0x58, 0x9f, 0xa5, 0x4e, 0xa0, 0xa5, 0x5e, 0x25, 0x83, 0xe1, 0x3e, 0x31, 0xe0,
+	0x72, 0xf7, 0x78, 0x51, 0xbb, 0x15, 0x18, 0x8b, 0xf4, 0x22, 0x16, 0x4f, 0x39, 0xd3, 0x13, 0x71,
+	0xd3, 0x3d, 0x86, 0xf5, 0xaf, 0x76, 0xae, 0x67, 0x6a, 0x67, 0x9d, 0xb5, 0x02, 0x7f, 0xaf, 0x77,
+	0x57, 0xdd, 0x80, 0xa9, 0x2e, 0xa7, 0x30, 0x9e, 0x9b, 0x30, 0x12, 0xc9, 0x15, 0xac, 0x85, 0x4a,
+	0x5e, 0x22, 0x75, 0xa2, 0xd3, 0xe6, 0xe5, 0x97, 0xfd, 0xc8, 0x80, 0x8b, 0x9d, 0x9e, 0xf6, 0x11,
+	0x6d, 0x34, 0x69, 0xfc, 0x7f, 0x77, 0x92, 0x27, 0x06, 0x4c, 0xe6, 0x28, 0x61, 0x98, 0xb7, 0x60,
+	0x94, 0x86, 0x22, 0x0e, 0x68, 0xd7, 0x1b, 0xaf, 0xc0, 0xf2, 0xc6, 0xeb, 0x91, 0x81, 0xe8, 0xfe,
+	0xe5, 0xee, 0x1e, 0x92, 0xbb, 0x2f, 0x5d, 0x6e, 0x08, 0x4f, 0x74, 0x2e, 0xd1, 0x04, 0x0c, 0x0b,
+	0x16, 0x6d, 0x86, 0x52, 0xae, 0x73, 0xee, 0x90, 0x60, 0xd1, 0xc7, 0xdd, 0xb5, 0xb2, 0x1f, 0xc0,
+	0x79, 0x3d, 0xe3, 0x56, 0xbd, 0x96, 0x17, 0xfa, 0xf4, 0x04, 0xb9, 0xdf, 0x81, 0xd1, 0xba, 0x02,
+	0x61, 0xcb, 0x9c, 0xca, 0x10, 0xd7, 0x94, 0xef, 0xb2, 0x20, 0xd4, 0x61, 0x23, 0xde, 0xfe, 0x7e,
+	0x10, 0x2a, 0x79, 0xba, 0x28, 0xe6, 0xe7, 0x50, 0x12, 0x3b, 0x2d, 0x14, 0xf2, 0x04, 0x9b, 0x4b,
+	0x89, 0xcd, 0xdf, 0xfe, 0x9a, 0x9e, 0x6f, 0x06, 0x62, 0xab, 0x5d, 0xaf, 0xfa, 0x6c, 0xdb, 0x51,
+	0x60, 0xfc, 0x59, 0xe4, 0x8d, 0x2f, 0x1d, 0xb1, 0x17, 0x51, 0x2e, 0x0f, 0x70, 0x37, 0xb1, 0x4b,
+	0xae, 0xc0, 0x59, 0xcf, 0x17, 0xc1, 0x0e, 0xdd, 0x54, 0xe3, 0x31, 0xe1, 0x3e, 0xe4, 0x8e, 0xab,
+	0x35, 0x39, 0x42, 0xc9, 0x2c, 0xbc, 0x82, 0x10, 0xdd, 0xc7, 0x4b, 0x12, 0x74, 0x4e, 0xad, 0x62,
+	0xbf, 0x27, 0x6b, 0x70, 0x2e, 0x11, 0xd6, 0x47, 0xc5, 0x78, 0x65, 0x68, 0xa6, 0x54, 0xf4, 0x64,
+	0x40, 0x39, 0x51, 0x88, 0xb3, 0x82, 0x45, 0x7a, 0x87, 0xaf, 0xfc, 0x3b, 0x06, 0xc3, 0x52, 0x0d,
+	0x42, 0x61, 0x44, 0xbd, 0x7a, 0x88, 0x95, 0x36, 0x92, 0x7f, 0x50, 0x99, 0xd3, 0x85, 0xfb, 0x4a,
+	0x45, 0xdb, 0xfc, 0xf6, 0xf7, 0x7f, 0x1e, 0x0f, 0x96, 0x09, 0x71, 0x72, 0x4f, 0x38, 0xf2, 0x8b,
+	0x01, 0x24, 0xff, 0x08, 0x22, 0x0b, 0x39, 0x9b, 0x85, 0xaf, 0x2c, 0xf3, 0xda, 0xa9, 0xb0, 0xc8,
+	0x65, 0x4e, 0x72, 0xb9, 0x42, 0xa6, 0xd3, 0x5c, 0x3a, 0xb2, 0x39, 0xfb, 0x58, 0x51, 0x07, 0x64,
+	0x17, 0xce, 0xd4, 0xa8, 0x50, 0x49, 0x78, 0x3d, 0x1f, 0x61, 0xea, 0x5d, 0x65, 0x5a, 0x45, 0xdb,
+	0xe8, 0x73, 0x51, 0xfa, 0x9c, 0x23, 0xb3, 0x3d, 0x7c, 0x3a, 0xea, 0x01, 0xf4, 0xa3, 0x01, 0x50,
+	0xa3, 0x42, 0xa7, 0x36, 0x2f, 0x6f, 0xf6, 0x3d, 0x63, 0xce, 0x14, 0x03, 0x90, 0xc0, 0x07, 0x92,
+	0xc0, 0x2d, 0x72, 0xe3, 0x54, 0x04, 0x9c, 0x7d, 0x79, 0x03, 0x0f, 0x1c, 0xfd, 0x22, 0xf8, 0xd5,
+	0x80, 0xb1, 0x0e, 0x21, 0x72, 0xb5, 0xc0, 0x5d, 0x66, 0xc0, 0x9b, 0xb3, 0x3d, 0x50, 0xc8, 0xac,
+	0x26, 0x99, 0xdd, 0x21, 0xb7, 0x5f, 0x8a, 0x99, 0xb3, 0x8f, 0x33, 0xf8, 0x20, 0xa9, 0xa3, 0x57,
+	0x3b, 0x1c, 0x75, 0xc3, 0x98, 0x2b, 0x20, 0xf1, 0xfc, 0x70, 0x37, 0xe7, 0x7b, 0x03, 0x91, 0xb0,
+	0x23, 0x09, 0xbf, 0x45, 0xe6, 0xd2, 0x84, 0x73, 0x74, 0x9c, 0xe3, 0x91, 0xf8, 0xb3, 0x01, 0xe7,
+	0x3b, 0xc4, 0xd4, 0x80, 0x29, 0x94, 0x30, 0x33, 0xe7, 0xcc, 0xd9, 0x1e, 0x28, 0x64, 0x74, 0x43,
+	0x32, 0x72, 0xc8, 0x62, 0x2f, 0x09, 0x15, 0xcb, 0x4d, 0x35, 0xd6, 0xc8, 0x63, 0x03, 0xe0, 0x78,
+	0x7c, 0x10, 0xbb, 0x6b, 0x0d, 0x67, 0xc6, 0x9d, 0xf9, 0xc6, 0x89, 0x18, 0xa4, 0xf3, 0xbe, 0xa4,
+	0x73, 0x93, 0x5c, 0x7f, 0xb1, 0x8c, 0xb6, 0x14, 0x0d, 0x0e, 0xe3, 0xa9, 0x3e, 0x4c, 0xf2, 0x1e,
+	0xf3, 0x43, 0xc5, 0xbc, 0x7a, 0x32, 0x08, 0x79, 0x4d, 0x49, 0x5e, 0x13, 0xe4, 0x42, 0x9a, 0x17,
+	0x4f, 0x20, 0xab, 0xb7, 0x9f, 0x1e, 0x5a, 0xc6, 0xb3, 0x43, 0xcb, 0xf8, 0xfb, 0xd0, 0x32, 0x1e,
+	0x1d, 0x59, 0x03, 0xcf, 0x8e, 0xac, 0x81, 0x3f, 0x8e, 0xac, 0x81, 0xcf, 0x66, 0x53, 0xfd, 0x9c,
+	0x7b, 0x4d, 0x6f, 0x77, 0xef, 0x6b, 0x79, 0x7c, 0x57, 0x1b, 0x90, 0x2d, 0xbd, 0x3e, 0x22, 0xff,
+	0xdf, 0x7c, 0xfb, 0xbf, 0x01, 0x00, 0xd0, 0x47, 0x8b, 0xfe, 0x47, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -851,6 +1268,13 @@ type QueryClient interface {
 	// Optional convenience: all positions for a wallet across chainlets/denoms
 	// (paginated).
 	GetFunderBalance(ctx context.Context, in *QueryFunderPositionsRequest, opts ...grpc.CallOption) (*QueryFunderPositionsResponse, error)
+	// Who may fund the pools of a chainlet.
+	GetFunderPolicy(ctx context.Context, in *QueryFunderPolicyRequest, opts ...grpc.CallOption) (*QueryFunderPolicyResponse, error)
+	// Balance movements of a {chainId, denom} pool, oldest first (paginated).
+	PoolLedger(ctx context.Context, in *QueryPoolLedgerRequest, opts ...grpc.CallOption) (*QueryPoolLedgerResponse, error)
+	// Total value locked, active pools and funders, and the chainlets with the
+	// largest escrow balances.
+	EscrowStats(ctx context.Context, in *QueryEscrowStatsRequest, opts ...grpc.CallOption) (*QueryEscrowStatsResponse, error)
 }
 
 type queryClient struct {
@@ -915,6 +1339,33 @@ func (c *queryClient) GetFunderBalance(ctx context.Context, in *QueryFunderPosit
 	return out, nil
 }
 
+func (c *queryClient) GetFunderPolicy(ctx context.Context, in *QueryFunderPolicyRequest, opts ...grpc.CallOption) (*QueryFunderPolicyResponse, error) {
+	out := new(QueryFunderPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ssc.escrow.Query/GetFunderPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolLedger(ctx context.Context, in *QueryPoolLedgerRequest, opts ...grpc.CallOption) (*QueryPoolLedgerResponse, error) {
+	out := new(QueryPoolLedgerResponse)
+	err := c.cc.Invoke(ctx, "/ssc.escrow.Query/PoolLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowStats(ctx context.Context, in *QueryEscrowStatsRequest, opts ...grpc.CallOption) (*QueryEscrowStatsResponse, error) {
+	out := new(QueryEscrowStatsResponse)
+	err := c.cc.Invoke(ctx, "/ssc.escrow.Query/EscrowStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Module params.
@@ -930,6 +1381,13 @@ type QueryServer interface {
 	// Optional convenience: all positions for a wallet across chainlets/denoms
 	// (paginated).
 	GetFunderBalance(context.Context, *QueryFunderPositionsRequest) (*QueryFunderPositionsResponse, error)
+	// Who may fund the pools of a chainlet.
+	GetFunderPolicy(context.Context, *QueryFunderPolicyRequest) (*QueryFunderPolicyResponse, error)
+	// Balance movements of a {chainId, denom} pool, oldest first (paginated).
+	PoolLedger(context.Context, *QueryPoolLedgerRequest) (*QueryPoolLedgerResponse, error)
+	// Total value locked, active pools and funders, and the chainlets with the
+	// largest escrow balances.
+	EscrowStats(context.Context, *QueryEscrowStatsRequest) (*QueryEscrowStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -954,6 +1412,15 @@ func (*UnimplementedQueryServer) GetFunder(ctx context.Context, req *QueryFunder
 func (*UnimplementedQueryServer) GetFunderBalance(ctx context.Context, req *QueryFunderPositionsRequest) (*QueryFunderPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFunderBalance not implemented")
 }
+func (*UnimplementedQueryServer) GetFunderPolicy(ctx context.Context, req *QueryFunderPolicyRequest) (*QueryFunderPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunderPolicy not implemented")
+}
+func (*UnimplementedQueryServer) PoolLedger(ctx context.Context, req *QueryPoolLedgerRequest) (*QueryPoolLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolLedger not implemented")
+}
+func (*UnimplementedQueryServer) EscrowStats(ctx context.Context, req *QueryEscrowStatsRequest) (*QueryEscrowStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1067,6 +1534,60 @@ func _Query_GetFunderBalance_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetFunderPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunderPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFunderPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.escrow.Query/GetFunderPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFunderPolicy(ctx, req.(*QueryFunderPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.escrow.Query/PoolLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolLedger(ctx, req.(*QueryPoolLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ssc.escrow.Query/EscrowStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowStats(ctx, req.(*QueryEscrowStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ssc.escrow.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1095,6 +1616,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFunderBalance",
 			Handler:    _Query_GetFunderBalance_Handler,
 		},
+		{
+			MethodName: "GetFunderPolicy",
+			Handler:    _Query_GetFunderPolicy_Handler,
+		},
+		{
+			MethodName: "PoolLedger",
+			Handler:    _Query_PoolLedger_Handler,
+		},
+		{
+			MethodName: "EscrowStats",
+			Handler:    _Query_EscrowStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ssc/escrow/query.proto",
@@ -1677,193 +2210,369 @@ func (m *QueryFunderPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunderPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFunderPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainletAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFunderPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainletAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunderPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Account.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFunderPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFunderPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *QueryPoolLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FunderEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Funder.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPoolLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Funders) > 0 {
-		for _, e := range m.Funders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFunderSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolLedgerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFunderSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolLedgerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolLedgerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Shares != nil {
-		l = m.Shares.Size()
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopN != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainletBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainletBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainletBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopChainlets) > 0 {
+		for iNdEx := len(m.TopChainlets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopChainlets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ActiveFunders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveFunders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActivePools != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivePools))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tvl) > 0 {
+		for iNdEx := len(m.Tvl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tvl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetChainletAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryFunderPositionsRequest) Size() (n int) {
+func (m *QueryGetChainletAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -1874,7 +2583,26 @@ func (m *QueryFunderPositionsRequest) Size() (n int) {
 	return n
 }
 
-func (m *Position) Size() (n int) {
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1888,19 +2616,36 @@ func (m *Position) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.Shares.Size()
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FunderEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Funder.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryFunderPositionsResponse) Size() (n int) {
+func (m *QueryFundersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1912,17 +2657,228 @@ func (m *QueryFunderPositionsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *QueryFunderSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shares != nil {
+		l = m.Shares.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFunderPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolLedgerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopN != 0 {
+		n += 1 + sovQuery(uint64(m.TopN))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainletBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tvl) > 0 {
+		for _, e := range m.Tvl {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ActivePools != 0 {
+		n += 1 + sovQuery(uint64(m.ActivePools))
+	}
+	if m.ActiveFunders != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveFunders))
+	}
+	if len(m.TopChainlets) > 0 {
+		for _, e := range m.TopChainlets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1968,7 +2924,758 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainletAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainletAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainletAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainletAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainletAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainletAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &DenomPool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1991,15 +3698,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2026,7 +3733,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Funders = append(m.Funders, &FunderEntry{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2051,7 +3795,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainletAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunderSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,10 +3818,10 @@ func (m *QueryGetChainletAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainletAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunderSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainletAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunderSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2112,61 +3856,43 @@ func (m *QueryGetChainletAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetChainletAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainletAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainletAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2176,24 +3902,23 @@ func (m *QueryGetChainletAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2216,7 +3941,7 @@ func (m *QueryGetChainletAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunderSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2239,47 +3964,15 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunderSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunderSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 98:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2306,10 +3999,10 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Shares == nil {
+				m.Shares = &Funder{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2334,7 +4027,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunderPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2357,17 +4050,17 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunderPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunderPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2377,25 +4070,23 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &DenomPool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 98:
 			if wireType != 2 {
@@ -2427,7 +4118,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2454,7 +4145,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
+func (m *Position) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2477,10 +4168,10 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2547,9 +4238,9 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 98:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2576,10 +4267,7 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2604,7 +4292,7 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FunderEntry) Unmarshal(dAtA []byte) error {
+func (m *QueryFunderPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,17 +4315,17 @@ func (m *FunderEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FunderEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunderPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FunderEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunderPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2647,27 +4335,29 @@ func (m *FunderEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Positions = append(m.Positions, &Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2694,7 +4384,10 @@ func (m *FunderEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2719,7 +4412,7 @@ func (m *FunderEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunderPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,17 +4435,17 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunderPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunderPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2762,29 +4455,77 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funders = append(m.Funders, &FunderEntry{})
-			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 98:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2811,10 +4552,7 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2839,7 +4577,7 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolLedgerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,10 +4600,10 @@ func (m *QueryFunderSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolLedgerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2932,11 +4670,11 @@ func (m *QueryFunderSharesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2946,23 +4684,27 @@ func (m *QueryFunderSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2985,7 +4727,7 @@ func (m *QueryFunderSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolLedgerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3008,15 +4750,15 @@ func (m *QueryFunderSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolLedgerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3043,10 +4785,44 @@ func (m *QueryFunderSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shares == nil {
-				m.Shares = &Funder{}
+			m.Entries = append(m.Entries, LedgerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3071,7 +4847,7 @@ func (m *QueryFunderSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3094,17 +4870,17 @@ func (m *QueryFunderPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
 			}
-			var stringLen uint64
+			m.TopN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3114,29 +4890,16 @@ func (m *QueryFunderPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopN |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 98:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3146,27 +4909,23 @@ func (m *QueryFunderPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3189,7 +4948,7 @@ func (m *QueryFunderPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *ChainletBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3212,10 +4971,10 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainletBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainletBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3252,39 +5011,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3311,7 +5038,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3336,7 +5063,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3359,15 +5086,15 @@ func (m *QueryFunderPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3394,14 +5121,52 @@ func (m *QueryFunderPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, &Position{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tvl = append(m.Tvl, types.Coin{})
+			if err := m.Tvl[len(m.Tvl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 98:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePools", wireType)
+			}
+			m.ActivePools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivePools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFunders", wireType)
+			}
+			m.ActiveFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveFunders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopChainlets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3428,10 +5193,8 @@ func (m *QueryFunderPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopChainlets = append(m.TopChainlets, ChainletBalance{})
+			if err := m.TopChainlets[len(m.TopChainlets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
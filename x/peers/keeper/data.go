@@ -9,7 +9,6 @@ import (
 	"github.com/sagaxyz/ssc/x/peers/types"
 )
 
-//nolint:unused
 func (k Keeper) data(ctx sdk.Context, chainID string, addr string) (data types.Data, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DataKey)
 	store = prefix.NewStore(store, types.KeyPrefix(chainID))
@@ -99,6 +98,12 @@ func (k Keeper) GetPeers(ctx sdk.Context, chainID string) (peers []string) {
 	return
 }
 
+// HasPeers returns whether a validator submitted peers for a chain ID.
+func (k Keeper) HasPeers(ctx sdk.Context, chainID string, addr string) bool {
+	_, err := k.data(ctx, chainID, addr)
+	return err == nil
+}
+
 // Test helper
 func (k Keeper) Counter(ctx sdk.Context, chainID string) uint32 {
 	chainStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainsKey)
@@ -114,3 +119,28 @@ func (k Keeper) Counter(ctx sdk.Context, chainID string) uint32 {
 
 	return counter.Number
 }
+
+// DeleteChainletData removes the data of all validators for a chain ID.
+func (k Keeper) DeleteChainletData(ctx sdk.Context, chainID string) {
+	dataStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DataKey)
+	s := prefix.NewStore(dataStore, types.KeyPrefix(chainID))
+
+	// Collect keys first to avoid iterator invalidation during deletion
+	var keys [][]byte
+	iterator := s.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		// Skip keys of other chain IDs sharing the same prefix
+		if _, err := sdk.ValAddressFromBech32(string(iterator.Key())); err != nil {
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		s.Delete(key)
+	}
+
+	chainStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainsKey)
+	chainStore.Delete([]byte(chainID))
+}
@@ -38,6 +38,8 @@ func (s *TestSuite) TestDataStorage() {
 	})
 	s.Require().Equal([]string{"a", "b"}, s.peersKeeper.GetPeers(s.ctx, chainIDs[0]))
 	s.Require().Equal(uint32(1), s.peersKeeper.Counter(s.ctx, chainIDs[0]))
+	s.Require().True(s.peersKeeper.HasPeers(s.ctx, chainIDs[0], valAddrA.String()))
+	s.Require().False(s.peersKeeper.HasPeers(s.ctx, chainIDs[0], valAddrB.String()))
 
 	// Add val B addrs
 	s.peersKeeper.StoreData(s.ctx, chainIDs[0], valAddrB.String(), types.Data{
@@ -61,3 +63,37 @@ func (s *TestSuite) TestDataStorage() {
 	s.Require().Equal(0, len(s.peersKeeper.GetPeers(s.ctx, chainIDs[1])))
 	s.Require().Equal(uint32(0), s.peersKeeper.Counter(s.ctx, chainIDs[1]))
 }
+
+func (s *TestSuite) TestDeleteChainletData() {
+	_, _, addrA := testdata.KeyTestPubAddr()
+	valAddrA := sdk.ValAddress(addrA)
+	_, _, addrB := testdata.KeyTestPubAddr()
+	valAddrB := sdk.ValAddress(addrB)
+
+	// Shares the prefix of the first chain ID
+	otherChainIDs := []string{chainIDs[1], chainIDs[2], chainIDs[0] + "0"}
+
+	for _, chainID := range append([]string{chainIDs[0]}, otherChainIDs...) {
+		s.peersKeeper.StoreData(s.ctx, chainID, valAddrA.String(), types.Data{
+			Updated:   s.ctx.BlockTime(),
+			Addresses: []string{"a"},
+		})
+		s.peersKeeper.StoreData(s.ctx, chainID, valAddrB.String(), types.Data{
+			Updated:   s.ctx.BlockTime(),
+			Addresses: []string{"b"},
+		})
+	}
+
+	s.peersKeeper.DeleteChainletData(s.ctx, chainIDs[0])
+	s.Require().Equal(uint32(0), s.peersKeeper.Counter(s.ctx, chainIDs[0]))
+
+	// Other chain IDs are untouched
+	for _, chainID := range otherChainIDs {
+		s.Require().Equal(2, len(s.peersKeeper.GetPeers(s.ctx, chainID)))
+		s.Require().Equal(uint32(2), s.peersKeeper.Counter(s.ctx, chainID))
+	}
+
+	// Validator data can still be removed afterwards
+	s.peersKeeper.DeleteValidatorData(s.ctx, valAddrA.String())
+	s.Require().Equal(uint32(1), s.peersKeeper.Counter(s.ctx, chainIDs[1]))
+}